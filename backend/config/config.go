@@ -18,10 +18,38 @@ type Config struct {
 	DBName             string `env:"DB_NAME"`
 	GoogleClientID     string `env:"GOOGLE_CLIENT_ID"`
 	GoogleClientSecret string `env:"GOOGLE_CLIENT_SECRET"`
+	GitHubClientID     string `env:"GITHUB_CLIENT_ID"`
+	GitHubClientSecret string `env:"GITHUB_CLIENT_SECRET"`
+	GitHubAPIBaseURL   string `env:"GITHUB_API_BASE_URL"`
+	GitLabClientID     string `env:"GITLAB_CLIENT_ID"`
+	GitLabClientSecret string `env:"GITLAB_CLIENT_SECRET"`
+	GitLabBaseURL      string `env:"GITLAB_BASE_URL"`
+	OIDCName           string `env:"OIDC_NAME"`
+	OIDCIssuer         string `env:"OIDC_ISSUER"`
+	OIDCClientID       string `env:"OIDC_CLIENT_ID"`
+	OIDCClientSecret   string `env:"OIDC_CLIENT_SECRET"`
 	OpenAIApiKey       string `env:"OPENAI_API_KEY"`
 	TriggerSecretKey   string `env:"TRIGGER_SECRET_KEY"`
 	ProcessorSecret    string `env:"PROCESSOR_SECRET"`
 	ProcessorUserID    string `env:"PROCESSOR_USER_ID"`
+
+	ModerationMaxLinks          string `env:"MODERATION_MAX_LINKS"`
+	ModerationBlockedWords      string `env:"MODERATION_BLOCKED_WORDS"`
+	ModerationHoldFirstComments string `env:"MODERATION_HOLD_FIRST_COMMENTS"`
+	ModerationClassifier        string `env:"MODERATION_CLASSIFIER"`
+
+	Reactions string `env:"REACTIONS"`
+
+	AdminEmails string `env:"ADMIN_EMAILS"`
+
+	AccessTokenTTL  string `env:"ACCESS_TOKEN_TTL"`
+	RefreshTokenTTL string `env:"REFRESH_TOKEN_TTL"`
+
+	HandleChangeCooldown string `env:"HANDLE_CHANGE_COOLDOWN"`
+	HandleAliasGrace     string `env:"HANDLE_ALIAS_GRACE"`
+
+	AccountDeletionGrace  string `env:"ACCOUNT_DELETION_GRACE"`
+	AccountDeletionPolicy string `env:"ACCOUNT_DELETION_POLICY"`
 }
 
 func Load() Config {
@@ -0,0 +1,22 @@
+-- +goose Up
+-- NOTIFICATIONS (one row per aggregated group, e.g. "5 people liked your post")
+CREATE TABLE notifications (
+  id UUID PRIMARY KEY,               -- generated by app
+  recipient_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  type TEXT NOT NULL,                -- comment | reply | like | follow
+  post_id UUID REFERENCES posts(id) ON DELETE CASCADE,
+  comment_id UUID REFERENCES comments(id) ON DELETE CASCADE,
+  group_key TEXT NOT NULL,           -- unread notifications with the same key are merged
+  actor_ids JSONB NOT NULL DEFAULT '[]', -- array of user ids, most recent last
+  read_at TIMESTAMPTZ,               -- NULL while unread
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_notifications_recipient ON notifications(recipient_id, updated_at DESC);
+CREATE INDEX idx_notifications_unread_group ON notifications(recipient_id, group_key) WHERE read_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_notifications_unread_group;
+DROP INDEX IF EXISTS idx_notifications_recipient;
+DROP TABLE IF EXISTS notifications;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE comments
+  ADD COLUMN reply_count INTEGER NOT NULL DEFAULT 0; -- direct replies, recomputed by the app
+
+UPDATE comments c
+SET reply_count = (SELECT COUNT(*) FROM comments r WHERE r.parent_id = c.id);
+
+CREATE INDEX idx_comments_post_roots ON comments(post_id, created_at) WHERE parent_id IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_comments_post_roots;
+ALTER TABLE comments
+  DROP COLUMN IF EXISTS reply_count;
@@ -0,0 +1,22 @@
+-- +goose Up
+ALTER TABLE comments
+  ADD COLUMN edited_at TIMESTAMPTZ,   -- NULL until the body is edited
+  ADD COLUMN deleted_at TIMESTAMPTZ;  -- set when a comment with replies is deleted (tombstone)
+
+-- COMMENT REVISIONS (previous bodies of edited comments)
+CREATE TABLE comment_revisions (
+  id UUID PRIMARY KEY,               -- generated by app
+  comment_id UUID NOT NULL REFERENCES comments(id) ON DELETE CASCADE,
+  editor_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  body TEXT NOT NULL,                -- body before the edit
+  created_at TIMESTAMPTZ NOT NULL    -- when the body was replaced
+);
+
+CREATE INDEX idx_comment_revisions_comment ON comment_revisions(comment_id, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_comment_revisions_comment;
+DROP TABLE IF EXISTS comment_revisions;
+ALTER TABLE comments
+  DROP COLUMN IF EXISTS deleted_at,
+  DROP COLUMN IF EXISTS edited_at;
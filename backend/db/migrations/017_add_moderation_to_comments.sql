@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE comments
+  ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'approved', -- pending, approved or rejected
+  ADD COLUMN moderation_reason TEXT,                         -- why the comment was held or rejected
+  ADD COLUMN moderated_at TIMESTAMPTZ;                       -- set when the post author approves or rejects it
+
+CREATE INDEX idx_comments_status ON comments(post_id, status);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_comments_status;
+ALTER TABLE comments
+  DROP COLUMN IF EXISTS moderated_at,
+  DROP COLUMN IF EXISTS moderation_reason,
+  DROP COLUMN IF EXISTS status;
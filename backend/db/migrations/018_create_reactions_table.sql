@@ -0,0 +1,43 @@
+-- +goose Up
+-- REACTIONS (emoji reactions on a post or a comment; one row per user + target + emoji)
+CREATE TABLE reactions (
+  id UUID PRIMARY KEY,               -- generated by app
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  post_id UUID REFERENCES posts(id) ON DELETE CASCADE,       -- set for post reactions
+  comment_id UUID REFERENCES comments(id) ON DELETE CASCADE, -- set for comment reactions
+  emoji TEXT NOT NULL,               -- reaction name from the configured set, e.g. like
+  created_at TIMESTAMPTZ NOT NULL,
+  CHECK ((post_id IS NULL) <> (comment_id IS NULL)),
+  UNIQUE (user_id, post_id, emoji),
+  UNIQUE (user_id, comment_id, emoji)
+);
+
+CREATE INDEX idx_reactions_post ON reactions(post_id) WHERE post_id IS NOT NULL;
+CREATE INDEX idx_reactions_comment ON reactions(comment_id) WHERE comment_id IS NOT NULL;
+CREATE INDEX idx_reactions_user ON reactions(user_id, emoji);
+
+-- Existing likes become the "like" reaction
+INSERT INTO reactions (id, user_id, post_id, emoji, created_at)
+SELECT id, user_id, post_id, 'like', created_at FROM post_likes;
+
+DROP TABLE post_likes;
+
+-- +goose Down
+CREATE TABLE post_likes (
+  id UUID PRIMARY KEY,               -- generated by app
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL,
+  UNIQUE (user_id, post_id)
+);
+
+CREATE INDEX idx_post_likes_post ON post_likes(post_id);
+CREATE INDEX idx_post_likes_user ON post_likes(user_id);
+
+INSERT INTO post_likes (id, user_id, post_id, created_at)
+SELECT id, user_id, post_id, created_at FROM reactions WHERE post_id IS NOT NULL AND emoji = 'like';
+
+DROP INDEX IF EXISTS idx_reactions_user;
+DROP INDEX IF EXISTS idx_reactions_comment;
+DROP INDEX IF EXISTS idx_reactions_post;
+DROP TABLE IF EXISTS reactions;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- MENTIONS (@username in a post body or a comment; one row per mentioned user + source)
+CREATE TABLE mentions (
+  id UUID PRIMARY KEY,               -- generated by app
+  mentioned_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE, -- who wrote the mention
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  comment_id UUID REFERENCES comments(id) ON DELETE CASCADE, -- NULL when the mention is in the post body
+  created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_mentions_post_user ON mentions(post_id, mentioned_user_id) WHERE comment_id IS NULL;
+CREATE UNIQUE INDEX idx_mentions_comment_user ON mentions(comment_id, mentioned_user_id) WHERE comment_id IS NOT NULL;
+CREATE INDEX idx_mentions_user ON mentions(mentioned_user_id, created_at DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_mentions_user;
+DROP INDEX IF EXISTS idx_mentions_comment_user;
+DROP INDEX IF EXISTS idx_mentions_post_user;
+DROP TABLE IF EXISTS mentions;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- BLOCKS (blocked users cannot follow the blocker, comment on or react to their posts)
+CREATE TABLE user_blocks (
+  id UUID PRIMARY KEY,               -- generated by app
+  blocker_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  blocked_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL,
+  UNIQUE (blocker_id, blocked_id)
+);
+
+CREATE INDEX idx_user_blocks_blocked ON user_blocks(blocked_id);
+
+-- MUTES (content of muted users is hidden from the muter)
+CREATE TABLE user_mutes (
+  id UUID PRIMARY KEY,               -- generated by app
+  muter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  muted_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL,
+  UNIQUE (muter_id, muted_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_mutes;
+DROP INDEX IF EXISTS idx_user_blocks_blocked;
+DROP TABLE IF EXISTS user_blocks;
@@ -0,0 +1,62 @@
+-- +goose Up
+-- REPORTS (readers flag a post, a comment or a user for the admins to triage)
+CREATE TABLE reports (
+  id UUID PRIMARY KEY,               -- generated by app
+  reporter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  target_type TEXT NOT NULL,         -- post | comment | user
+  post_id UUID REFERENCES posts(id) ON DELETE CASCADE,       -- set for post and comment reports
+  comment_id UUID REFERENCES comments(id) ON DELETE CASCADE, -- set for comment reports
+  reported_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE, -- the reported user or the content author
+  reason TEXT NOT NULL,              -- spam | harassment | hate | violence | sexual | misinformation | other
+  details TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'open', -- open | dismissed | actioned
+  resolution TEXT,                   -- triage action once resolved: dismiss | hide_content | suspend_user
+  resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+  resolved_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_reports_status ON reports(status, created_at);
+CREATE INDEX idx_reports_target ON reports(target_type, reported_user_id) WHERE status = 'open';
+-- A reporter has at most one open report per target, post and comment IDs are
+-- coalesced as NULLs never conflict
+CREATE UNIQUE INDEX idx_reports_open_reporter_target ON reports(
+  reporter_id,
+  target_type,
+  COALESCE(post_id, '00000000-0000-0000-0000-000000000000'),
+  COALESCE(comment_id, '00000000-0000-0000-0000-000000000000'),
+  reported_user_id
+) WHERE status = 'open';
+
+-- ADMIN ACTIONS (audit trail of every triage action)
+CREATE TABLE admin_actions (
+  id UUID PRIMARY KEY,               -- generated by app
+  admin_id UUID NOT NULL REFERENCES users(id),
+  action TEXT NOT NULL,              -- dismiss | hide_content | suspend_user
+  report_id UUID REFERENCES reports(id) ON DELETE SET NULL,
+  target_type TEXT NOT NULL,         -- post | comment | user
+  target_id UUID NOT NULL,
+  note TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_admin_actions_created ON admin_actions(created_at DESC);
+
+-- Hidden posts are out of every public listing, hidden comments get the 'hidden'
+-- status and suspended users cannot sign in or write
+ALTER TABLE posts ADD COLUMN hidden_at TIMESTAMPTZ;
+ALTER TABLE users ADD COLUMN suspended_at TIMESTAMPTZ;
+
+-- Reporters are notified of the outcome of their report
+ALTER TABLE notifications ADD COLUMN report_id UUID REFERENCES reports(id) ON DELETE CASCADE;
+
+-- +goose Down
+ALTER TABLE notifications DROP COLUMN IF EXISTS report_id;
+ALTER TABLE users DROP COLUMN IF EXISTS suspended_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS hidden_at;
+DROP INDEX IF EXISTS idx_admin_actions_created;
+DROP TABLE IF EXISTS admin_actions;
+DROP INDEX IF EXISTS idx_reports_open_reporter_target;
+DROP INDEX IF EXISTS idx_reports_target;
+DROP INDEX IF EXISTS idx_reports_status;
+DROP TABLE IF EXISTS reports;
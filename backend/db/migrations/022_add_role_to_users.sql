@@ -0,0 +1,15 @@
+-- +goose Up
+-- Roles, from least to most privileged: reader | author | editor | admin.
+-- Everybody could write posts until now, so existing users are authors.
+ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'author';
+
+CREATE INDEX idx_users_role ON users(role);
+
+-- The audit trail outlives the admins who acted
+ALTER TABLE admin_actions DROP CONSTRAINT IF EXISTS admin_actions_admin_id_fkey;
+
+-- +goose Down
+DELETE FROM admin_actions WHERE admin_id NOT IN (SELECT id FROM users);
+ALTER TABLE admin_actions ADD CONSTRAINT admin_actions_admin_id_fkey FOREIGN KEY (admin_id) REFERENCES users(id);
+DROP INDEX IF EXISTS idx_users_role;
+ALTER TABLE users DROP COLUMN IF EXISTS role;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- SESSIONS (one per sign-in; access tokens carry the session ID, refresh tokens rotate)
+CREATE TABLE sessions (
+  id UUID PRIMARY KEY,               -- generated by app, the "sid" claim of access tokens
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  refresh_token_hash TEXT NOT NULL UNIQUE,   -- sha256 of the current refresh token
+  previous_token_hash TEXT,          -- sha256 of the rotated out token, reusing it revokes the session
+  user_agent TEXT NOT NULL DEFAULT '',
+  ip TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL,
+  last_seen_at TIMESTAMPTZ NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL,   -- refresh tokens are refused after this
+  revoked_at TIMESTAMPTZ             -- set on logout or revocation
+);
+
+CREATE INDEX idx_sessions_user ON sessions(user_id, last_seen_at DESC);
+CREATE INDEX idx_sessions_previous_token ON sessions(previous_token_hash) WHERE previous_token_hash IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_sessions_previous_token;
+DROP INDEX IF EXISTS idx_sessions_user;
+DROP TABLE IF EXISTS sessions;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- OAUTH CODES (one-time codes the OAuth callback hands to the web app, exchanged for tokens via /auth/exchange)
+CREATE TABLE oauth_codes (
+  id UUID PRIMARY KEY,               -- generated by app
+  code_hash TEXT NOT NULL UNIQUE,    -- sha256 of the code, deleted once exchanged
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  provider TEXT NOT NULL,            -- provider the user signed in with
+  created_at TIMESTAMPTZ NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL    -- codes are refused after this
+);
+
+CREATE INDEX idx_oauth_codes_expires ON oauth_codes(expires_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_oauth_codes_expires;
+DROP TABLE IF EXISTS oauth_codes;
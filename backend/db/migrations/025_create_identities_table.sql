@@ -0,0 +1,16 @@
+-- +goose Up
+-- IDENTITIES (the provider accounts a user signs in with)
+CREATE TABLE identities (
+  id UUID PRIMARY KEY,               -- generated by app
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  provider TEXT NOT NULL,            -- google, github, gitlab or the OIDC provider name
+  subject TEXT NOT NULL,             -- stable user ID at the provider
+  email TEXT NOT NULL DEFAULT '',    -- verified email the provider last shared, empty if none
+  created_at TIMESTAMPTZ NOT NULL,
+  last_used_at TIMESTAMPTZ NOT NULL,
+  UNIQUE (provider, subject),        -- a provider account belongs to a single user
+  UNIQUE (user_id, provider)         -- one account per provider and user
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS identities;
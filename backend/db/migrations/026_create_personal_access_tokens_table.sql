@@ -0,0 +1,20 @@
+-- +goose Up
+-- PERSONAL ACCESS TOKENS (named, scoped and expiring API credentials for scripts)
+CREATE TABLE personal_access_tokens (
+  id UUID PRIMARY KEY,               -- generated by app
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,                -- label chosen by the user, e.g. "release notes CI"
+  token_hash TEXT NOT NULL UNIQUE,   -- sha256 of the token, which is only shown once
+  token_prefix TEXT NOT NULL,        -- first characters of the token, to recognise it in lists
+  scopes JSONB NOT NULL DEFAULT '[]', -- array of scopes (read, write:posts, write:comments)
+  created_at TIMESTAMPTZ NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL,
+  last_used_at TIMESTAMPTZ,
+  revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_personal_access_tokens_user ON personal_access_tokens(user_id, created_at DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_personal_access_tokens_user;
+DROP TABLE IF EXISTS personal_access_tokens;
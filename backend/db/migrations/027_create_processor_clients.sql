@@ -0,0 +1,32 @@
+-- +goose Up
+-- PROCESSOR CLIENTS (machine clients of /api/p/v1, each with its own credential and permissions)
+CREATE TABLE processor_clients (
+  id UUID PRIMARY KEY,               -- generated by app, the username of the Basic credential
+  name TEXT NOT NULL UNIQUE,         -- e.g. "trigger.dev processor"
+  secret_hash TEXT NOT NULL,         -- sha256 of the secret, which is only shown once
+  allowed_author_ids JSONB NOT NULL DEFAULT '[]', -- users the client may act as
+  allowed_ops JSONB NOT NULL DEFAULT '[]',        -- operations (posts:create, posts:update, posts:publish, posts:audio)
+  created_by UUID,                   -- admin who registered it
+  created_at TIMESTAMPTZ NOT NULL,
+  last_used_at TIMESTAMPTZ,
+  disabled_at TIMESTAMPTZ            -- disabled clients are refused
+);
+
+-- PROCESSOR AUDIT LOGS (who did what through /api/p/v1)
+CREATE TABLE processor_audit_logs (
+  id UUID PRIMARY KEY,               -- generated by app
+  client_id TEXT NOT NULL,           -- processor_clients.id, or "legacy" for the PROCESSOR_SECRET client
+  op TEXT NOT NULL,
+  author_id TEXT NOT NULL DEFAULT '', -- user the client acted as
+  post_id TEXT NOT NULL DEFAULT '',
+  succeeded BOOLEAN NOT NULL,
+  error TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_processor_audit_logs_client ON processor_audit_logs(client_id, created_at DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_processor_audit_logs_client;
+DROP TABLE IF EXISTS processor_audit_logs;
+DROP TABLE IF EXISTS processor_clients;
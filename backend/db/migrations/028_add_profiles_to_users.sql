@@ -0,0 +1,30 @@
+-- +goose Up
+-- Public profile of users, edited from PUT /me/profile
+ALTER TABLE users ADD COLUMN display_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN bio TEXT NOT NULL DEFAULT '';            -- Markdown
+ALTER TABLE users ADD COLUMN avatar_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN website TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN location TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN social_links JSONB NOT NULL DEFAULT '[]'; -- array of URLs
+ALTER TABLE users ADD COLUMN handle TEXT;                             -- lowercase, /users/@handle
+
+CREATE UNIQUE INDEX idx_users_handle ON users(handle);
+
+-- AVATARS (uploaded images, served from /users/{id}/avatar)
+CREATE TABLE avatars (
+  user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  content_type TEXT NOT NULL,
+  data BYTEA NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS avatars;
+DROP INDEX IF EXISTS idx_users_handle;
+ALTER TABLE users DROP COLUMN IF EXISTS handle;
+ALTER TABLE users DROP COLUMN IF EXISTS social_links;
+ALTER TABLE users DROP COLUMN IF EXISTS location;
+ALTER TABLE users DROP COLUMN IF EXISTS website;
+ALTER TABLE users DROP COLUMN IF EXISTS avatar_url;
+ALTER TABLE users DROP COLUMN IF EXISTS bio;
+ALTER TABLE users DROP COLUMN IF EXISTS display_name;
@@ -0,0 +1,42 @@
+-- +goose Up
+-- Every user gets a handle derived from their username: lowercase letters,
+-- digits and underscores. Short, reserved or repeated ones get a suffix.
+WITH candidates AS (
+  SELECT id, left(regexp_replace(regexp_replace(lower(coalesce(username, '')), '\s+', '_', 'g'), '[^a-z0-9_]', '', 'g'), 23) AS base
+  FROM users
+  WHERE handle IS NULL
+), numbered AS (
+  SELECT id, base, row_number() OVER (PARTITION BY base ORDER BY id) AS n
+  FROM candidates
+)
+UPDATE users u
+SET handle = CASE
+  WHEN length(numbered.base) >= 3
+    AND numbered.n = 1
+    AND numbered.base NOT IN ('about', 'admin', 'administrator', 'api', 'app', 'auth', 'blog0', 'collections', 'feed', 'help', 'login', 'logout', 'me', 'moderator', 'new', 'null', 'posts', 'privacy', 'root', 'search', 'settings', 'signin', 'signup', 'staff', 'support', 'system', 'terms', 'trending', 'undefined', 'user', 'users', 'www')
+    AND NOT EXISTS (SELECT 1 FROM users other WHERE other.handle = numbered.base)
+    THEN numbered.base
+  WHEN length(numbered.base) >= 3 THEN numbered.base || '_' || left(md5(u.id::text), 6)
+  ELSE 'user_' || left(md5(u.id::text), 6)
+END
+FROM numbered
+WHERE u.id = numbered.id;
+
+ALTER TABLE users ALTER COLUMN handle SET NOT NULL;
+ALTER TABLE users ADD COLUMN handle_changed_at TIMESTAMPTZ; -- start of the cooldown between handle changes
+
+-- HANDLE ALIASES (previous handles, redirecting to their user for a grace period)
+CREATE TABLE handle_aliases (
+  handle TEXT PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL  -- afterwards the handle is free again
+);
+
+CREATE INDEX idx_handle_aliases_user ON handle_aliases(user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_handle_aliases_user;
+DROP TABLE IF EXISTS handle_aliases;
+ALTER TABLE users DROP COLUMN IF EXISTS handle_changed_at;
+ALTER TABLE users ALTER COLUMN handle DROP NOT NULL;
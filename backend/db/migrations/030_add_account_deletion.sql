@@ -0,0 +1,13 @@
+-- +goose Up
+-- Users ask for their account to be deleted with DELETE /me and have a grace
+-- period to change their mind. Once purged, the row is kept anonymised so
+-- what the policy keeps (comments, reactions...) stays attached to it.
+ALTER TABLE users ADD COLUMN deletion_scheduled_at TIMESTAMPTZ; -- purge after this time
+ALTER TABLE users ADD COLUMN deleted_at TIMESTAMPTZ;            -- set once purged
+
+CREATE INDEX idx_users_deletion_scheduled ON users(deletion_scheduled_at) WHERE deletion_scheduled_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_users_deletion_scheduled;
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE users DROP COLUMN IF EXISTS deletion_scheduled_at;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- BOOKMARK COLLECTIONS (named reading lists, public ones are shared at /collections/{id})
+CREATE TABLE bookmark_collections (
+  id UUID PRIMARY KEY,               -- generated by app
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  is_public BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_bookmark_collections_user_name ON bookmark_collections(user_id, LOWER(name));
+
+-- A bookmark is in at most one collection, and carries a private note and
+-- whether it was read
+ALTER TABLE bookmarks ADD COLUMN collection_id UUID REFERENCES bookmark_collections(id) ON DELETE SET NULL;
+ALTER TABLE bookmarks ADD COLUMN note TEXT NOT NULL DEFAULT '';
+ALTER TABLE bookmarks ADD COLUMN read_at TIMESTAMPTZ;
+
+CREATE INDEX idx_bookmarks_collection ON bookmarks(collection_id, created_at) WHERE collection_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bookmarks_collection;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS read_at;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS note;
+ALTER TABLE bookmarks DROP COLUMN IF EXISTS collection_id;
+DROP INDEX IF EXISTS idx_bookmark_collections_user_name;
+DROP TABLE IF EXISTS bookmark_collections;
@@ -0,0 +1,41 @@
+-- +goose Up
+-- POST VIEWS (one row per visitor, post and day; visitors are hashed and rows
+-- are pruned once older than the longest ranking window)
+CREATE TABLE post_views (
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  visitor_key TEXT NOT NULL,          -- sha256 of the user, or of the IP and user agent
+  viewed_on DATE NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (post_id, visitor_key, viewed_on)
+);
+
+CREATE INDEX idx_post_views_created_at ON post_views(created_at);
+
+-- POST RANKINGS (time-decayed scores per window, rebuilt by the refresh-rankings job)
+CREATE TABLE post_rankings (
+  period TEXT NOT NULL,               -- day, week or month
+  post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+  score DOUBLE PRECISION NOT NULL,
+  likes_count INT NOT NULL,           -- events within the window
+  comments_count INT NOT NULL,
+  bookmarks_count INT NOT NULL,
+  views_count INT NOT NULL,
+  refreshed_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (period, post_id)
+);
+
+CREATE INDEX idx_post_rankings_score ON post_rankings(period, score DESC);
+
+-- The windows only look at recent events
+CREATE INDEX idx_reactions_created_at ON reactions(created_at) WHERE post_id IS NOT NULL;
+CREATE INDEX idx_comments_created_at ON comments(created_at);
+CREATE INDEX idx_bookmarks_created_at ON bookmarks(created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bookmarks_created_at;
+DROP INDEX IF EXISTS idx_comments_created_at;
+DROP INDEX IF EXISTS idx_reactions_created_at;
+DROP INDEX IF EXISTS idx_post_rankings_score;
+DROP TABLE IF EXISTS post_rankings;
+DROP INDEX IF EXISTS idx_post_views_created_at;
+DROP TABLE IF EXISTS post_views;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Slugs taken by the routes under /posts (domain.ReservedPostSlugs) would
+-- make their post unreachable: existing ones get a suffix.
+UPDATE posts
+SET slug = slug || '-' || left(md5(id::text), 6),
+    updated_at = NOW()
+WHERE slug IN ('trending');
+
+-- +goose Down
+-- The previous slugs are reserved, renamed posts keep their new one
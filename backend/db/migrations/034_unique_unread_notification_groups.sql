@@ -0,0 +1,20 @@
+-- +goose Up
+-- At most one unread notification per group, so concurrent events merge
+-- instead of racing into duplicates. Older duplicates are marked read.
+UPDATE notifications n
+SET read_at = NOW()
+WHERE n.read_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM notifications o
+    WHERE o.recipient_id = n.recipient_id
+      AND o.group_key = n.group_key
+      AND o.read_at IS NULL
+      AND (o.updated_at, o.id) > (n.updated_at, n.id)
+  );
+
+DROP INDEX IF EXISTS idx_notifications_unread_group;
+CREATE UNIQUE INDEX idx_notifications_unread_group ON notifications(recipient_id, group_key) WHERE read_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_notifications_unread_group;
+CREATE INDEX idx_notifications_unread_group ON notifications(recipient_id, group_key) WHERE read_at IS NULL;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE comments
+  ADD COLUMN depth INTEGER NOT NULL DEFAULT 0; -- 0 for top level comments, parent depth + 1 for replies
+
+WITH RECURSIVE tree AS (
+  SELECT id, 0 AS depth FROM comments WHERE parent_id IS NULL
+  UNION ALL
+  SELECT c.id, tree.depth + 1 FROM comments c JOIN tree ON c.parent_id = tree.id
+)
+UPDATE comments c
+SET depth = tree.depth
+FROM tree
+WHERE c.id = tree.id AND tree.depth > 0;
+
+-- +goose Down
+ALTER TABLE comments
+  DROP COLUMN IF EXISTS depth;
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	DeletionAnonymize = "anonymize"
+	DeletionRemove    = "remove"
+)
+
+// DeletedUsername is shown in place of the name of deleted accounts.
+const DeletedUsername = "Deleted user"
+
+// DeletionPolicy says what becomes of each kind of content of a deleted
+// account: removed, or kept and attributed to the anonymised account.
+type DeletionPolicy struct {
+	Posts     string
+	Comments  string
+	Likes     string
+	Bookmarks string
+	Follows   string
+}
+
+// DefaultDeletionPolicy keeps the conversations others took part in
+// readable and the like counts right, and removes the rest.
+func DefaultDeletionPolicy() DeletionPolicy {
+	return DeletionPolicy{
+		Posts:     DeletionRemove,
+		Comments:  DeletionAnonymize,
+		Likes:     DeletionAnonymize,
+		Bookmarks: DeletionRemove,
+		Follows:   DeletionRemove,
+	}
+}
+
+// ParseDeletionPolicy reads a policy such as "comments=remove,likes=remove"
+// over the default one.
+func ParseDeletionPolicy(raw string) (DeletionPolicy, error) {
+	policy := DefaultDeletionPolicy()
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		kind, action, ok := strings.Cut(entry, "=")
+		action = strings.ToLower(strings.TrimSpace(action))
+		if !ok || (action != DeletionAnonymize && action != DeletionRemove) {
+			return policy, fmt.Errorf("invalid deletion policy entry: %s", entry)
+		}
+
+		switch strings.ToLower(strings.TrimSpace(kind)) {
+		case "posts":
+			policy.Posts = action
+		case "comments":
+			policy.Comments = action
+		case "likes":
+			policy.Likes = action
+		case "bookmarks":
+			policy.Bookmarks = action
+		case "follows":
+			policy.Follows = action
+		default:
+			return policy, fmt.Errorf("unknown deletion policy kind: %s", kind)
+		}
+	}
+
+	return policy, nil
+}
+
+func (u *User) ScheduleDeletion(at time.Time) {
+	u.DeletionScheduledAt = &at
+}
+
+func (u *User) CancelDeletion() {
+	u.DeletionScheduledAt = nil
+}
+
+func (u *User) IsDeletionScheduled() bool {
+	return u.DeletionScheduledAt != nil && u.DeletedAt == nil
+}
+
+func (u *User) IsDeleted() bool {
+	return u.DeletedAt != nil
+}
+
+// Anonymise drops everything that identifies the user from the account row
+// and locks it. The row stays for the content the policy keeps.
+func (u *User) Anonymise(now time.Time) {
+	id := strings.ReplaceAll(u.ID, "-", "")
+	if len(id) > 12 {
+		id = id[:12]
+	}
+
+	u.Email = "deleted-" + u.ID
+	u.Username = DeletedUsername
+	u.Handle = "deleted_" + strings.ToLower(id)
+	u.HandleChangedAt = nil
+	u.Role = RoleReader
+	u.DisplayName = ""
+	u.Bio = ""
+	u.AvatarURL = ""
+	u.Website = ""
+	u.Location = ""
+	u.SocialLinks = json.RawMessage("[]")
+	u.SuspendedAt = &now
+	u.DeletionScheduledAt = nil
+	u.DeletedAt = &now
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "testing"
+
+func TestParseDeletionPolicy(t *testing.T) {
+	withComments := DefaultDeletionPolicy()
+	withComments.Comments = DeletionRemove
+
+	everything := DeletionPolicy{
+		Posts:     DeletionAnonymize,
+		Comments:  DeletionRemove,
+		Likes:     DeletionRemove,
+		Bookmarks: DeletionAnonymize,
+		Follows:   DeletionAnonymize,
+	}
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    DeletionPolicy
+		wantErr bool
+	}{
+		{"empty keeps the default", "", DefaultDeletionPolicy(), false},
+		{"blank entries are skipped", " , ", DefaultDeletionPolicy(), false},
+		{"overrides one kind", "comments=remove", withComments, false},
+		{"case and spaces are ignored", " Comments = REMOVE ", withComments, false},
+		{"overrides every kind", "posts=anonymize,comments=remove,likes=remove,bookmarks=anonymize,follows=anonymize", everything, false},
+		{"unknown action", "comments=keep", DeletionPolicy{}, true},
+		{"missing action", "comments", DeletionPolicy{}, true},
+		{"unknown kind", "reactions=remove", DeletionPolicy{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got, err := ParseDeletionPolicy(tt.raw)
+
+			// Assert
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected ParseDeletionPolicy(%q) to fail", tt.raw)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDeletionPolicy(%q) failed: %v", tt.raw, err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseDeletionPolicy(%q) = %+v, expected %+v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Audit trail actions for user management. Report triage uses the
+// ReportAction constants.
+const (
+	AdminActionChangeRole    = "change_role"
+	AdminActionSuspendUser   = "suspend_user"
+	AdminActionUnsuspendUser = "unsuspend_user"
+	AdminActionDeleteUser    = "delete_user"
+
+	AdminActionCreateProcessorClient  = "create_processor_client"
+	AdminActionDisableProcessorClient = "disable_processor_client"
+)
+
+// AdminAction is an entry of the admins' audit trail. AdminID is kept when the
+// admin is deleted.
+type AdminAction struct {
+	ID         string    `sql:"id,primary"`
+	AdminID    string    `sql:"admin_id"`
+	Action     string    `sql:"action"`
+	ReportID   *string   `sql:"report_id"`
+	TargetType string    `sql:"target_type"`
+	TargetID   string    `sql:"target_id"`
+	Note       string    `sql:"note"`
+	CreatedAt  time.Time `sql:"created_at"`
+}
+
+func NewAdminAction(id string, adminID string, action string, reportID *string, targetType string, targetID string, note string) (*AdminAction, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if adminID == "" {
+		return nil, fmt.Errorf("admin ID cannot be empty")
+	}
+
+	if action == "" {
+		return nil, fmt.Errorf("action cannot be empty")
+	}
+
+	if targetType == "" || targetID == "" {
+		return nil, fmt.Errorf("target cannot be empty")
+	}
+
+	return &AdminAction{
+		ID:         id,
+		AdminID:    adminID,
+		Action:     action,
+		ReportID:   reportID,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Note:       note,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+func (a *AdminAction) TableName() string {
+	return "admin_actions"
+}
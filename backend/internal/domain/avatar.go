@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// MaxAvatarSize is the largest avatar image users can upload, in bytes.
+const MaxAvatarSize = 1 << 20
+
+var avatarContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// Avatar is the uploaded profile picture of a user.
+type Avatar struct {
+	UserID      string    `sql:"user_id,primary"`
+	ContentType string    `sql:"content_type"`
+	Data        []byte    `sql:"data"`
+	UpdatedAt   time.Time `sql:"updated_at"`
+}
+
+// NewAvatar checks the image is small enough and a PNG, JPEG, GIF or WebP,
+// going by its content rather than what the client claims.
+func NewAvatar(userID string, data []byte) (*Avatar, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID cannot be empty")
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("avatar cannot be empty")
+	}
+
+	if len(data) > MaxAvatarSize {
+		return nil, fmt.Errorf("avatar cannot be larger than %d KB", MaxAvatarSize/1024)
+	}
+
+	contentType := http.DetectContentType(data)
+	if !avatarContentTypes[contentType] {
+		return nil, fmt.Errorf("avatar must be a PNG, JPEG, GIF or WebP image")
+	}
+
+	return &Avatar{
+		UserID:      userID,
+		ContentType: contentType,
+		Data:        data,
+		UpdatedAt:   time.Now(),
+	}, nil
+}
+
+func (a *Avatar) TableName() string {
+	return "avatars"
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type Block struct {
+	ID        string    `sql:"id,primary"`
+	BlockerID string    `sql:"blocker_id"`
+	BlockedID string    `sql:"blocked_id"`
+	CreatedAt time.Time `sql:"created_at"`
+}
+
+func NewBlock(id string, blockerID string, blockedID string) (*Block, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if blockerID == "" {
+		return nil, fmt.Errorf("blocker ID cannot be empty")
+	}
+
+	if blockedID == "" {
+		return nil, fmt.Errorf("blocked ID cannot be empty")
+	}
+
+	if blockerID == blockedID {
+		return nil, fmt.Errorf("cannot block yourself")
+	}
+
+	return &Block{
+		ID:        id,
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (b *Block) TableName() string {
+	return "user_blocks"
+}
@@ -2,14 +2,22 @@ package domain
 
 import (
 	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
+// MaxBookmarkNote is the maximum length of the private note on a bookmark.
+const MaxBookmarkNote = 2000
+
 type Bookmark struct {
-	ID        string    `sql:"id,primary"`
-	UserID    string    `sql:"user_id"`
-	PostID    string    `sql:"post_id"`
-	CreatedAt time.Time `sql:"created_at"`
+	ID           string     `sql:"id,primary"`
+	UserID       string     `sql:"user_id"`
+	PostID       string     `sql:"post_id"`
+	CreatedAt    time.Time  `sql:"created_at"`
+	CollectionID *string    `sql:"collection_id"`
+	Note         string     `sql:"note"`
+	ReadAt       *time.Time `sql:"read_at"`
 }
 
 func NewBookmark(id string, userID string, postID string) (*Bookmark, error) {
@@ -33,6 +41,32 @@ func NewBookmark(id string, userID string, postID string) (*Bookmark, error) {
 	}, nil
 }
 
+// SetNote sets the private note of the bookmark, an empty note removes it.
+func (b *Bookmark) SetNote(note string) error {
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > MaxBookmarkNote {
+		return fmt.Errorf("note cannot be longer than %d characters", MaxBookmarkNote)
+	}
+
+	b.Note = note
+	return nil
+}
+
+// SetRead marks the bookmarked post as read or unread. Marking it read again
+// keeps the first read time.
+func (b *Bookmark) SetRead(read bool, now time.Time) {
+	switch {
+	case !read:
+		b.ReadAt = nil
+	case b.ReadAt == nil:
+		b.ReadAt = &now
+	}
+}
+
+func (b *Bookmark) IsRead() bool {
+	return b.ReadAt != nil
+}
+
 func (b *Bookmark) TableName() string {
 	return "bookmarks"
 }
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MaxCollectionName        = 100
+	MaxCollectionDescription = 500
+)
+
+// BookmarkCollection is a named list of bookmarks. Public collections can be
+// read by anyone, without the notes and read status of their bookmarks.
+type BookmarkCollection struct {
+	ID          string    `sql:"id,primary"`
+	UserID      string    `sql:"user_id"`
+	Name        string    `sql:"name"`
+	Description string    `sql:"description"`
+	IsPublic    bool      `sql:"is_public"`
+	CreatedAt   time.Time `sql:"created_at"`
+	UpdatedAt   time.Time `sql:"updated_at"`
+}
+
+func NewBookmarkCollection(id string, userID string, name string, description string, isPublic bool) (*BookmarkCollection, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if userID == "" {
+		return nil, fmt.Errorf("user ID cannot be empty")
+	}
+
+	now := time.Now()
+	collection := &BookmarkCollection{
+		ID:        id,
+		UserID:    userID,
+		CreatedAt: now,
+	}
+
+	err := collection.Update(name, description, isPublic, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+// Update renames and describes the collection, and makes it public or private.
+func (c *BookmarkCollection) Update(name string, description string, isPublic bool, now time.Time) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if utf8.RuneCountInString(name) > MaxCollectionName {
+		return fmt.Errorf("name cannot be longer than %d characters", MaxCollectionName)
+	}
+
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > MaxCollectionDescription {
+		return fmt.Errorf("description cannot be longer than %d characters", MaxCollectionDescription)
+	}
+
+	c.Name = name
+	c.Description = description
+	c.IsPublic = isPublic
+	c.UpdatedAt = now
+
+	return nil
+}
+
+func (c *BookmarkCollection) TableName() string {
+	return "bookmark_collections"
+}
@@ -5,14 +5,28 @@ import (
 	"time"
 )
 
+const (
+	CommentStatusPending  = "pending"
+	CommentStatusApproved = "approved"
+	CommentStatusRejected = "rejected"
+	CommentStatusHidden   = "hidden"
+)
+
 type Comment struct {
-	ID        string    `sql:"id,primary"`
-	PostID    string    `sql:"post_id"`
-	AuthorID  string    `sql:"author_id"`
-	ParentID  *string   `sql:"parent_id"`
-	Body      string    `sql:"body"`
-	CreatedAt time.Time `sql:"created_at"`
-	UpdatedAt time.Time `sql:"updated_at"`
+	ID               string     `sql:"id,primary"`
+	PostID           string     `sql:"post_id"`
+	AuthorID         string     `sql:"author_id"`
+	ParentID         *string    `sql:"parent_id"`
+	Body             string     `sql:"body"`
+	ReplyCount       int        `sql:"reply_count"`
+	Depth            int        `sql:"depth"`
+	CreatedAt        time.Time  `sql:"created_at"`
+	UpdatedAt        time.Time  `sql:"updated_at"`
+	EditedAt         *time.Time `sql:"edited_at"`
+	DeletedAt        *time.Time `sql:"deleted_at"`
+	Status           string     `sql:"status"`
+	ModerationReason *string    `sql:"moderation_reason"`
+	ModeratedAt      *time.Time `sql:"moderated_at"`
 }
 
 func NewComment(id string, postID string, authorID string, body string) (*Comment, error) {
@@ -34,40 +48,105 @@ func NewComment(id string, postID string, authorID string, body string) (*Commen
 
 	now := time.Now()
 	return &Comment{
-		ID:        id,
-		PostID:    postID,
-		AuthorID:  authorID,
-		ParentID:  nil,
-		Body:      body,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:         id,
+		PostID:     postID,
+		AuthorID:   authorID,
+		ParentID:   nil,
+		Body:       body,
+		ReplyCount: 0,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Status:     CommentStatusApproved,
 	}, nil
 }
 
-func NewReplyComment(id string, postID string, authorID string, parentID string, body string) (*Comment, error) {
+// NewReplyComment creates a reply one level deeper than its parent.
+func NewReplyComment(id string, postID string, authorID string, parent *Comment, body string) (*Comment, error) {
 	comment, err := NewComment(id, postID, authorID, body)
 	if err != nil {
 		return nil, err
 	}
 
-	if parentID == "" {
+	if parent == nil || parent.ID == "" {
 		return nil, fmt.Errorf("parent ID cannot be empty for reply comment")
 	}
 
-	comment.ParentID = &parentID
+	comment.ParentID = &parent.ID
+	comment.Depth = parent.Depth + 1
 	return comment, nil
 }
 
 func (c *Comment) UpdateBody(body string) error {
+	if c.IsDeleted() {
+		return fmt.Errorf("deleted comments cannot be edited")
+	}
+
 	if body == "" {
 		return fmt.Errorf("body cannot be empty")
 	}
 
+	now := time.Now()
 	c.Body = body
-	c.UpdatedAt = time.Now()
+	c.UpdatedAt = now
+	c.EditedAt = &now
 	return nil
 }
 
+// Tombstone keeps the comment row so its replies stay attached, but drops
+// the body.
+func (c *Comment) Tombstone() {
+	now := time.Now()
+	c.Body = ""
+	c.UpdatedAt = now
+	c.DeletedAt = &now
+}
+
+func (c *Comment) IsEdited() bool {
+	return c.EditedAt != nil
+}
+
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+// Hold keeps the comment out of the public thread until the post author
+// reviews it.
+func (c *Comment) Hold(reason string) {
+	c.Status = CommentStatusPending
+	c.ModerationReason = &reason
+}
+
+func (c *Comment) Approve() {
+	now := time.Now()
+	c.Status = CommentStatusApproved
+	c.ModerationReason = nil
+	c.ModeratedAt = &now
+}
+
+func (c *Comment) Reject(reason string) {
+	now := time.Now()
+	c.Status = CommentStatusRejected
+	c.ModerationReason = &reason
+	c.ModeratedAt = &now
+}
+
+// Hide takes the comment down after a report. Unlike a rejection, the post
+// author cannot approve it again.
+func (c *Comment) Hide(reason string) {
+	now := time.Now()
+	c.Status = CommentStatusHidden
+	c.ModerationReason = &reason
+	c.ModeratedAt = &now
+}
+
+func (c *Comment) IsHidden() bool {
+	return c.Status == CommentStatusHidden
+}
+
+func (c *Comment) IsApproved() bool {
+	return c.Status == CommentStatusApproved
+}
+
 func (c *Comment) TableName() string {
 	return "comments"
 }
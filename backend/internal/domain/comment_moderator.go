@@ -0,0 +1,20 @@
+package domain
+
+import "context"
+
+type ModerationVerdict struct {
+	Status string
+	Reason string
+}
+
+// CommentModerator decides whether a new comment goes live, waits for the
+// post author or is rejected.
+type CommentModerator interface {
+	Moderate(ctx context.Context, comment *Comment) (*ModerationVerdict, error)
+}
+
+// CommentClassifier labels a comment body as spam or abuse, usually through
+// an LLM. The returned status is one of the comment statuses.
+type CommentClassifier interface {
+	ClassifyComment(ctx context.Context, body string) (*ModerationVerdict, error)
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type CommentRevision struct {
+	ID        string    `sql:"id,primary"`
+	CommentID string    `sql:"comment_id"`
+	EditorID  string    `sql:"editor_id"`
+	Body      string    `sql:"body"`
+	CreatedAt time.Time `sql:"created_at"`
+}
+
+func NewCommentRevision(id string, commentID string, editorID string, body string) (*CommentRevision, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if commentID == "" {
+		return nil, fmt.Errorf("comment ID cannot be empty")
+	}
+
+	if editorID == "" {
+		return nil, fmt.Errorf("editor ID cannot be empty")
+	}
+
+	if body == "" {
+		return nil, fmt.Errorf("body cannot be empty")
+	}
+
+	return &CommentRevision{
+		ID:        id,
+		CommentID: commentID,
+		EditorID:  editorID,
+		Body:      body,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (r *CommentRevision) TableName() string {
+	return "comment_revisions"
+}
@@ -0,0 +1,63 @@
+package customdao
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"blog0/internal/domain"
+)
+
+// PostCard is a published post with its author and counts, as listed to
+// readers.
+type PostCard struct {
+	ID              string
+	Title           string
+	Slug            string
+	Summary         string
+	Tags            json.RawMessage
+	PublishedAt     time.Time
+	SummaryAudioURL *string
+	AuthorID        string
+	AuthorHandle    string
+	AuthorName      string
+	AuthorAvatarURL string
+	LikesCount      int
+	CommentsCount   int
+}
+
+type BookmarkedPost struct {
+	Bookmark domain.Bookmark
+	Post     PostCard
+}
+
+type LikedPost struct {
+	LikedAt time.Time
+	Post    PostCard
+}
+
+type FollowedUser struct {
+	FollowedAt     time.Time
+	ID             string
+	Handle         string
+	Name           string
+	AvatarURL      string
+	Bio            string
+	PostsCount     int
+	FollowersCount int
+}
+
+// ActivityDAO lists the bookmarks, likes and follows of users with what
+// they point to, one query per page instead of a lookup per row. Where
+// clauses filter the bookmarks, reactions or follows tables, rows come most
+// recent first.
+type ActivityDAO interface {
+	// FindBookmarkedPosts finds bookmarks with their post
+	FindBookmarkedPosts(ctx context.Context, limit, offset int, where string, args ...interface{}) ([]*BookmarkedPost, error)
+
+	// FindLikedPosts finds post reactions with their post
+	FindLikedPosts(ctx context.Context, limit, offset int, where string, args ...interface{}) ([]*LikedPost, error)
+
+	// FindFollowedUsers finds follows with the followed user
+	FindFollowedUsers(ctx context.Context, limit, offset int, where string, args ...interface{}) ([]*FollowedUser, error)
+}
@@ -0,0 +1,11 @@
+package customdao
+
+import "context"
+
+// IdentityDAO guards the sign-in methods of users against concurrent
+// unlinks.
+type IdentityDAO interface {
+	// LockLinked locks the identities of the user until the end of the
+	// transaction of the context, and returns how many there are
+	LockLinked(ctx context.Context, userID string) (int, error)
+}
@@ -0,0 +1,15 @@
+package customdao
+
+import (
+	"context"
+
+	"blog0/internal/domain"
+)
+
+// NotificationGroupDAO merges notifications into their unread group in one
+// statement, so concurrent events can't create the group twice.
+type NotificationGroupDAO interface {
+	// MergeUnread creates the notification, or adds its actor last to the
+	// unread notification of its group, and returns the stored one
+	MergeUnread(ctx context.Context, m *domain.Notification) (*domain.Notification, error)
+}
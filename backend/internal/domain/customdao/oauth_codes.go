@@ -0,0 +1,15 @@
+package customdao
+
+import (
+	"context"
+
+	"blog0/internal/domain"
+)
+
+// OAuthCodeDAO consumes one-time sign-in codes in a single statement, so a
+// code can't be exchanged twice by concurrent requests.
+type OAuthCodeDAO interface {
+	// Consume deletes the code with the hash and returns it. It returns
+	// sql.ErrNoRows when there is none, or another request consumed it first
+	Consume(ctx context.Context, codeHash string) (*domain.OAuthCode, error)
+}
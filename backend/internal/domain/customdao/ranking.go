@@ -0,0 +1,50 @@
+package customdao
+
+import (
+	"context"
+	"time"
+
+	"blog0/internal/domain"
+)
+
+// RankedPost is a trending post of a ranking window, with the events that
+// happened within it.
+type RankedPost struct {
+	Score          float64
+	LikesCount     int
+	CommentsCount  int
+	BookmarksCount int
+	ViewsCount     int
+	RefreshedAt    time.Time
+	Post           PostCard
+}
+
+// RankingDAO records post views and keeps the post_rankings table, where
+// the time-decayed scores of the recently active posts are materialised.
+type RankingDAO interface {
+	// InsertView records the view of a post by a visitor unless they already
+	// viewed it that day, and tells whether it did
+	InsertView(ctx context.Context, postID string, visitorKey string, now time.Time) (bool, error)
+
+	// DeleteViewsBefore prunes the views older than before
+	DeleteViewsBefore(ctx context.Context, before time.Time) (int64, error)
+
+	// Refresh scores the published posts with likes, comments, bookmarks or
+	// views within the window, replaces the window rankings and tells how
+	// many posts are ranked
+	Refresh(ctx context.Context, window domain.RankingWindow, weights domain.RankingWeights, now time.Time) (int64, error)
+
+	// FindRankedPosts finds rankings with their post, best score first. Where
+	// clauses filter the post_rankings table
+	FindRankedPosts(ctx context.Context, limit, offset int, where string, args ...interface{}) ([]*RankedPost, error)
+
+	// CountRankedPosts counts the rankings FindRankedPosts finds
+	CountRankedPosts(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// FindMostLikedPosts finds posts with their author, most liked first.
+	// Where clauses filter the posts table
+	FindMostLikedPosts(ctx context.Context, limit int, where string, args ...interface{}) ([]*PostCard, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
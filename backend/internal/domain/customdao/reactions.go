@@ -0,0 +1,20 @@
+package customdao
+
+import "context"
+
+// ReactionTally is how many times an emoji was picked on a post or comment.
+type ReactionTally struct {
+	TargetID string
+	Emoji    string
+	Count    int
+}
+
+// ReactionTallyDAO counts reactions in the database, popular posts have too
+// many to load.
+type ReactionTallyDAO interface {
+	// CountForPosts tallies the reactions of the posts per emoji
+	CountForPosts(ctx context.Context, postIDs []string) ([]*ReactionTally, error)
+
+	// CountForComments tallies the reactions of the comments per emoji
+	CountForComments(ctx context.Context, commentIDs []string) ([]*ReactionTally, error)
+}
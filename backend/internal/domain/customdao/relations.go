@@ -0,0 +1,39 @@
+// Package customdao declares the hand-written DAOs, for what gormless
+// doesn't generate. `make gen` wipes the generated dao package, not this one.
+package customdao
+
+import (
+	"context"
+
+	"blog0/internal/domain"
+)
+
+// RelationDAO writes the follows, blocks, mutes, bookmarks, reactions and
+// mentions of users. The generated DAOs fail on a unique key conflict, these
+// inserts do nothing instead, so concurrent or retried requests can't fail or
+// double count.
+type RelationDAO interface {
+	// InsertFollow creates the follow unless the follower already follows
+	// the followee, and tells whether it did
+	InsertFollow(ctx context.Context, m *domain.Follow) (bool, error)
+
+	// InsertBlock creates the block unless the blocker already blocked the
+	// user, and tells whether it did
+	InsertBlock(ctx context.Context, m *domain.Block) (bool, error)
+
+	// InsertMute creates the mute unless the muter already muted the user,
+	// and tells whether it did
+	InsertMute(ctx context.Context, m *domain.Mute) (bool, error)
+
+	// InsertBookmark creates the bookmark unless the user already bookmarked
+	// the post, and tells whether it did
+	InsertBookmark(ctx context.Context, m *domain.Bookmark) (bool, error)
+
+	// InsertReaction creates the reaction unless the user already reacted
+	// with this emoji to the post or comment, and tells whether it did
+	InsertReaction(ctx context.Context, m *domain.Reaction) (bool, error)
+
+	// InsertMention creates the mention unless the post body or comment
+	// already mentions the user, and tells whether it did
+	InsertMention(ctx context.Context, m *domain.Mention) (bool, error)
+}
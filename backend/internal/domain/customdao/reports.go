@@ -0,0 +1,15 @@
+package customdao
+
+import (
+	"context"
+
+	"blog0/internal/domain"
+)
+
+// ReportDAO files reports. A reporter has at most one open report per
+// target, so the same report sent twice at once is only filed once.
+type ReportDAO interface {
+	// InsertOpen creates the report unless the reporter already has an open
+	// report on the target, and tells whether it did
+	InsertOpen(ctx context.Context, m *domain.Report) (bool, error)
+}
@@ -0,0 +1,26 @@
+package customdao
+
+import (
+	"context"
+	"time"
+
+	"blog0/internal/domain"
+)
+
+// SessionRotation is what a refresh changes on a session.
+type SessionRotation struct {
+	RefreshTokenHash string
+	UserAgent        string
+	IP               string
+	Now              time.Time
+	ExpiresAt        time.Time
+}
+
+// SessionRotationDAO rotates refresh tokens in a single statement, so a
+// token can't be exchanged twice by concurrent refreshes.
+type SessionRotationDAO interface {
+	// Rotate swaps the refresh token of the active session holding the old
+	// one, remembering it to detect its reuse. It returns sql.ErrNoRows when
+	// no active session holds the token, or another refresh took it first
+	Rotate(ctx context.Context, oldTokenHash string, r *SessionRotation) (*domain.Session, error)
+}
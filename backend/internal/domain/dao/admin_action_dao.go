@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type AdminAction = domain.AdminAction
+
+type AdminActionDAO interface {
+	// Create creates a new AdminAction
+	Create(ctx context.Context, m *AdminAction) error
+
+	// Update updates an existing AdminAction
+	Update(ctx context.Context, m *AdminAction) error
+
+	// PartialUpdate updates specific fields of a AdminAction
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a AdminAction by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a AdminAction by primary key
+	FindByPk(ctx context.Context, pk string) (*AdminAction, error)
+
+	// CreateMany creates multiple AdminAction records
+	CreateMany(ctx context.Context, models []*AdminAction) error
+
+	// UpdateMany updates multiple AdminAction records
+	UpdateMany(ctx context.Context, models []*AdminAction) error
+
+	// DeleteManyByPks deletes multiple AdminAction records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single AdminAction with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*AdminAction, error)
+
+	// FindAll finds all AdminAction records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*AdminAction, error)
+
+	// FindPaginated finds AdminAction records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*AdminAction, error)
+
+	// Count counts AdminAction records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
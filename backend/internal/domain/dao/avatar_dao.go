@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type Avatar = domain.Avatar
+
+type AvatarDAO interface {
+	// Create creates a new Avatar
+	Create(ctx context.Context, m *Avatar) error
+
+	// Update updates an existing Avatar
+	Update(ctx context.Context, m *Avatar) error
+
+	// PartialUpdate updates specific fields of a Avatar
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Avatar by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Avatar by primary key
+	FindByPk(ctx context.Context, pk string) (*Avatar, error)
+
+	// CreateMany creates multiple Avatar records
+	CreateMany(ctx context.Context, models []*Avatar) error
+
+	// UpdateMany updates multiple Avatar records
+	UpdateMany(ctx context.Context, models []*Avatar) error
+
+	// DeleteManyByPks deletes multiple Avatar records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Avatar with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Avatar, error)
+
+	// FindAll finds all Avatar records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Avatar, error)
+
+	// FindPaginated finds Avatar records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Avatar, error)
+
+	// Count counts Avatar records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
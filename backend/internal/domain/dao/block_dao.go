@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type Block = domain.Block
+
+type BlockDAO interface {
+	// Create creates a new Block
+	Create(ctx context.Context, m *Block) error
+
+	// Update updates an existing Block
+	Update(ctx context.Context, m *Block) error
+
+	// PartialUpdate updates specific fields of a Block
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Block by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Block by primary key
+	FindByPk(ctx context.Context, pk string) (*Block, error)
+
+	// CreateMany creates multiple Block records
+	CreateMany(ctx context.Context, models []*Block) error
+
+	// UpdateMany updates multiple Block records
+	UpdateMany(ctx context.Context, models []*Block) error
+
+	// DeleteManyByPks deletes multiple Block records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Block with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Block, error)
+
+	// FindAll finds all Block records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Block, error)
+
+	// FindPaginated finds Block records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Block, error)
+
+	// Count counts Block records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type BookmarkCollection = domain.BookmarkCollection
+
+type BookmarkCollectionDAO interface {
+	// Create creates a new BookmarkCollection
+	Create(ctx context.Context, m *BookmarkCollection) error
+
+	// Update updates an existing BookmarkCollection
+	Update(ctx context.Context, m *BookmarkCollection) error
+
+	// PartialUpdate updates specific fields of a BookmarkCollection
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a BookmarkCollection by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a BookmarkCollection by primary key
+	FindByPk(ctx context.Context, pk string) (*BookmarkCollection, error)
+
+	// CreateMany creates multiple BookmarkCollection records
+	CreateMany(ctx context.Context, models []*BookmarkCollection) error
+
+	// UpdateMany updates multiple BookmarkCollection records
+	UpdateMany(ctx context.Context, models []*BookmarkCollection) error
+
+	// DeleteManyByPks deletes multiple BookmarkCollection records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single BookmarkCollection with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*BookmarkCollection, error)
+
+	// FindAll finds all BookmarkCollection records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*BookmarkCollection, error)
+
+	// FindPaginated finds BookmarkCollection records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*BookmarkCollection, error)
+
+	// Count counts BookmarkCollection records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
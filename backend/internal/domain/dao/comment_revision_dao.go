@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type CommentRevision = domain.CommentRevision
+
+type CommentRevisionDAO interface {
+	// Create creates a new CommentRevision
+	Create(ctx context.Context, m *CommentRevision) error
+
+	// Update updates an existing CommentRevision
+	Update(ctx context.Context, m *CommentRevision) error
+
+	// PartialUpdate updates specific fields of a CommentRevision
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a CommentRevision by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a CommentRevision by primary key
+	FindByPk(ctx context.Context, pk string) (*CommentRevision, error)
+
+	// CreateMany creates multiple CommentRevision records
+	CreateMany(ctx context.Context, models []*CommentRevision) error
+
+	// UpdateMany updates multiple CommentRevision records
+	UpdateMany(ctx context.Context, models []*CommentRevision) error
+
+	// DeleteManyByPks deletes multiple CommentRevision records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single CommentRevision with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*CommentRevision, error)
+
+	// FindAll finds all CommentRevision records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*CommentRevision, error)
+
+	// FindPaginated finds CommentRevision records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*CommentRevision, error)
+
+	// Count counts CommentRevision records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type HandleAlias = domain.HandleAlias
+
+type HandleAliasDAO interface {
+	// Create creates a new HandleAlias
+	Create(ctx context.Context, m *HandleAlias) error
+
+	// Update updates an existing HandleAlias
+	Update(ctx context.Context, m *HandleAlias) error
+
+	// PartialUpdate updates specific fields of a HandleAlias
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a HandleAlias by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a HandleAlias by primary key
+	FindByPk(ctx context.Context, pk string) (*HandleAlias, error)
+
+	// CreateMany creates multiple HandleAlias records
+	CreateMany(ctx context.Context, models []*HandleAlias) error
+
+	// UpdateMany updates multiple HandleAlias records
+	UpdateMany(ctx context.Context, models []*HandleAlias) error
+
+	// DeleteManyByPks deletes multiple HandleAlias records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single HandleAlias with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*HandleAlias, error)
+
+	// FindAll finds all HandleAlias records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*HandleAlias, error)
+
+	// FindPaginated finds HandleAlias records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*HandleAlias, error)
+
+	// Count counts HandleAlias records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type Identity = domain.Identity
+
+type IdentityDAO interface {
+	// Create creates a new Identity
+	Create(ctx context.Context, m *Identity) error
+
+	// Update updates an existing Identity
+	Update(ctx context.Context, m *Identity) error
+
+	// PartialUpdate updates specific fields of a Identity
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Identity by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Identity by primary key
+	FindByPk(ctx context.Context, pk string) (*Identity, error)
+
+	// CreateMany creates multiple Identity records
+	CreateMany(ctx context.Context, models []*Identity) error
+
+	// UpdateMany updates multiple Identity records
+	UpdateMany(ctx context.Context, models []*Identity) error
+
+	// DeleteManyByPks deletes multiple Identity records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Identity with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Identity, error)
+
+	// FindAll finds all Identity records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Identity, error)
+
+	// FindPaginated finds Identity records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Identity, error)
+
+	// Count counts Identity records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type Mention = domain.Mention
+
+type MentionDAO interface {
+	// Create creates a new Mention
+	Create(ctx context.Context, m *Mention) error
+
+	// Update updates an existing Mention
+	Update(ctx context.Context, m *Mention) error
+
+	// PartialUpdate updates specific fields of a Mention
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Mention by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Mention by primary key
+	FindByPk(ctx context.Context, pk string) (*Mention, error)
+
+	// CreateMany creates multiple Mention records
+	CreateMany(ctx context.Context, models []*Mention) error
+
+	// UpdateMany updates multiple Mention records
+	UpdateMany(ctx context.Context, models []*Mention) error
+
+	// DeleteManyByPks deletes multiple Mention records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Mention with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Mention, error)
+
+	// FindAll finds all Mention records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Mention, error)
+
+	// FindPaginated finds Mention records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Mention, error)
+
+	// Count counts Mention records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type Mute = domain.Mute
+
+type MuteDAO interface {
+	// Create creates a new Mute
+	Create(ctx context.Context, m *Mute) error
+
+	// Update updates an existing Mute
+	Update(ctx context.Context, m *Mute) error
+
+	// PartialUpdate updates specific fields of a Mute
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Mute by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Mute by primary key
+	FindByPk(ctx context.Context, pk string) (*Mute, error)
+
+	// CreateMany creates multiple Mute records
+	CreateMany(ctx context.Context, models []*Mute) error
+
+	// UpdateMany updates multiple Mute records
+	UpdateMany(ctx context.Context, models []*Mute) error
+
+	// DeleteManyByPks deletes multiple Mute records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Mute with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Mute, error)
+
+	// FindAll finds all Mute records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Mute, error)
+
+	// FindPaginated finds Mute records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Mute, error)
+
+	// Count counts Mute records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
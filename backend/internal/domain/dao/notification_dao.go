@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type Notification = domain.Notification
+
+type NotificationDAO interface {
+	// Create creates a new Notification
+	Create(ctx context.Context, m *Notification) error
+
+	// Update updates an existing Notification
+	Update(ctx context.Context, m *Notification) error
+
+	// PartialUpdate updates specific fields of a Notification
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Notification by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Notification by primary key
+	FindByPk(ctx context.Context, pk string) (*Notification, error)
+
+	// CreateMany creates multiple Notification records
+	CreateMany(ctx context.Context, models []*Notification) error
+
+	// UpdateMany updates multiple Notification records
+	UpdateMany(ctx context.Context, models []*Notification) error
+
+	// DeleteManyByPks deletes multiple Notification records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Notification with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Notification, error)
+
+	// FindAll finds all Notification records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Notification, error)
+
+	// FindPaginated finds Notification records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Notification, error)
+
+	// Count counts Notification records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
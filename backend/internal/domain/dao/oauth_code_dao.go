@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type OAuthCode = domain.OAuthCode
+
+type OAuthCodeDAO interface {
+	// Create creates a new OAuthCode
+	Create(ctx context.Context, m *OAuthCode) error
+
+	// Update updates an existing OAuthCode
+	Update(ctx context.Context, m *OAuthCode) error
+
+	// PartialUpdate updates specific fields of a OAuthCode
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a OAuthCode by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a OAuthCode by primary key
+	FindByPk(ctx context.Context, pk string) (*OAuthCode, error)
+
+	// CreateMany creates multiple OAuthCode records
+	CreateMany(ctx context.Context, models []*OAuthCode) error
+
+	// UpdateMany updates multiple OAuthCode records
+	UpdateMany(ctx context.Context, models []*OAuthCode) error
+
+	// DeleteManyByPks deletes multiple OAuthCode records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single OAuthCode with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*OAuthCode, error)
+
+	// FindAll finds all OAuthCode records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*OAuthCode, error)
+
+	// FindPaginated finds OAuthCode records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*OAuthCode, error)
+
+	// Count counts OAuthCode records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type PersonalAccessToken = domain.PersonalAccessToken
+
+type PersonalAccessTokenDAO interface {
+	// Create creates a new PersonalAccessToken
+	Create(ctx context.Context, m *PersonalAccessToken) error
+
+	// Update updates an existing PersonalAccessToken
+	Update(ctx context.Context, m *PersonalAccessToken) error
+
+	// PartialUpdate updates specific fields of a PersonalAccessToken
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a PersonalAccessToken by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a PersonalAccessToken by primary key
+	FindByPk(ctx context.Context, pk string) (*PersonalAccessToken, error)
+
+	// CreateMany creates multiple PersonalAccessToken records
+	CreateMany(ctx context.Context, models []*PersonalAccessToken) error
+
+	// UpdateMany updates multiple PersonalAccessToken records
+	UpdateMany(ctx context.Context, models []*PersonalAccessToken) error
+
+	// DeleteManyByPks deletes multiple PersonalAccessToken records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single PersonalAccessToken with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*PersonalAccessToken, error)
+
+	// FindAll finds all PersonalAccessToken records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*PersonalAccessToken, error)
+
+	// FindPaginated finds PersonalAccessToken records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*PersonalAccessToken, error)
+
+	// Count counts PersonalAccessToken records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type ProcessorAuditLog = domain.ProcessorAuditLog
+
+type ProcessorAuditLogDAO interface {
+	// Create creates a new ProcessorAuditLog
+	Create(ctx context.Context, m *ProcessorAuditLog) error
+
+	// Update updates an existing ProcessorAuditLog
+	Update(ctx context.Context, m *ProcessorAuditLog) error
+
+	// PartialUpdate updates specific fields of a ProcessorAuditLog
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a ProcessorAuditLog by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a ProcessorAuditLog by primary key
+	FindByPk(ctx context.Context, pk string) (*ProcessorAuditLog, error)
+
+	// CreateMany creates multiple ProcessorAuditLog records
+	CreateMany(ctx context.Context, models []*ProcessorAuditLog) error
+
+	// UpdateMany updates multiple ProcessorAuditLog records
+	UpdateMany(ctx context.Context, models []*ProcessorAuditLog) error
+
+	// DeleteManyByPks deletes multiple ProcessorAuditLog records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single ProcessorAuditLog with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*ProcessorAuditLog, error)
+
+	// FindAll finds all ProcessorAuditLog records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*ProcessorAuditLog, error)
+
+	// FindPaginated finds ProcessorAuditLog records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*ProcessorAuditLog, error)
+
+	// Count counts ProcessorAuditLog records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type ProcessorClient = domain.ProcessorClient
+
+type ProcessorClientDAO interface {
+	// Create creates a new ProcessorClient
+	Create(ctx context.Context, m *ProcessorClient) error
+
+	// Update updates an existing ProcessorClient
+	Update(ctx context.Context, m *ProcessorClient) error
+
+	// PartialUpdate updates specific fields of a ProcessorClient
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a ProcessorClient by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a ProcessorClient by primary key
+	FindByPk(ctx context.Context, pk string) (*ProcessorClient, error)
+
+	// CreateMany creates multiple ProcessorClient records
+	CreateMany(ctx context.Context, models []*ProcessorClient) error
+
+	// UpdateMany updates multiple ProcessorClient records
+	UpdateMany(ctx context.Context, models []*ProcessorClient) error
+
+	// DeleteManyByPks deletes multiple ProcessorClient records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single ProcessorClient with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*ProcessorClient, error)
+
+	// FindAll finds all ProcessorClient records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*ProcessorClient, error)
+
+	// FindPaginated finds ProcessorClient records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*ProcessorClient, error)
+
+	// Count counts ProcessorClient records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type Reaction = domain.Reaction
+
+type ReactionDAO interface {
+	// Create creates a new Reaction
+	Create(ctx context.Context, m *Reaction) error
+
+	// Update updates an existing Reaction
+	Update(ctx context.Context, m *Reaction) error
+
+	// PartialUpdate updates specific fields of a Reaction
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Reaction by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Reaction by primary key
+	FindByPk(ctx context.Context, pk string) (*Reaction, error)
+
+	// CreateMany creates multiple Reaction records
+	CreateMany(ctx context.Context, models []*Reaction) error
+
+	// UpdateMany updates multiple Reaction records
+	UpdateMany(ctx context.Context, models []*Reaction) error
+
+	// DeleteManyByPks deletes multiple Reaction records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Reaction with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Reaction, error)
+
+	// FindAll finds all Reaction records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Reaction, error)
+
+	// FindPaginated finds Reaction records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Reaction, error)
+
+	// Count counts Reaction records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type Report = domain.Report
+
+type ReportDAO interface {
+	// Create creates a new Report
+	Create(ctx context.Context, m *Report) error
+
+	// Update updates an existing Report
+	Update(ctx context.Context, m *Report) error
+
+	// PartialUpdate updates specific fields of a Report
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Report by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Report by primary key
+	FindByPk(ctx context.Context, pk string) (*Report, error)
+
+	// CreateMany creates multiple Report records
+	CreateMany(ctx context.Context, models []*Report) error
+
+	// UpdateMany updates multiple Report records
+	UpdateMany(ctx context.Context, models []*Report) error
+
+	// DeleteManyByPks deletes multiple Report records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Report with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Report, error)
+
+	// FindAll finds all Report records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Report, error)
+
+	// FindPaginated finds Report records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Report, error)
+
+	// Count counts Report records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"blog0/internal/domain"
+	"context"
+)
+
+type Session = domain.Session
+
+type SessionDAO interface {
+	// Create creates a new Session
+	Create(ctx context.Context, m *Session) error
+
+	// Update updates an existing Session
+	Update(ctx context.Context, m *Session) error
+
+	// PartialUpdate updates specific fields of a Session
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Session by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Session by primary key
+	FindByPk(ctx context.Context, pk string) (*Session, error)
+
+	// CreateMany creates multiple Session records
+	CreateMany(ctx context.Context, models []*Session) error
+
+	// UpdateMany updates multiple Session records
+	UpdateMany(ctx context.Context, models []*Session) error
+
+	// DeleteManyByPks deletes multiple Session records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Session with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Session, error)
+
+	// FindAll finds all Session records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Session, error)
+
+	// FindPaginated finds Session records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Session, error)
+
+	// Count counts Session records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
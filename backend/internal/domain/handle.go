@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	MinHandleLength = 3
+	MaxHandleLength = 30
+)
+
+var handlePattern = regexp.MustCompile(`^[a-z0-9_]{3,30}$`)
+
+// reservedHandles would clash with routes of the web app or pass for staff.
+var reservedHandles = map[string]bool{
+	"about": true, "admin": true, "administrator": true, "api": true,
+	"app": true, "auth": true, "blog0": true, "collections": true,
+	"feed": true, "help": true, "login": true, "logout": true,
+	"me": true, "moderator": true, "new": true, "null": true,
+	"posts": true, "privacy": true, "root": true, "search": true,
+	"settings": true, "signin": true, "signup": true, "staff": true,
+	"support": true, "system": true, "terms": true, "trending": true,
+	"undefined": true, "user": true, "users": true, "www": true,
+}
+
+// ParseHandle normalises a handle: without its leading @ and lowercase. Handles
+// are 3 to 30 letters, digits or underscores, and not reserved.
+func ParseHandle(raw string) (string, error) {
+	handle := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(raw), "@"))
+	if !handlePattern.MatchString(handle) {
+		return "", fmt.Errorf("handle must be %d to %d letters, digits or underscores", MinHandleLength, MaxHandleLength)
+	}
+
+	if IsReservedHandle(handle) {
+		return "", fmt.Errorf("handle %s is reserved", handle)
+	}
+
+	return handle, nil
+}
+
+// IsReservedHandle also covers the handles of deleted accounts, deleted_*.
+func IsReservedHandle(handle string) bool {
+	return reservedHandles[handle] || strings.HasPrefix(handle, "deleted_")
+}
+
+// IsHandleRef tells handle references, like @jane, apart from user IDs in
+// URLs.
+func IsHandleRef(ref string) bool {
+	return strings.HasPrefix(ref, "@")
+}
+
+// DeriveHandle turns a display name such as "Jane Doe" into a handle
+// candidate, "jane_doe". It may still be taken, reserved or too short.
+func DeriveHandle(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		case unicode.IsSpace(r), r == '-', r == '.':
+			b.WriteRune('_')
+		}
+	}
+
+	handle := strings.Trim(b.String(), "_")
+	if len(handle) > MaxHandleLength {
+		handle = strings.TrimRight(handle[:MaxHandleLength], "_")
+	}
+	return handle
+}
+
+// ChangeHandle gives the user a new handle, at most once per cooldown. It
+// returns the previous handle.
+func (u *User) ChangeHandle(handle string, now time.Time, cooldown time.Duration) (string, error) {
+	if u.HandleChangedAt != nil && now.Sub(*u.HandleChangedAt) < cooldown {
+		return "", fmt.Errorf("handle can be changed again after %s", u.HandleChangedAt.Add(cooldown).Format(time.RFC3339))
+	}
+
+	previous := u.Handle
+	u.Handle = handle
+	u.HandleChangedAt = &now
+	return previous, nil
+}
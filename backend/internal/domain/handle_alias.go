@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// HandleAlias is a previous handle of a user. Until it expires it leads to
+// the user and nobody else can take it.
+type HandleAlias struct {
+	Handle    string    `sql:"handle,primary"`
+	UserID    string    `sql:"user_id"`
+	CreatedAt time.Time `sql:"created_at"`
+	ExpiresAt time.Time `sql:"expires_at"`
+}
+
+func NewHandleAlias(handle string, userID string, expiresAt time.Time) (*HandleAlias, error) {
+	if handle == "" {
+		return nil, fmt.Errorf("handle cannot be empty")
+	}
+
+	if userID == "" {
+		return nil, fmt.Errorf("user ID cannot be empty")
+	}
+
+	return &HandleAlias{
+		Handle:    handle,
+		UserID:    userID,
+		CreatedAt: time.Now(),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (a *HandleAlias) IsExpired() bool {
+	return time.Now().After(a.ExpiresAt)
+}
+
+func (a *HandleAlias) TableName() string {
+	return "handle_aliases"
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseHandle(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{"lowercased without the @", " @Jane_Doe ", "jane_doe", false},
+		{"digits are allowed", "ada42", "ada42", false},
+		{"too short", "ab", "", true},
+		{"too long", strings.Repeat("a", MaxHandleLength+1), "", true},
+		{"longest allowed", strings.Repeat("a", MaxHandleLength), strings.Repeat("a", MaxHandleLength), false},
+		{"dots are not allowed", "jane.doe", "", true},
+		{"reserved route", "Admin", "", true},
+		{"reserved for deleted accounts", "deleted_1234", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got, err := ParseHandle(tt.raw)
+
+			// Assert
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected ParseHandle(%q) to fail, got %q", tt.raw, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseHandle(%q) failed: %v", tt.raw, err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseHandle(%q) = %q, expected %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeriveHandle(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"spaces become underscores", "Jane Doe", "jane_doe"},
+		{"dots and dashes become underscores", "jane.doe-smith", "jane_doe_smith"},
+		{"other characters are dropped", "Zoë O'Brien!", "zo_obrien"},
+		{"edge underscores are trimmed", "  _jane_  ", "jane"},
+		{"nothing usable", "张伟", ""},
+		{"cut to the max length", strings.Repeat("ab ", 20), strings.TrimRight(strings.Repeat("ab_", 10), "_")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := DeriveHandle(tt.in)
+
+			// Assert
+			if got != tt.want {
+				t.Fatalf("DeriveHandle(%q) = %q, expected %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsReservedHandle(t *testing.T) {
+	tests := []struct {
+		handle string
+		want   bool
+	}{
+		{"admin", true},
+		{"trending", true},
+		{"deleted_abc", true},
+		{"jane", false},
+		{"deleted", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.handle, func(t *testing.T) {
+			// Act
+			got := IsReservedHandle(tt.handle)
+
+			// Assert
+			if got != tt.want {
+				t.Fatalf("IsReservedHandle(%q) = %v, expected %v", tt.handle, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Identity is a provider account a user signs in with. Users are found by
+// the provider and subject of their identities, so a changed email at the
+// provider still leads to the same user.
+type Identity struct {
+	ID         string    `sql:"id,primary"`
+	UserID     string    `sql:"user_id"`
+	Provider   string    `sql:"provider"`
+	Subject    string    `sql:"subject"`
+	Email      string    `sql:"email"`
+	CreatedAt  time.Time `sql:"created_at"`
+	LastUsedAt time.Time `sql:"last_used_at"`
+}
+
+func NewIdentity(id string, userID string, provider string, subject string, email string) (*Identity, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if userID == "" {
+		return nil, fmt.Errorf("user ID cannot be empty")
+	}
+
+	if provider == "" {
+		return nil, fmt.Errorf("provider cannot be empty")
+	}
+
+	if subject == "" {
+		return nil, fmt.Errorf("subject cannot be empty")
+	}
+
+	now := time.Now()
+	return &Identity{
+		ID:         id,
+		UserID:     userID,
+		Provider:   provider,
+		Subject:    subject,
+		Email:      email,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}, nil
+}
+
+// Use records a sign in, along with the email the provider shared this time.
+func (i *Identity) Use(email string) {
+	i.Email = email
+	i.LastUsedAt = time.Now()
+}
+
+func (i *Identity) TableName() string {
+	return "identities"
+}
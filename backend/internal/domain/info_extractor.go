@@ -1,3 +1,13 @@
 package domain
 
-type InfoExtractor func(token string) (string, string, error)
+import "context"
+
+// OAuthUserInfo is what a sign in provider tells about a user. Subject is the
+// stable id of the user at the provider.
+type OAuthUserInfo struct {
+	Subject  string
+	Email    string
+	Username string
+}
+
+type InfoExtractor func(ctx context.Context, token string) (*OAuthUserInfo, error)
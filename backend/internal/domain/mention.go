@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MaxMentions caps how many users a single post or comment can mention.
+const MaxMentions = 20
+
+var (
+	mentionPattern   = regexp.MustCompile(`(^|[^\w@./-])@([A-Za-z0-9_](?:[A-Za-z0-9_.-]*[A-Za-z0-9_])?)`)
+	codeBlockPattern = regexp.MustCompile("(?s)```.*?```|`[^`\n]*`")
+)
+
+type Mention struct {
+	ID              string    `sql:"id,primary"`
+	MentionedUserID string    `sql:"mentioned_user_id"`
+	AuthorID        string    `sql:"author_id"`
+	PostID          string    `sql:"post_id"`
+	CommentID       *string   `sql:"comment_id"`
+	CreatedAt       time.Time `sql:"created_at"`
+}
+
+func NewMention(id string, mentionedUserID string, authorID string, postID string, commentID *string) (*Mention, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if mentionedUserID == "" {
+		return nil, fmt.Errorf("mentioned user ID cannot be empty")
+	}
+
+	if authorID == "" {
+		return nil, fmt.Errorf("author ID cannot be empty")
+	}
+
+	if postID == "" {
+		return nil, fmt.Errorf("post ID cannot be empty")
+	}
+
+	return &Mention{
+		ID:              id,
+		MentionedUserID: mentionedUserID,
+		AuthorID:        authorID,
+		PostID:          postID,
+		CommentID:       commentID,
+		CreatedAt:       time.Now(),
+	}, nil
+}
+
+func (m *Mention) TableName() string {
+	return "mentions"
+}
+
+// ParseMentions returns the lowercased handles mentioned as @handle in a
+// markdown text, in order of appearance and without duplicates. Mentions in
+// code, emails and paths are ignored.
+func ParseMentions(text string) []string {
+	text = codeBlockPattern.ReplaceAllString(text, " ")
+
+	handles := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		handle := strings.ToLower(match[2])
+		if seen[handle] {
+			continue
+		}
+
+		seen[handle] = true
+		handles = append(handles, handle)
+		if len(handles) == MaxMentions {
+			break
+		}
+	}
+
+	return handles
+}
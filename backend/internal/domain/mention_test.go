@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	many := make([]string, 0, MaxMentions+5)
+	for i := range MaxMentions + 5 {
+		many = append(many, fmt.Sprintf("@user%d", i))
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"no mentions", "hello world", []string{}},
+		{"lowercased in order", "thanks @Jane and @bob_1!", []string{"jane", "bob_1"}},
+		{"duplicates once", "@jane @JANE @jane", []string{"jane"}},
+		{"trailing dot is not part of the handle", "ask @jane.", []string{"jane"}},
+		{"emails and paths are not mentions", "mail jane@example.com or see /docs/@bob", []string{}},
+		{"inline code is stripped", "run `npm i @types/node` then ping @jane", []string{"jane"}},
+		{"code blocks are stripped", "```\n@bob\n```\n@jane", []string{"jane"}},
+		{"capped at MaxMentions", strings.Join(many, " "), many[:MaxMentions]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			want := make([]string, 0, len(tt.want))
+			for _, handle := range tt.want {
+				want = append(want, strings.TrimPrefix(handle, "@"))
+			}
+
+			// Act
+			got := ParseMentions(tt.text)
+
+			// Assert
+			if !slices.Equal(got, want) {
+				t.Fatalf("ParseMentions(%q) = %v, expected %v", tt.text, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type Mute struct {
+	ID        string    `sql:"id,primary"`
+	MuterID   string    `sql:"muter_id"`
+	MutedID   string    `sql:"muted_id"`
+	CreatedAt time.Time `sql:"created_at"`
+}
+
+func NewMute(id string, muterID string, mutedID string) (*Mute, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if muterID == "" {
+		return nil, fmt.Errorf("muter ID cannot be empty")
+	}
+
+	if mutedID == "" {
+		return nil, fmt.Errorf("muted ID cannot be empty")
+	}
+
+	if muterID == mutedID {
+		return nil, fmt.Errorf("cannot mute yourself")
+	}
+
+	return &Mute{
+		ID:        id,
+		MuterID:   muterID,
+		MutedID:   mutedID,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (m *Mute) TableName() string {
+	return "user_mutes"
+}
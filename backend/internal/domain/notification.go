@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	NotificationTypeComment = "comment"
+	NotificationTypeReply   = "reply"
+	NotificationTypeLike    = "like"
+	NotificationTypeFollow  = "follow"
+	NotificationTypeMention = "mention"
+	NotificationTypeReport  = "report"
+)
+
+type Notification struct {
+	ID          string          `sql:"id,primary"`
+	RecipientID string          `sql:"recipient_id"`
+	Type        string          `sql:"type"`
+	PostID      *string         `sql:"post_id"`
+	CommentID   *string         `sql:"comment_id"`
+	ReportID    *string         `sql:"report_id"`
+	GroupKey    string          `sql:"group_key"`
+	ActorIDs    json.RawMessage `sql:"actor_ids"`
+	ReadAt      *time.Time      `sql:"read_at"`
+	CreatedAt   time.Time       `sql:"created_at"`
+	UpdatedAt   time.Time       `sql:"updated_at"`
+}
+
+func NewNotification(id string, recipientID string, actorID string, kind string, postID *string, commentID *string, reportID *string) (*Notification, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if recipientID == "" {
+		return nil, fmt.Errorf("recipient ID cannot be empty")
+	}
+
+	if actorID == "" {
+		return nil, fmt.Errorf("actor ID cannot be empty")
+	}
+
+	groupKey, err := NotificationGroupKey(kind, postID, commentID, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	rawActorIDs, err := json.Marshal([]string{actorID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal actor IDs: %w", err)
+	}
+
+	now := time.Now()
+	return &Notification{
+		ID:          id,
+		RecipientID: recipientID,
+		Type:        kind,
+		PostID:      postID,
+		CommentID:   commentID,
+		ReportID:    reportID,
+		GroupKey:    groupKey,
+		ActorIDs:    rawActorIDs,
+		ReadAt:      nil,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// NotificationGroupKey returns the key used to merge unread notifications:
+// likes and comments are grouped per post, replies per parent comment,
+// follows per recipient, mentions per post or comment and report outcomes are
+// never merged.
+func NotificationGroupKey(kind string, postID *string, commentID *string, reportID *string) (string, error) {
+	switch kind {
+	case NotificationTypeComment, NotificationTypeLike:
+		if postID == nil || *postID == "" {
+			return "", fmt.Errorf("post ID cannot be empty for %s notification", kind)
+		}
+		return kind + ":" + *postID, nil
+	case NotificationTypeReply:
+		if commentID == nil || *commentID == "" {
+			return "", fmt.Errorf("comment ID cannot be empty for reply notification")
+		}
+		return kind + ":" + *commentID, nil
+	case NotificationTypeFollow:
+		return kind, nil
+	case NotificationTypeMention:
+		if commentID != nil && *commentID != "" {
+			return kind + ":comment:" + *commentID, nil
+		}
+		if postID == nil || *postID == "" {
+			return "", fmt.Errorf("post ID cannot be empty for mention notification")
+		}
+		return kind + ":post:" + *postID, nil
+	case NotificationTypeReport:
+		if reportID == nil || *reportID == "" {
+			return "", fmt.Errorf("report ID cannot be empty for report notification")
+		}
+		return kind + ":" + *reportID, nil
+	default:
+		return "", fmt.Errorf("unknown notification type: %s", kind)
+	}
+}
+
+func (n *Notification) MarkRead(readAt time.Time) {
+	if n.ReadAt == nil {
+		n.ReadAt = &readAt
+	}
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+func (n *Notification) TableName() string {
+	return "notifications"
+}
+
+func (n *Notification) ItsActorIDs() []string {
+	var actorIDs []string
+	if len(n.ActorIDs) == 0 {
+		return actorIDs
+	}
+	_ = json.Unmarshal(n.ActorIDs, &actorIDs)
+	return actorIDs
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// OAuthCode is the one-time code the OAuth callback hands to the web app in
+// place of tokens. The web app exchanges it for a session; it is stored
+// hashed and deleted on first use.
+type OAuthCode struct {
+	ID        string    `sql:"id,primary"`
+	CodeHash  string    `sql:"code_hash"`
+	UserID    string    `sql:"user_id"`
+	Provider  string    `sql:"provider"`
+	CreatedAt time.Time `sql:"created_at"`
+	ExpiresAt time.Time `sql:"expires_at"`
+}
+
+func NewOAuthCode(id string, userID string, provider string, code string, expiresAt time.Time) (*OAuthCode, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if userID == "" {
+		return nil, fmt.Errorf("user ID cannot be empty")
+	}
+
+	if provider == "" {
+		return nil, fmt.Errorf("provider cannot be empty")
+	}
+
+	if code == "" {
+		return nil, fmt.Errorf("code cannot be empty")
+	}
+
+	return &OAuthCode{
+		ID:        id,
+		CodeHash:  HashToken(code),
+		UserID:    userID,
+		Provider:  provider,
+		CreatedAt: time.Now(),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (c *OAuthCode) IsExpired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
+
+func (c *OAuthCode) TableName() string {
+	return "oauth_codes"
+}
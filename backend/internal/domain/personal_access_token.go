@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	ScopeRead          = "read"
+	ScopeWritePosts    = "write:posts"
+	ScopeWriteComments = "write:comments"
+)
+
+// PersonalAccessTokenPrefix starts every personal access token, which tells
+// them apart from JWTs in the Authorization header.
+const PersonalAccessTokenPrefix = "b0p_"
+
+// MaxPersonalAccessTokenName is the longest name a token can have.
+const MaxPersonalAccessTokenName = 100
+
+// PersonalAccessToken is a named API credential of a user for scripts. It is
+// limited to its scopes, expires, and is stored hashed.
+type PersonalAccessToken struct {
+	ID          string          `sql:"id,primary"`
+	UserID      string          `sql:"user_id"`
+	Name        string          `sql:"name"`
+	TokenHash   string          `sql:"token_hash"`
+	TokenPrefix string          `sql:"token_prefix"`
+	Scopes      json.RawMessage `sql:"scopes"`
+	CreatedAt   time.Time       `sql:"created_at"`
+	ExpiresAt   time.Time       `sql:"expires_at"`
+	LastUsedAt  *time.Time      `sql:"last_used_at"`
+	RevokedAt   *time.Time      `sql:"revoked_at"`
+}
+
+func NewPersonalAccessToken(id string, userID string, name string, token string, scopes []string, expiresAt time.Time) (*PersonalAccessToken, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if userID == "" {
+		return nil, fmt.Errorf("user ID cannot be empty")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("name cannot be empty")
+	}
+
+	if len(name) > MaxPersonalAccessTokenName {
+		return nil, fmt.Errorf("name cannot be longer than %d characters", MaxPersonalAccessTokenName)
+	}
+
+	if !strings.HasPrefix(token, PersonalAccessTokenPrefix) {
+		return nil, fmt.Errorf("token must start with %s", PersonalAccessTokenPrefix)
+	}
+
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("scopes cannot be empty")
+	}
+
+	for _, scope := range scopes {
+		if !IsScope(scope) {
+			return nil, fmt.Errorf("unknown scope: %s", scope)
+		}
+	}
+
+	rawScopes, err := json.Marshal(scopes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal scopes: %w", err)
+	}
+
+	return &PersonalAccessToken{
+		ID:          id,
+		UserID:      userID,
+		Name:        name,
+		TokenHash:   HashToken(token),
+		TokenPrefix: token[:len(PersonalAccessTokenPrefix)+4],
+		Scopes:      rawScopes,
+		CreatedAt:   time.Now(),
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+func IsScope(scope string) bool {
+	switch scope {
+	case ScopeRead, ScopeWritePosts, ScopeWriteComments:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *PersonalAccessToken) ItsScopes() []string {
+	var scopes []string
+	if len(t.Scopes) == 0 {
+		return scopes
+	}
+	_ = json.Unmarshal(t.Scopes, &scopes)
+	return scopes
+}
+
+func (t *PersonalAccessToken) HasScope(scope string) bool {
+	for _, s := range t.ItsScopes() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *PersonalAccessToken) Revoke() {
+	if t.RevokedAt == nil {
+		now := time.Now()
+		t.RevokedAt = &now
+	}
+}
+
+func (t *PersonalAccessToken) IsActive(now time.Time) bool {
+	return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
+
+func (t *PersonalAccessToken) TableName() string {
+	return "personal_access_tokens"
+}
@@ -3,9 +3,13 @@ package domain
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 )
 
+// ReservedPostSlugs are taken by the routes under /posts.
+var ReservedPostSlugs = []string{"trending"}
+
 type Post struct {
 	ID          string          `sql:"id,primary"`
 	AuthorID    string          `sql:"author_id"`
@@ -15,6 +19,7 @@ type Post struct {
 	Summary     string          `sql:"summary"`
 	Tags        json.RawMessage `sql:"tags"`
 	PublishedAt *time.Time      `sql:"published_at"`
+	HiddenAt    *time.Time      `sql:"hidden_at"`
 	CreatedAt   time.Time       `sql:"created_at"`
 	UpdatedAt   time.Time       `sql:"updated_at"`
 
@@ -39,6 +44,10 @@ func NewPost(id string, authorID string, title string, slug string, rawMarkdown
 		return nil, fmt.Errorf("slug cannot be empty")
 	}
 
+	if slices.Contains(ReservedPostSlugs, slug) {
+		return nil, fmt.Errorf("slug %q is reserved", slug)
+	}
+
 	if rawMarkdown == "" {
 		return nil, fmt.Errorf("raw markdown cannot be empty")
 	}
@@ -86,6 +95,16 @@ func (p *Post) Publish(publishedAt time.Time) {
 	p.UpdatedAt = time.Now()
 }
 
+// Hide takes the post out of the public listings after a report.
+func (p *Post) Hide(hiddenAt time.Time) {
+	p.HiddenAt = &hiddenAt
+	p.UpdatedAt = time.Now()
+}
+
+func (p *Post) IsHidden() bool {
+	return p.HiddenAt != nil
+}
+
 func (p *Post) Update(title string, slug string, rawMarkdown string, summary string, tags []string) error {
 	if title == "" {
 		return fmt.Errorf("title cannot be empty")
@@ -95,6 +114,10 @@ func (p *Post) Update(title string, slug string, rawMarkdown string, summary str
 		return fmt.Errorf("slug cannot be empty")
 	}
 
+	if slices.Contains(ReservedPostSlugs, slug) {
+		return fmt.Errorf("slug %q is reserved", slug)
+	}
+
 	if rawMarkdown == "" {
 		return fmt.Errorf("raw markdown cannot be empty")
 	}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProcessorAuditLog records an operation a processor client attempted, and
+// whether it went through.
+type ProcessorAuditLog struct {
+	ID        string    `sql:"id,primary"`
+	ClientID  string    `sql:"client_id"`
+	Op        string    `sql:"op"`
+	AuthorID  string    `sql:"author_id"`
+	PostID    string    `sql:"post_id"`
+	Succeeded bool      `sql:"succeeded"`
+	Error     string    `sql:"error"`
+	CreatedAt time.Time `sql:"created_at"`
+}
+
+func NewProcessorAuditLog(id string, clientID string, op string, authorID string, postID string, opErr error) (*ProcessorAuditLog, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if clientID == "" {
+		return nil, fmt.Errorf("client ID cannot be empty")
+	}
+
+	if op == "" {
+		return nil, fmt.Errorf("op cannot be empty")
+	}
+
+	log := &ProcessorAuditLog{
+		ID:        id,
+		ClientID:  clientID,
+		Op:        op,
+		AuthorID:  authorID,
+		PostID:    postID,
+		Succeeded: opErr == nil,
+		CreatedAt: time.Now(),
+	}
+	if opErr != nil {
+		log.Error = opErr.Error()
+	}
+
+	return log, nil
+}
+
+func (l *ProcessorAuditLog) TableName() string {
+	return "processor_audit_logs"
+}
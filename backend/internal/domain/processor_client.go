@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Operations processor clients can be allowed to perform.
+const (
+	ProcessorOpCreatePost  = "posts:create"
+	ProcessorOpUpdatePost  = "posts:update"
+	ProcessorOpPublishPost = "posts:publish"
+	ProcessorOpPostAudio   = "posts:audio"
+	ProcessorOpRunJobs     = "jobs:run"
+)
+
+// LegacyProcessorClientID identifies the client configured with
+// PROCESSOR_SECRET and PROCESSOR_USER_ID, from before clients were
+// registered.
+const LegacyProcessorClientID = "legacy"
+
+// ProcessorClient is a machine client of the processor API. It authenticates
+// with its ID and secret, acts as one of its allowed authors and can only
+// perform its allowed operations.
+type ProcessorClient struct {
+	ID               string          `sql:"id,primary"`
+	Name             string          `sql:"name"`
+	SecretHash       string          `sql:"secret_hash"`
+	AllowedAuthorIDs json.RawMessage `sql:"allowed_author_ids"`
+	AllowedOps       json.RawMessage `sql:"allowed_ops"`
+	CreatedBy        *string         `sql:"created_by"`
+	CreatedAt        time.Time       `sql:"created_at"`
+	LastUsedAt       *time.Time      `sql:"last_used_at"`
+	DisabledAt       *time.Time      `sql:"disabled_at"`
+}
+
+func NewProcessorClient(id string, name string, secret string, allowedAuthorIDs []string, allowedOps []string, createdBy *string) (*ProcessorClient, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("name cannot be empty")
+	}
+
+	if secret == "" {
+		return nil, fmt.Errorf("secret cannot be empty")
+	}
+
+	if len(allowedAuthorIDs) == 0 {
+		return nil, fmt.Errorf("allowed authors cannot be empty")
+	}
+
+	if len(allowedOps) == 0 {
+		return nil, fmt.Errorf("allowed operations cannot be empty")
+	}
+
+	for _, op := range allowedOps {
+		if !IsProcessorOp(op) {
+			return nil, fmt.Errorf("unknown operation: %s", op)
+		}
+	}
+
+	rawAuthors, err := json.Marshal(allowedAuthorIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal allowed authors: %w", err)
+	}
+
+	rawOps, err := json.Marshal(allowedOps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal allowed operations: %w", err)
+	}
+
+	return &ProcessorClient{
+		ID:               id,
+		Name:             name,
+		SecretHash:       HashToken(secret),
+		AllowedAuthorIDs: rawAuthors,
+		AllowedOps:       rawOps,
+		CreatedBy:        createdBy,
+		CreatedAt:        time.Now(),
+	}, nil
+}
+
+// NewLegacyProcessorClient is the client of the PROCESSOR_SECRET setting: it
+// acts as PROCESSOR_USER_ID and may perform every operation.
+func NewLegacyProcessorClient(userID string) *ProcessorClient {
+	rawAuthors, _ := json.Marshal([]string{userID})
+	rawOps, _ := json.Marshal(ProcessorOps())
+
+	return &ProcessorClient{
+		ID:               LegacyProcessorClientID,
+		Name:             "PROCESSOR_SECRET",
+		AllowedAuthorIDs: rawAuthors,
+		AllowedOps:       rawOps,
+	}
+}
+
+func ProcessorOps() []string {
+	return []string{ProcessorOpCreatePost, ProcessorOpUpdatePost, ProcessorOpPublishPost, ProcessorOpPostAudio, ProcessorOpRunJobs}
+}
+
+func IsProcessorOp(op string) bool {
+	for _, known := range ProcessorOps() {
+		if op == known {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ProcessorClient) ItsAllowedAuthorIDs() []string {
+	var ids []string
+	if len(c.AllowedAuthorIDs) == 0 {
+		return ids
+	}
+	_ = json.Unmarshal(c.AllowedAuthorIDs, &ids)
+	return ids
+}
+
+func (c *ProcessorClient) ItsAllowedOps() []string {
+	var ops []string
+	if len(c.AllowedOps) == 0 {
+		return ops
+	}
+	_ = json.Unmarshal(c.AllowedOps, &ops)
+	return ops
+}
+
+func (c *ProcessorClient) CanPerform(op string) bool {
+	for _, allowed := range c.ItsAllowedOps() {
+		if allowed == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ProcessorClient) CanActAs(authorID string) bool {
+	for _, allowed := range c.ItsAllowedAuthorIDs() {
+		if allowed == authorID {
+			return true
+		}
+	}
+	return false
+}
+
+// ActingAuthor returns the author a request acts as: the requested one, or
+// the only allowed author when none is requested.
+func (c *ProcessorClient) ActingAuthor(requested string) (string, error) {
+	if requested == "" {
+		allowed := c.ItsAllowedAuthorIDs()
+		if len(allowed) != 1 {
+			return "", fmt.Errorf("author_id is required, the client may act as several authors")
+		}
+		return allowed[0], nil
+	}
+
+	if !c.CanActAs(requested) {
+		return "", fmt.Errorf("client may not act as author %s", requested)
+	}
+	return requested, nil
+}
+
+func (c *ProcessorClient) Disable() {
+	if c.DisabledAt == nil {
+		now := time.Now()
+		c.DisabledAt = &now
+	}
+}
+
+func (c *ProcessorClient) IsActive() bool {
+	return c.DisabledAt == nil
+}
+
+func (c *ProcessorClient) TableName() string {
+	return "processor_clients"
+}
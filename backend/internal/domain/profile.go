@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MaxDisplayName = 50
+	MaxBio         = 2000
+	MaxLocation    = 100
+	MaxProfileURL  = 200
+	MaxSocialLinks = 5
+)
+
+// Profile is what users tell about themselves on their author page.
+type Profile struct {
+	DisplayName string
+	Bio         string
+	Website     string
+	Location    string
+	SocialLinks []string
+}
+
+func (u *User) UpdateProfile(profile Profile) error {
+	displayName := strings.TrimSpace(profile.DisplayName)
+	if utf8.RuneCountInString(displayName) > MaxDisplayName {
+		return fmt.Errorf("display name cannot be longer than %d characters", MaxDisplayName)
+	}
+
+	bio := strings.TrimSpace(profile.Bio)
+	if utf8.RuneCountInString(bio) > MaxBio {
+		return fmt.Errorf("bio cannot be longer than %d characters", MaxBio)
+	}
+
+	location := strings.TrimSpace(profile.Location)
+	if utf8.RuneCountInString(location) > MaxLocation {
+		return fmt.Errorf("location cannot be longer than %d characters", MaxLocation)
+	}
+
+	website := strings.TrimSpace(profile.Website)
+	if website != "" {
+		if err := validateProfileURL(website); err != nil {
+			return fmt.Errorf("website %w", err)
+		}
+	}
+
+	if len(profile.SocialLinks) > MaxSocialLinks {
+		return fmt.Errorf("cannot have more than %d social links", MaxSocialLinks)
+	}
+
+	socialLinks := make([]string, 0, len(profile.SocialLinks))
+	for _, link := range profile.SocialLinks {
+		link = strings.TrimSpace(link)
+		if err := validateProfileURL(link); err != nil {
+			return fmt.Errorf("social link %w", err)
+		}
+		socialLinks = append(socialLinks, link)
+	}
+
+	rawSocialLinks, err := json.Marshal(socialLinks)
+	if err != nil {
+		return fmt.Errorf("failed to marshal social links: %w", err)
+	}
+
+	u.DisplayName = displayName
+	u.Bio = bio
+	u.Location = location
+	u.Website = website
+	u.SocialLinks = rawSocialLinks
+	return nil
+}
+
+func (u *User) ItsSocialLinks() []string {
+	links := make([]string, 0)
+	if len(u.SocialLinks) > 0 {
+		_ = json.Unmarshal(u.SocialLinks, &links)
+	}
+	return links
+}
+
+// Name is how the user is shown: the display name, or the username when
+// there is none.
+func (u *User) Name() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	return u.Username
+}
+
+func validateProfileURL(raw string) error {
+	if len(raw) > MaxProfileURL {
+		return fmt.Errorf("cannot be longer than %d characters", MaxProfileURL)
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("must be an http(s) URL")
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+const (
+	RankingDay   = "day"
+	RankingWeek  = "week"
+	RankingMonth = "month"
+)
+
+// RankingWindow is how far back the trending posts of a period look, and how
+// fast the events within it fade.
+type RankingWindow struct {
+	Period   string
+	Span     time.Duration
+	HalfLife time.Duration
+}
+
+// RankingWindows are refreshed by the refresh-rankings job. An event loses
+// half its weight every quarter of its window.
+var RankingWindows = []RankingWindow{
+	{Period: RankingDay, Span: 24 * time.Hour, HalfLife: 6 * time.Hour},
+	{Period: RankingWeek, Span: 7 * 24 * time.Hour, HalfLife: 42 * time.Hour},
+	{Period: RankingMonth, Span: 30 * 24 * time.Hour, HalfLife: 180 * time.Hour},
+}
+
+// RankingWeights is what each kind of event adds to a post score before it
+// decays.
+type RankingWeights struct {
+	Like     float64
+	Comment  float64
+	Bookmark float64
+	View     float64
+}
+
+// DefaultRankingWeights rate the events by the effort they take, a view
+// being the cheapest.
+var DefaultRankingWeights = RankingWeights{
+	Like:     3,
+	Comment:  5,
+	Bookmark: 4,
+	View:     0.2,
+}
+
+func FindRankingWindow(period string) (RankingWindow, error) {
+	for _, window := range RankingWindows {
+		if window.Period == period {
+			return window, nil
+		}
+	}
+	return RankingWindow{}, fmt.Errorf("window must be one of %s, %s or %s", RankingDay, RankingWeek, RankingMonth)
+}
+
+// ViewRetention is how long views are kept, as long as the longest window.
+func ViewRetention() time.Duration {
+	var retention time.Duration
+	for _, window := range RankingWindows {
+		retention = max(retention, window.Span)
+	}
+	return retention
+}
+
+// VisitorKey identifies who viewed a post without storing them: the user
+// when signed in, their IP and user agent otherwise.
+func VisitorKey(userID string, ip string, userAgent string) string {
+	visitor := "user:" + userID
+	if userID == "" {
+		visitor = "anonymous:" + ip + "|" + userAgent
+	}
+	sum := sha256.Sum256([]byte(visitor))
+	return hex.EncodeToString(sum[:])
+}
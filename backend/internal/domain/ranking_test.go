@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFindRankingWindow(t *testing.T) {
+	tests := []struct {
+		period   string
+		wantSpan time.Duration
+		wantErr  bool
+	}{
+		{RankingDay, 24 * time.Hour, false},
+		{RankingWeek, 7 * 24 * time.Hour, false},
+		{RankingMonth, 30 * 24 * time.Hour, false},
+		{"year", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.period, func(t *testing.T) {
+			// Act
+			window, err := FindRankingWindow(tt.period)
+
+			// Assert
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected FindRankingWindow(%q) to fail", tt.period)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FindRankingWindow(%q) failed: %v", tt.period, err)
+			}
+			if window.Period != tt.period || window.Span != tt.wantSpan {
+				t.Fatalf("FindRankingWindow(%q) = %+v, expected a span of %s", tt.period, window, tt.wantSpan)
+			}
+			if window.HalfLife != window.Span/4 {
+				t.Fatalf("expected a half-life of a quarter of the window, got %s", window.HalfLife)
+			}
+		})
+	}
+}
+
+func TestViewRetentionCoversTheLongestWindow(t *testing.T) {
+	// Act
+	retention := ViewRetention()
+
+	// Assert
+	if retention != 30*24*time.Hour {
+		t.Fatalf("expected views to be kept for the month window, got %s", retention)
+	}
+}
+
+func TestVisitorKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      [3]string
+		wantEqual bool
+	}{
+		{"same user on another device", [3]string{"user-1", "1.1.1.1", "firefox"}, [3]string{"user-1", "2.2.2.2", "chrome"}, true},
+		{"different users", [3]string{"user-1", "", ""}, [3]string{"user-2", "", ""}, false},
+		{"same anonymous visitor", [3]string{"", "1.1.1.1", "firefox"}, [3]string{"", "1.1.1.1", "firefox"}, true},
+		{"anonymous visitor on another browser", [3]string{"", "1.1.1.1", "firefox"}, [3]string{"", "1.1.1.1", "chrome"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			a := VisitorKey(tt.a[0], tt.a[1], tt.a[2])
+			b := VisitorKey(tt.b[0], tt.b[1], tt.b[2])
+
+			// Assert
+			if (a == b) != tt.wantEqual {
+				t.Fatalf("expected keys equal to be %v, got %s and %s", tt.wantEqual, a, b)
+			}
+			if len(a) != 64 {
+				t.Fatalf("expected a hex sha256 key, got %q", a)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ReactionLike is the reaction behind the classic post likes.
+const ReactionLike = "like"
+
+// DefaultReactionSet is used when no reaction set is configured.
+var DefaultReactionSet = ReactionSet{ReactionLike, "love", "laugh", "wow", "sad", "celebrate"}
+
+type Reaction struct {
+	ID        string    `sql:"id,primary"`
+	UserID    string    `sql:"user_id"`
+	PostID    *string   `sql:"post_id"`
+	CommentID *string   `sql:"comment_id"`
+	Emoji     string    `sql:"emoji"`
+	CreatedAt time.Time `sql:"created_at"`
+}
+
+func NewPostReaction(id string, userID string, postID string, emoji string) (*Reaction, error) {
+	if postID == "" {
+		return nil, fmt.Errorf("post ID cannot be empty")
+	}
+
+	return newReaction(id, userID, &postID, nil, emoji)
+}
+
+func NewCommentReaction(id string, userID string, commentID string, emoji string) (*Reaction, error) {
+	if commentID == "" {
+		return nil, fmt.Errorf("comment ID cannot be empty")
+	}
+
+	return newReaction(id, userID, nil, &commentID, emoji)
+}
+
+func newReaction(id string, userID string, postID *string, commentID *string, emoji string) (*Reaction, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if userID == "" {
+		return nil, fmt.Errorf("user ID cannot be empty")
+	}
+
+	if emoji == "" {
+		return nil, fmt.Errorf("emoji cannot be empty")
+	}
+
+	return &Reaction{
+		ID:        id,
+		UserID:    userID,
+		PostID:    postID,
+		CommentID: commentID,
+		Emoji:     emoji,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (r *Reaction) TableName() string {
+	return "reactions"
+}
+
+// ReactionSet is the ordered list of reactions users can pick from.
+type ReactionSet []string
+
+// ParseReactionSet reads a comma separated list of reaction names, the
+// default set when it names none. The like reaction is always part of the set
+// so post likes keep working.
+func ParseReactionSet(value string) ReactionSet {
+	set := ReactionSet{ReactionLike}
+	configured := false
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		configured = true
+		if !set.Has(name) {
+			set = append(set, name)
+		}
+	}
+
+	if !configured {
+		return DefaultReactionSet
+	}
+
+	return set
+}
+
+func (s ReactionSet) Has(emoji string) bool {
+	for _, name := range s {
+		if name == emoji {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseReactionSet(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  ReactionSet
+	}{
+		{"empty uses the default", "", DefaultReactionSet},
+		{"blank names use the default", " , ,", DefaultReactionSet},
+		{"like alone stays like only", "like", ReactionSet{ReactionLike}},
+		{"like is always first", "love, wow", ReactionSet{ReactionLike, "love", "wow"}},
+		{"names are lowercased and deduplicated", "Love,love, LIKE ,wow", ReactionSet{ReactionLike, "love", "wow"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := ParseReactionSet(tt.value)
+
+			// Assert
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("ParseReactionSet(%q) = %v, expected %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+const (
+	RealtimeCommentCreated      = "comment.created"
+	RealtimeCommentUpdated      = "comment.updated"
+	RealtimeCommentDeleted      = "comment.deleted"
+	RealtimeLikeCountChanged    = "like_count.changed"
+	RealtimeReactionsChanged    = "reactions.changed"
+	RealtimeNotificationCreated = "notification.created"
+)
+
+type RealtimeEvent struct {
+	Topic string `json:"topic"`
+	Type  string `json:"type"`
+	Data  any    `json:"data"`
+}
+
+// RealtimeHub fans events out to the subscribers of a topic, across every
+// running instance of the API. Subscribe fails when events can't be received,
+// rather than handing out a stream that stays silent.
+type RealtimeHub interface {
+	Publish(event RealtimeEvent) error
+	Subscribe(topic string) (<-chan RealtimeEvent, func(), error)
+}
+
+func PostTopic(postID string) string {
+	return "post:" + postID
+}
+
+func UserTopic(userID string) string {
+	return "user:" + userID
+}
+
+// StreamTicketType tells stream tickets apart from access tokens, which are
+// signed with the same secret. A ticket carries the user (uid) and session
+// (sid) it was issued to.
+const StreamTicketType = "stream_ticket"
+
+// StreamTicketTTL is how long a stream ticket can open a stream. Browsers
+// can't send headers with EventSource, the ticket goes in the URL instead.
+const StreamTicketTTL = time.Minute
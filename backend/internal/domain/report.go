@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	ReportTargetPost    = "post"
+	ReportTargetComment = "comment"
+	ReportTargetUser    = "user"
+)
+
+const (
+	ReportReasonSpam           = "spam"
+	ReportReasonHarassment     = "harassment"
+	ReportReasonHate           = "hate"
+	ReportReasonViolence       = "violence"
+	ReportReasonSexual         = "sexual"
+	ReportReasonMisinformation = "misinformation"
+	ReportReasonOther          = "other"
+)
+
+const (
+	ReportStatusOpen      = "open"
+	ReportStatusDismissed = "dismissed"
+	ReportStatusActioned  = "actioned"
+)
+
+// Triage actions an admin can take on a report.
+const (
+	ReportActionDismiss     = "dismiss"
+	ReportActionHideContent = "hide_content"
+	ReportActionSuspendUser = "suspend_user"
+)
+
+// MaxReportDetails caps the free text a reporter can attach to a report.
+const MaxReportDetails = 2000
+
+type Report struct {
+	ID             string     `sql:"id,primary"`
+	ReporterID     string     `sql:"reporter_id"`
+	TargetType     string     `sql:"target_type"`
+	PostID         *string    `sql:"post_id"`
+	CommentID      *string    `sql:"comment_id"`
+	ReportedUserID string     `sql:"reported_user_id"`
+	Reason         string     `sql:"reason"`
+	Details        string     `sql:"details"`
+	Status         string     `sql:"status"`
+	Resolution     *string    `sql:"resolution"`
+	ResolvedBy     *string    `sql:"resolved_by"`
+	ResolvedAt     *time.Time `sql:"resolved_at"`
+	CreatedAt      time.Time  `sql:"created_at"`
+}
+
+// NewReport creates an open report. Post reports carry the post ID, comment
+// reports the comment and its post IDs, and ReportedUserID is always the
+// reported user or the author of the reported content.
+func NewReport(id string, reporterID string, targetType string, postID *string, commentID *string, reportedUserID string, reason string, details string) (*Report, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if reporterID == "" {
+		return nil, fmt.Errorf("reporter ID cannot be empty")
+	}
+
+	if reportedUserID == "" {
+		return nil, fmt.Errorf("reported user ID cannot be empty")
+	}
+
+	if reporterID == reportedUserID {
+		return nil, fmt.Errorf("cannot report yourself")
+	}
+
+	switch targetType {
+	case ReportTargetPost:
+		if postID == nil || *postID == "" {
+			return nil, fmt.Errorf("post ID cannot be empty for post report")
+		}
+		commentID = nil
+	case ReportTargetComment:
+		if postID == nil || *postID == "" || commentID == nil || *commentID == "" {
+			return nil, fmt.Errorf("post and comment IDs cannot be empty for comment report")
+		}
+	case ReportTargetUser:
+		postID = nil
+		commentID = nil
+	default:
+		return nil, fmt.Errorf("unknown report target %s", targetType)
+	}
+
+	if !IsReportReason(reason) {
+		return nil, fmt.Errorf("unknown report reason %s", reason)
+	}
+
+	if len([]rune(details)) > MaxReportDetails {
+		return nil, fmt.Errorf("details cannot be longer than %d characters", MaxReportDetails)
+	}
+
+	return &Report{
+		ID:             id,
+		ReporterID:     reporterID,
+		TargetType:     targetType,
+		PostID:         postID,
+		CommentID:      commentID,
+		ReportedUserID: reportedUserID,
+		Reason:         reason,
+		Details:        details,
+		Status:         ReportStatusOpen,
+		CreatedAt:      time.Now(),
+	}, nil
+}
+
+func IsReportReason(reason string) bool {
+	switch reason {
+	case ReportReasonSpam, ReportReasonHarassment, ReportReasonHate, ReportReasonViolence,
+		ReportReasonSexual, ReportReasonMisinformation, ReportReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// Resolve closes the report with the admin's triage action: dismissed reports
+// led to nothing, the others to hidden content or a suspension.
+func (r *Report) Resolve(adminID string, action string) error {
+	if !r.IsOpen() {
+		return fmt.Errorf("report already resolved")
+	}
+
+	switch action {
+	case ReportActionDismiss:
+		r.Status = ReportStatusDismissed
+	case ReportActionHideContent, ReportActionSuspendUser:
+		r.Status = ReportStatusActioned
+	default:
+		return fmt.Errorf("unknown report action %s", action)
+	}
+
+	now := time.Now()
+	r.Resolution = &action
+	r.ResolvedBy = &adminID
+	r.ResolvedAt = &now
+	return nil
+}
+
+func (r *Report) IsOpen() bool {
+	return r.Status == ReportStatusOpen
+}
+
+// TargetID returns the ID of the reported post, comment or user.
+func (r *Report) TargetID() string {
+	switch r.TargetType {
+	case ReportTargetPost:
+		return *r.PostID
+	case ReportTargetComment:
+		return *r.CommentID
+	default:
+		return r.ReportedUserID
+	}
+}
+
+func (r *Report) TableName() string {
+	return "reports"
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "fmt"
+
+// Roles, from least to most privileged. Each role can do everything the
+// previous ones can: readers comment and react, authors also write posts,
+// editors triage reports and admins manage users.
+const (
+	RoleReader = "reader"
+	RoleAuthor = "author"
+	RoleEditor = "editor"
+	RoleAdmin  = "admin"
+)
+
+// DefaultRole is given to new users and assumed for tokens issued before
+// roles existed.
+const DefaultRole = RoleAuthor
+
+var roleRanks = map[string]int{
+	RoleReader: 1,
+	RoleAuthor: 2,
+	RoleEditor: 3,
+	RoleAdmin:  4,
+}
+
+func ParseRole(role string) (string, error) {
+	if _, ok := roleRanks[role]; !ok {
+		return "", fmt.Errorf("unknown role %s", role)
+	}
+	return role, nil
+}
+
+// RoleAtLeast reports whether role grants the permissions of required.
+// Unknown roles grant nothing.
+func RoleAtLeast(role string, required string) bool {
+	rank, ok := roleRanks[role]
+	if !ok {
+		return false
+	}
+	return rank >= roleRanks[required]
+}
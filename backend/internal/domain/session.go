@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// Session is a sign-in on a device. Access tokens carry its ID and stop being
+// accepted once it is revoked; its refresh token is stored hashed and
+// replaced on every refresh.
+type Session struct {
+	ID                string     `sql:"id,primary"`
+	UserID            string     `sql:"user_id"`
+	RefreshTokenHash  string     `sql:"refresh_token_hash"`
+	PreviousTokenHash *string    `sql:"previous_token_hash"`
+	UserAgent         string     `sql:"user_agent"`
+	IP                string     `sql:"ip"`
+	CreatedAt         time.Time  `sql:"created_at"`
+	LastSeenAt        time.Time  `sql:"last_seen_at"`
+	ExpiresAt         time.Time  `sql:"expires_at"`
+	RevokedAt         *time.Time `sql:"revoked_at"`
+}
+
+func NewSession(id string, userID string, refreshToken string, userAgent string, ip string, expiresAt time.Time) (*Session, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if userID == "" {
+		return nil, fmt.Errorf("user ID cannot be empty")
+	}
+
+	if refreshToken == "" {
+		return nil, fmt.Errorf("refresh token cannot be empty")
+	}
+
+	now := time.Now()
+	return &Session{
+		ID:               id,
+		UserID:           userID,
+		RefreshTokenHash: HashToken(refreshToken),
+		UserAgent:        userAgent,
+		IP:               ip,
+		CreatedAt:        now,
+		LastSeenAt:       now,
+		ExpiresAt:        expiresAt,
+	}, nil
+}
+
+func (s *Session) Revoke() {
+	if s.RevokedAt == nil {
+		now := time.Now()
+		s.RevokedAt = &now
+	}
+}
+
+func (s *Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+func (s *Session) TableName() string {
+	return "sessions"
+}
+
+// NewOpaqueToken returns a random URL safe token, for refresh tokens and
+// other secrets that are only stored hashed.
+func NewOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
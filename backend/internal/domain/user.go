@@ -1,14 +1,33 @@
 package domain
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
 
 type User struct {
-	ID       string `sql:"id,primary"`
-	Email    string `sql:"email"`
-	Username string `sql:"username"`
+	ID          string     `sql:"id,primary"`
+	Email       string     `sql:"email"`
+	Username    string     `sql:"username"`
+	Role        string     `sql:"role"`
+	SuspendedAt *time.Time `sql:"suspended_at"`
+
+	Handle          string     `sql:"handle"`
+	HandleChangedAt *time.Time `sql:"handle_changed_at"`
+
+	DisplayName string          `sql:"display_name"`
+	Bio         string          `sql:"bio"`
+	AvatarURL   string          `sql:"avatar_url"`
+	Website     string          `sql:"website"`
+	Location    string          `sql:"location"`
+	SocialLinks json.RawMessage `sql:"social_links"`
+
+	DeletionScheduledAt *time.Time `sql:"deletion_scheduled_at"`
+	DeletedAt           *time.Time `sql:"deleted_at"`
 }
 
-func NewUser(id string, email string, username string) (*User, error) {
+func NewUser(id string, email string, username string, handle string) (*User, error) {
 	if email == "" {
 		return nil, fmt.Errorf("email cannot be empty")
 	}
@@ -17,13 +36,49 @@ func NewUser(id string, email string, username string) (*User, error) {
 		return nil, fmt.Errorf("username cannot be empty")
 	}
 
+	handle, err := ParseHandle(handle)
+	if err != nil {
+		return nil, err
+	}
+
 	return &User{
 		ID:       id,
 		Email:    email,
 		Username: username,
+		Handle:   handle,
+		Role:     DefaultRole,
+
+		SocialLinks: json.RawMessage("[]"),
 	}, nil
 }
 
+// Suspend locks the user out: they can no longer sign in or write.
+func (u *User) Suspend(suspendedAt time.Time) {
+	u.SuspendedAt = &suspendedAt
+}
+
+func (u *User) Unsuspend() {
+	u.SuspendedAt = nil
+}
+
+func (u *User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+func (u *User) ChangeRole(role string) error {
+	role, err := ParseRole(role)
+	if err != nil {
+		return err
+	}
+
+	u.Role = role
+	return nil
+}
+
+func (u *User) HasRole(role string) bool {
+	return RoleAtLeast(u.Role, role)
+}
+
 func (u *User) TableName() string {
 	return "users"
 }
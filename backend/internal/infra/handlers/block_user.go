@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// BlockUser godoc
+// @Summary      Block user
+// @Description  Block a user: they can no longer follow me, comment on or react to my posts, and their content is hidden from me (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id path     string true "User ID"
+// @Success      200     {object} services.BlockUserResp
+// @Failure      400     {object} ErrorResp
+// @Failure      401     {object} ErrorResp
+// @Failure      404     {object} ErrorResp
+// @Failure      500     {object} ErrorResp
+// @Router       /api/v1/me/blocks/{user_id} [post]
+func BlockUser(blockUser *services.BlockUser) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		blockedID := c.Param("user_id")
+		if blockedID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "user_id is required"})
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.BlockUserReq{
+			BlockedID: blockedID,
+			UserID:    userID.(string),
+		}
+
+		resp, err := blockUser.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "user not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "failed to create block"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// UnblockUser godoc
+// @Summary      Unblock user
+// @Description  Remove a block (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id path     string true "User ID"
+// @Success      200     {object} services.UnblockUserResp
+// @Failure      400     {object} ErrorResp
+// @Failure      401     {object} ErrorResp
+// @Failure      404     {object} ErrorResp
+// @Failure      500     {object} ErrorResp
+// @Router       /api/v1/me/blocks/{user_id} [delete]
+func UnblockUser(unblockUser *services.UnblockUser) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		blockedID := c.Param("user_id")
+		if blockedID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "user_id is required"})
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.UnblockUserReq{
+			BlockedID: blockedID,
+			UserID:    userID.(string),
+		}
+
+		resp, err := unblockUser.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "block not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
@@ -10,16 +11,17 @@ import (
 
 // BookmarkPost godoc
 // @Summary      Bookmark post
-// @Description  Add post to user's bookmarks (requires authentication)
+// @Description  Add post to user's bookmarks, keeping the existing bookmark when it is already there (requires authentication)
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        slug path     string true "Post slug"
+// @Success      200  {object} services.BookmarkPostResp "Already bookmarked"
 // @Success      201  {object} services.BookmarkPostResp
 // @Failure      401  {object} ErrorResp
 // @Failure      404  {object} ErrorResp
-// @Failure      409  {object} ErrorResp
 // @Failure      500  {object} ErrorResp
+// @Router       /api/v1/posts/{slug}/bookmarks [put]
 // @Router       /api/v1/posts/{slug}/bookmarks [post]
 func BookmarkPost(bookmarkPost *services.BookmarkPost) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -42,17 +44,26 @@ func BookmarkPost(bookmarkPost *services.BookmarkPost) gin.HandlerFunc {
 
 		resp, err := bookmarkPost.Exec(c, req)
 		if err != nil {
+			if strings.HasPrefix(err.Error(), "post not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
 			return
 		}
 
+		if !resp.Created {
+			c.JSON(http.StatusOK, resp)
+			return
+		}
+
 		c.JSON(http.StatusCreated, resp)
 	}
 }
 
 // UnbookmarkPost godoc
 // @Summary      Remove bookmark
-// @Description  Remove post from user's bookmarks (requires authentication)
+// @Description  Remove post from user's bookmarks, succeeding when it isn't bookmarked (requires authentication)
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
@@ -83,10 +94,14 @@ func UnbookmarkPost(unbookmarkPost *services.UnbookmarkPost) gin.HandlerFunc {
 
 		resp, err := unbookmarkPost.Exec(c, req)
 		if err != nil {
+			if strings.HasPrefix(err.Error(), "post not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
 			return
 		}
 
 		c.JSON(http.StatusOK, resp)
 	}
-}
\ No newline at end of file
+}
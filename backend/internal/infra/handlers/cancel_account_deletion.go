@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// CancelAccountDeletion godoc
+// @Summary      Cancel my account deletion
+// @Description  Keep the current user's account, when its deletion is scheduled
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} services.AccountDeletionResp
+// @Failure      401 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/deletion/cancel [post]
+func CancelAccountDeletion(cancelAccountDeletion *services.CancelAccountDeletion) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.CancelAccountDeletionReq{
+			UserID: userID.(string),
+		}
+
+		resp, err := cancelAccountDeletion.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ChangeHandle godoc
+// @Summary      Change my handle
+// @Description  Change the handle of the current user, at most once per cooldown. The previous handle keeps redirecting to the user, and can't be taken by others, for a grace period
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body body     services.ChangeHandleReq true "New handle"
+// @Success      200  {object} services.ChangeHandleResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      409  {object} ErrorResp
+// @Failure      429  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/me/handle [put]
+func ChangeHandle(changeHandle *services.ChangeHandle) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		var req services.ChangeHandleReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+		req.UserID = userID.(string)
+
+		resp, err := changeHandle.Exec(c, &req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "failed to change handle"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "handle already taken"):
+				c.JSON(http.StatusConflict, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "handle change too soon"):
+				c.JSON(http.StatusTooManyRequests, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+type ChangeUserRoleReq struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// ChangeUserRole godoc
+// @Summary      Change user role
+// @Description  Make a user a reader, author, editor or admin. The role applies from their next token refresh (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id path     string            true "User ID"
+// @Param        body    body     ChangeUserRoleReq true "New role"
+// @Success      200     {object} services.AdminUserItem
+// @Failure      400     {object} ErrorResp
+// @Failure      401     {object} ErrorResp
+// @Failure      403     {object} ErrorResp
+// @Failure      404     {object} ErrorResp
+// @Failure      500     {object} ErrorResp
+// @Router       /api/v1/admin/users/{user_id}/role [put]
+func ChangeUserRole(changeUserRole *services.ChangeUserRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		targetID := c.Param("user_id")
+		if targetID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "user_id is required"})
+			return
+		}
+
+		var body ChangeUserRoleReq
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.ChangeUserRoleReq{
+			UserID:  targetID,
+			AdminID: userID.(string),
+			Role:    body.Role,
+		}
+
+		resp, err := changeUserRole.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "unauthorized"):
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "user not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "failed to change role"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
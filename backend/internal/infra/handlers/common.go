@@ -1,5 +1,19 @@
 package handlers
 
+import (
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
 type ErrorResp struct {
 	Error string `json:"error"`
 }
+
+// clientInfo describes the device of the request, for the sessions list.
+func clientInfo(c *gin.Context) services.ClientInfo {
+	return services.ClientInfo{
+		UserAgent: c.Request.UserAgent(),
+		IP:        c.ClientIP(),
+	}
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// CreateBookmarkCollection godoc
+// @Summary      Create bookmark collection
+// @Description  Create a named collection of bookmarks, private unless is_public is set (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body body     services.CreateBookmarkCollectionReq true "Collection"
+// @Success      201  {object} services.BookmarkCollectionInfo
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      409  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/me/collections [post]
+func CreateBookmarkCollection(createBookmarkCollection *services.CreateBookmarkCollection) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		var req services.CreateBookmarkCollectionReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+		req.UserID = userID.(string)
+
+		resp, err := createBookmarkCollection.Exec(c, &req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "failed to create collection"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "collection name already taken"):
+				c.JSON(http.StatusConflict, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
@@ -24,6 +25,7 @@ type CreateCommentReq struct {
 // @Success      201  {object} services.CreateCommentResp
 // @Failure      400  {object} ErrorResp
 // @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
 // @Failure      404  {object} ErrorResp
 // @Failure      500  {object} ErrorResp
 // @Router       /api/v1/posts/{slug}/comments [post]
@@ -56,10 +58,18 @@ func CreateComment(createComment *services.CreateComment) gin.HandlerFunc {
 
 		resp, err := createComment.Exec(c, req)
 		if err != nil {
+			if err.Error() == "parent comment does not belong to this post" {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+				return
+			}
+			if strings.HasPrefix(err.Error(), "unauthorized") {
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
 			return
 		}
 
 		c.JSON(http.StatusCreated, resp)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+type CreatePersonalAccessTokenReq struct {
+	Name          string   `json:"name" binding:"required"`
+	Scopes        []string `json:"scopes" binding:"required"`
+	ExpiresInDays int      `json:"expires_in_days"`
+}
+
+// CreatePersonalAccessToken godoc
+// @Summary      Create a personal access token
+// @Description  Create a token for scripts, sent as the Authorization header. Scopes: read, write:posts, write:comments. Tokens expire after expires_in_days (default 30, at most 365) and are only shown in this response (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body body     CreatePersonalAccessTokenReq true "Token"
+// @Success      201  {object} services.CreatePersonalAccessTokenResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/me/tokens [post]
+func CreatePersonalAccessToken(createPersonalAccessToken *services.CreatePersonalAccessToken) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body CreatePersonalAccessTokenReq
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.CreatePersonalAccessTokenReq{
+			UserID:        userID.(string),
+			Name:          body.Name,
+			Scopes:        body.Scopes,
+			ExpiresInDays: body.ExpiresInDays,
+		}
+
+		resp, err := createPersonalAccessToken.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "failed to create token") {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
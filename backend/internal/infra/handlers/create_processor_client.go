@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// CreateProcessorClient godoc
+// @Summary      Register a processor client
+// @Description  Register a processor client allowed to act as some authors for some operations. The secret is only returned once (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body body     services.CreateProcessorClientReq true "Client data"
+// @Success      201  {object} services.CreateProcessorClientResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/admin/processor-clients [post]
+func CreateProcessorClient(createProcessorClient *services.CreateProcessorClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		var req services.CreateProcessorClientReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+		req.AdminID = userID.(string)
+
+		resp, err := createProcessorClient.Exec(c, &req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "user not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "failed to create processor client"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+type CreateReportReq struct {
+	TargetType string `json:"target_type" binding:"required"`
+	TargetID   string `json:"target_id" binding:"required"`
+	Reason     string `json:"reason" binding:"required"`
+	Details    string `json:"details"`
+}
+
+// CreateReport godoc
+// @Summary      Report content
+// @Description  Report a post, a comment or a user to the admins. Reasons: spam, harassment, hate, violence, sexual, misinformation, other (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body body     CreateReportReq true "Report"
+// @Success      201  {object} services.CreateReportResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/reports [post]
+func CreateReport(createReport *services.CreateReport) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body CreateReportReq
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.CreateReportReq{
+			TargetType: body.TargetType,
+			TargetID:   body.TargetID,
+			Reason:     body.Reason,
+			Details:    body.Details,
+			UserID:     userID.(string),
+		}
+
+		resp, err := createReport.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "post not found"),
+				strings.HasPrefix(err.Error(), "comment not found"),
+				strings.HasPrefix(err.Error(), "user not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "failed to create report"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// DeleteBookmarkCollection godoc
+// @Summary      Delete bookmark collection
+// @Description  Delete one of my collections. Its bookmarks are kept, outside of any collection (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id  path     string true "Collection ID"
+// @Success      200 {object} services.DeleteBookmarkCollectionResp
+// @Failure      401 {object} ErrorResp
+// @Failure      404 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/collections/{id} [delete]
+func DeleteBookmarkCollection(deleteBookmarkCollection *services.DeleteBookmarkCollection) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.DeleteBookmarkCollectionReq{
+			ID:     c.Param("id"),
+			UserID: userID.(string),
+		}
+
+		resp, err := deleteBookmarkCollection.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "collection not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
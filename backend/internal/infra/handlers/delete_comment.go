@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// DeleteComment godoc
+// @Summary      Delete comment
+// @Description  Delete one of my comments, or any comment on one of my posts. Comments with replies are kept as tombstones (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        slug path     string true "Post slug"
+// @Param        id   path     string true "Comment ID"
+// @Success      200  {object} services.DeleteCommentResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/posts/{slug}/comments/{id} [delete]
+func DeleteComment(deleteComment *services.DeleteComment) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		slug := c.Param("slug")
+		commentID := c.Param("id")
+		if slug == "" || commentID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "slug and id are required"})
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.DeleteCommentReq{
+			Slug:      slug,
+			CommentID: commentID,
+			UserID:    userID.(string),
+		}
+
+		resp, err := deleteComment.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "unauthorized:"):
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "post not found"), strings.HasPrefix(err.Error(), "comment not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
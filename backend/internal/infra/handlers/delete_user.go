@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+type DeleteUserReq struct {
+	Note string `json:"note"`
+}
+
+// DeleteUser godoc
+// @Summary      Delete user
+// @Description  Delete a user with their posts, comments and interactions (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id path     string        true  "User ID"
+// @Param        body    body     DeleteUserReq false "Audit note"
+// @Success      200     {object} services.DeleteUserResp
+// @Failure      400     {object} ErrorResp
+// @Failure      401     {object} ErrorResp
+// @Failure      403     {object} ErrorResp
+// @Failure      404     {object} ErrorResp
+// @Failure      500     {object} ErrorResp
+// @Router       /api/v1/admin/users/{user_id} [delete]
+func DeleteUser(deleteUser *services.DeleteUser) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		targetID := c.Param("user_id")
+		if targetID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "user_id is required"})
+			return
+		}
+
+		var body DeleteUserReq
+		if c.Request.ContentLength > 0 {
+			if err := c.ShouldBindJSON(&body); err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+				return
+			}
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.DeleteUserReq{
+			UserID:  targetID,
+			AdminID: userID.(string),
+			Note:    body.Note,
+		}
+
+		resp, err := deleteUser.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "unauthorized"):
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "user not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+type DisableProcessorClientReq struct {
+	Note string `json:"note"`
+}
+
+// DisableProcessorClient godoc
+// @Summary      Disable a processor client
+// @Description  Disable a processor client: its credentials stop working right away (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path     string                    true  "Client ID"
+// @Param        body body     DisableProcessorClientReq false "Audit note"
+// @Success      200  {object} services.ProcessorClientItem
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/admin/processor-clients/{id} [delete]
+func DisableProcessorClient(disableProcessorClient *services.DisableProcessorClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body DisableProcessorClientReq
+		if c.Request.ContentLength > 0 {
+			if err := c.ShouldBindJSON(&body); err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+				return
+			}
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.DisableProcessorClientReq{
+			ClientID: c.Param("id"),
+			AdminID:  userID.(string),
+			Note:     body.Note,
+		}
+
+		resp, err := disableProcessorClient.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "processor client not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+type ExchangeOAuthCodeReq struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// ExchangeOAuthCode godoc
+// @Summary      Exchange a sign in code
+// @Description  Trade the one-time code the OAuth callback redirects to the web app with for an access token and a refresh token. Codes work once and expire after a minute
+// @Accept       json
+// @Produce      json
+// @Param        body body     ExchangeOAuthCodeReq true "One-time code"
+// @Success      200  {object} services.ExchangeOAuthCodeResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/auth/exchange [post]
+func ExchangeOAuthCode(exchangeOAuthCode *services.ExchangeOAuthCode) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body ExchangeOAuthCodeReq
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		req := &services.ExchangeOAuthCodeReq{
+			Code:   body.Code,
+			Client: clientInfo(c),
+		}
+
+		resp, err := exchangeOAuthCode.Exec(c, req)
+		if err != nil {
+			switch err.Error() {
+			case "invalid code", "account suspended":
+				c.JSON(http.StatusUnauthorized, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
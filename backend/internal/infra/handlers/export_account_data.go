@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ExportAccountData godoc
+// @Summary      Export my data
+// @Description  Download everything stored about the current user as a JSON archive: the account and its rows in every table, keyed by column names
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} services.AccountExport
+// @Failure      401 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/data-export [get]
+func ExportAccountData(exportAccountData *services.ExportAccountData) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.ExportAccountDataReq{
+			UserID: userID.(string),
+		}
+
+		resp, err := exportAccountData.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		filename := fmt.Sprintf("blog0-export-%s.json", resp.ExportedAt.Format("2006-01-02"))
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		c.Header("Cache-Control", "no-store")
+		c.JSON(http.StatusOK, resp)
+	}
+}
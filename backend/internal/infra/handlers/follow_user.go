@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
@@ -10,7 +11,7 @@ import (
 
 // FollowUser godoc
 // @Summary      Follow user
-// @Description  Follow an author (requires authentication)
+// @Description  Follow an author, doing nothing when already following them (requires authentication)
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
@@ -18,8 +19,10 @@ import (
 // @Success      200       {object} services.FollowUserResp
 // @Failure      400       {object} ErrorResp
 // @Failure      401       {object} ErrorResp
+// @Failure      403       {object} ErrorResp
 // @Failure      404       {object} ErrorResp
 // @Failure      500       {object} ErrorResp
+// @Router       /api/v1/users/{author_id}/follow [put]
 // @Router       /api/v1/users/{author_id}/follow [post]
 func FollowUser(followUser *services.FollowUser) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -48,7 +51,14 @@ func FollowUser(followUser *services.FollowUser) gin.HandlerFunc {
 
 		resp, err := followUser.Exec(c, req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			switch {
+			case strings.HasPrefix(err.Error(), "author not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "unauthorized"):
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
 			return
 		}
 
@@ -58,7 +68,7 @@ func FollowUser(followUser *services.FollowUser) gin.HandlerFunc {
 
 // UnfollowUser godoc
 // @Summary      Unfollow user
-// @Description  Unfollow an author (requires authentication)
+// @Description  Unfollow an author, succeeding when not following them (requires authentication)
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
@@ -96,10 +106,14 @@ func UnfollowUser(unfollowUser *services.UnfollowUser) gin.HandlerFunc {
 
 		resp, err := unfollowUser.Exec(c, req)
 		if err != nil {
+			if strings.HasPrefix(err.Error(), "author not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
 			return
 		}
 
 		c.JSON(http.StatusOK, resp)
 	}
-}
\ No newline at end of file
+}
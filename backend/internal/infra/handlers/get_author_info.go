@@ -2,19 +2,22 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
+	"blog0/internal/domain"
 	"blog0/internal/services"
 )
 
 // GetAuthorInfo godoc
 // @Summary      Get author information
-// @Description  Get public information about an author
+// @Description  Get the public profile of an author, by ID or by handle (@jane). Previous handles redirect to the current one
 // @Accept       json
 // @Produce      json
-// @Param        author_id path     string true "Author ID"
+// @Param        author_id path     string true "Author ID or @handle"
 // @Success      200       {object} services.GetAuthorInfoResp
+// @Success      302       {string} string "Redirect from a previous handle"
 // @Failure      404       {object} ErrorResp
 // @Failure      500       {object} ErrorResp
 // @Router       /api/v1/users/{author_id} [get]
@@ -28,14 +31,25 @@ func GetAuthorInfo(getAuthorInfo *services.GetAuthorInfo) gin.HandlerFunc {
 
 		req := &services.GetAuthorInfoReq{
 			AuthorID: authorID,
+			ViewerID: c.GetString("user_id"),
 		}
 
 		resp, err := getAuthorInfo.Exec(c, req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			switch {
+			case strings.HasPrefix(err.Error(), "author not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		if domain.IsHandleRef(authorID) && !strings.EqualFold(authorID[1:], resp.Handle) {
+			c.Redirect(http.StatusFound, "/api/v1/users/@"+resp.Handle)
 			return
 		}
 
 		c.JSON(http.StatusOK, resp)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// GetAvatar godoc
+// @Summary      Get a user's avatar
+// @Description  The uploaded avatar image of a user. Profiles link to it with a version in the URL, so it is cached for long
+// @Produce      image/png,image/jpeg,image/gif,image/webp
+// @Param        author_id path     string true "Author ID"
+// @Success      200       {file}   file
+// @Failure      404       {object} ErrorResp
+// @Failure      500       {object} ErrorResp
+// @Router       /api/v1/users/{author_id}/avatar [get]
+func GetAvatar(getAvatar *services.GetAvatar) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &services.GetAvatarReq{
+			UserID: c.Param("author_id"),
+		}
+
+		avatar, err := getAvatar.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "avatar not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.Header("Cache-Control", "public, max-age=31536000, immutable")
+		c.Data(http.StatusOK, avatar.ContentType, avatar.Data)
+	}
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// GetBookmarkCollection godoc
+// @Summary      Get bookmark collection
+// @Description  Get a public bookmark collection and its posts, most recently added first. Private collections are only visible to their owner. Notes and read status are never shared
+// @Accept       json
+// @Produce      json
+// @Param        id       path     string true  "Collection ID"
+// @Param        page     query    int    false "Page number" default(1)
+// @Param        per_page query    int    false "Items per page (max 100)" default(20)
+// @Success      200      {object} services.GetBookmarkCollectionResp
+// @Failure      404      {object} ErrorResp
+// @Failure      500      {object} ErrorResp
+// @Router       /api/v1/collections/{id} [get]
+func GetBookmarkCollection(getBookmarkCollection *services.GetBookmarkCollection) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := getBookmarkCollection.ParseRequest(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := getBookmarkCollection.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "collection not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// GetCommentHistory godoc
+// @Summary      Get comment edit history
+// @Description  Get the previous bodies of an edited comment, newest first
+// @Accept       json
+// @Produce      json
+// @Param        slug path     string true "Post slug"
+// @Param        id   path     string true "Comment ID"
+// @Success      200  {object} services.GetCommentHistoryResp
+// @Failure      400  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/posts/{slug}/comments/{id}/history [get]
+func GetCommentHistory(getCommentHistory *services.GetCommentHistory) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		slug := c.Param("slug")
+		commentID := c.Param("id")
+		if slug == "" || commentID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "slug and id are required"})
+			return
+		}
+
+		req := &services.GetCommentHistoryReq{
+			Slug:      slug,
+			CommentID: commentID,
+		}
+
+		resp, err := getCommentHistory.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "post not found") || strings.HasPrefix(err.Error(), "comment not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
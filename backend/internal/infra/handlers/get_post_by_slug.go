@@ -5,12 +5,13 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"blog0/internal/domain"
 	"blog0/internal/services"
 )
 
 // GetPostBySlug godoc
 // @Summary      Get post by slug
-// @Description  Get post details by slug including comments
+// @Description  Get post details by slug including comments. Counts a view of the post for the rankings, once a day per reader
 // @Accept       json
 // @Produce      json
 // @Param        slug path     string true "Post slug"
@@ -26,8 +27,11 @@ func GetPostBySlug(getPostBySlug *services.GetPostBySlug) gin.HandlerFunc {
 			return
 		}
 
+		viewerID := c.GetString("user_id")
 		req := &services.GetPostBySlugReq{
-			Slug: slug,
+			Slug:       slug,
+			ViewerID:   viewerID,
+			VisitorKey: domain.VisitorKey(viewerID, c.ClientIP(), c.Request.UserAgent()),
 		}
 
 		resp, err := getPostBySlug.Exec(c, req)
@@ -38,4 +42,4 @@ func GetPostBySlug(getPostBySlug *services.GetPostBySlug) gin.HandlerFunc {
 
 		c.JSON(http.StatusOK, resp)
 	}
-}
\ No newline at end of file
+}
@@ -10,7 +10,7 @@ import (
 
 // GetProfile godoc
 // @Summary      Get user profile
-// @Description  Get user's following, bookmarks, and liked posts (requires authentication)
+// @Description  Get user's editable profile and how many users they follow and posts they bookmarked and liked (requires authentication)
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
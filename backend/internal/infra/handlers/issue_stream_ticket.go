@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// IssueStreamTicket godoc
+// @Summary      Get a stream ticket
+// @Description  Sign a ticket that opens my notification stream for a minute, for EventSource clients which can't send the Authorization header: GET /api/v1/me/stream?ticket=... (requires a signed in session)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      201 {object} services.IssueStreamTicketResp
+// @Failure      401 {object} ErrorResp
+// @Failure      403 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/stream/ticket [post]
+func IssueStreamTicket(issueStreamTicket *services.IssueStreamTicket) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.IssueStreamTicketReq{
+			UserID:    userID.(string),
+			SessionID: c.GetString("session_id"),
+		}
+
+		resp, err := issueStreamTicket.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "unauthorized") {
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// LinkIdentity godoc
+// @Summary      Link a provider
+// @Description  Get the URL that links a provider to my account; the web app navigates to it, it goes through the provider sign in and back to /settings/identities. The URL works for 5 minutes (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        provider  path     string true "Provider name"
+// @Success      200 {object} services.StartLinkIdentityResp
+// @Failure      401 {object} ErrorResp
+// @Failure      403 {object} ErrorResp
+// @Failure      404 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/identities/{provider} [post]
+func LinkIdentity(startLinkIdentity *services.StartLinkIdentity) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.StartLinkIdentityReq{
+			UserID:   userID.(string),
+			Provider: c.Param("provider"),
+		}
+
+		resp, err := startLinkIdentity.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "unauthorized"):
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "provider not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// UnlinkIdentity godoc
+// @Summary      Unlink a provider
+// @Description  Stop signing in with a provider. The last linked provider can't be unlinked (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        provider  path     string true "Provider name"
+// @Success      200 {object} services.UnlinkIdentityResp
+// @Failure      401 {object} ErrorResp
+// @Failure      403 {object} ErrorResp
+// @Failure      404 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/identities/{provider} [delete]
+func UnlinkIdentity(unlinkIdentity *services.UnlinkIdentity) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.UnlinkIdentityReq{
+			UserID:   userID.(string),
+			Provider: c.Param("provider"),
+		}
+
+		resp, err := unlinkIdentity.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "unauthorized"):
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "identity not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListAdminActions godoc
+// @Summary      List admin actions
+// @Description  Audit trail of the admins' triage actions, most recent first (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page     query    int false "Page number" default(1)
+// @Param        per_page query    int false "Items per page" default(20)
+// @Success      200      {object} services.ListAdminActionsResp
+// @Failure      401      {object} ErrorResp
+// @Failure      403      {object} ErrorResp
+// @Failure      500      {object} ErrorResp
+// @Router       /api/v1/admin/actions [get]
+func ListAdminActions(listAdminActions *services.ListAdminActions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := listAdminActions.ParseRequest(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := listAdminActions.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListBlocks godoc
+// @Summary      List blocked users
+// @Description  List the users I blocked, most recent first (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} services.ListBlocksResp
+// @Failure      401 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/blocks [get]
+func ListBlocks(listBlocks *services.ListBlocks) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.ListBlocksReq{
+			UserID: userID.(string),
+		}
+
+		resp, err := listBlocks.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListBookmarkCollections godoc
+// @Summary      List my bookmark collections
+// @Description  List my collections by name, with how many bookmarks each holds (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} services.ListBookmarkCollectionsResp
+// @Failure      401 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/collections [get]
+func ListBookmarkCollections(listBookmarkCollections *services.ListBookmarkCollections) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.ListBookmarkCollectionsReq{
+			UserID: userID.(string),
+		}
+
+		resp, err := listBookmarkCollections.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListBookmarks godoc
+// @Summary      List my bookmarks
+// @Description  List my bookmarks, most recent first, with their post, collection, private note and read status (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        collection_id query    string false "Only bookmarks of this collection, or none for those outside of any collection"
+// @Param        status        query    string false "Only read or unread bookmarks" Enums(read, unread)
+// @Param        page          query    int    false "Page number" default(1)
+// @Param        per_page      query    int    false "Items per page (max 100)" default(20)
+// @Success      200           {object} services.ListBookmarksResp
+// @Failure      400           {object} ErrorResp
+// @Failure      401           {object} ErrorResp
+// @Failure      404           {object} ErrorResp
+// @Failure      500           {object} ErrorResp
+// @Router       /api/v1/me/bookmarks [get]
+func ListBookmarks(listBookmarks *services.ListBookmarks) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req, err := listBookmarks.ParseRequest(c, userID.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := listBookmarks.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "collection not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
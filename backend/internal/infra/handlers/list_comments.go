@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListComments godoc
+// @Summary      List post comments
+// @Description  List comment threads of a post, nested up to the requested depth
+// @Accept       json
+// @Produce      json
+// @Param        slug      path     string true  "Post slug"
+// @Param        sort      query    string false "Sort mode" Enums(oldest, newest, top) default(oldest)
+// @Param        depth     query    int    false "Levels of nesting to return (1-5)" default(3)
+// @Param        per_page  query    int    false "Threads per page" default(20)
+// @Param        replies   query    int    false "Replies returned per comment" default(3)
+// @Param        parent_id query    string false "Only list replies of this comment"
+// @Param        cursor    query    string false "Cursor returned by the previous page"
+// @Success      200       {object} services.ListCommentsResp
+// @Failure      400       {object} ErrorResp
+// @Failure      404       {object} ErrorResp
+// @Failure      500       {object} ErrorResp
+// @Router       /api/v1/posts/{slug}/comments [get]
+func ListComments(listComments *services.ListComments) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		slug := c.Param("slug")
+		if slug == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "slug is required"})
+			return
+		}
+
+		req, err := listComments.ParseRequest(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := listComments.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "post not found") || strings.HasPrefix(err.Error(), "parent comment not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
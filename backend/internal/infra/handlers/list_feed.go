@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListFeed godoc
+// @Summary      List home feed
+// @Description  List published posts from followed authors using cursor pagination (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        cursor        query    string false "Cursor returned by the previous page"
+// @Param        per_page      query    int    false "Items per page" default(20)
+// @Param        include_liked query    bool   false "Also include posts liked by followed users" default(false)
+// @Success      200           {object} services.ListFeedResp
+// @Failure      400           {object} ErrorResp
+// @Failure      401           {object} ErrorResp
+// @Failure      500           {object} ErrorResp
+// @Router       /api/v1/me/feed [get]
+func ListFeed(listFeed *services.ListFeed) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req, err := listFeed.ParseRequest(c, userID.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := listFeed.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
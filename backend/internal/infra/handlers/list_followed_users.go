@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListFollowedUsers godoc
+// @Summary      List followed users
+// @Description  List the users I follow, most recently followed first, with their post and follower counts (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page     query    int false "Page number" default(1)
+// @Param        per_page query    int false "Items per page (max 100)" default(20)
+// @Success      200      {object} services.ListFollowedUsersResp
+// @Failure      401      {object} ErrorResp
+// @Failure      500      {object} ErrorResp
+// @Router       /api/v1/me/following [get]
+func ListFollowedUsers(listFollowedUsers *services.ListFollowedUsers) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req, err := listFollowedUsers.ParseRequest(c, userID.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := listFollowedUsers.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
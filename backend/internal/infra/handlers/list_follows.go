@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListFollowers godoc
+// @Summary      List followers
+// @Description  List the users following an author, most recent first. Signed in viewers also learn whether they follow each of them
+// @Accept       json
+// @Produce      json
+// @Param        author_id path     string true  "Author ID or @handle"
+// @Param        page      query    int    false "Page number" default(1)
+// @Param        per_page  query    int    false "Items per page (max 100)" default(20)
+// @Success      200       {object} services.ListFollowsResp
+// @Failure      404       {object} ErrorResp
+// @Failure      500       {object} ErrorResp
+// @Router       /api/v1/users/{author_id}/followers [get]
+func ListFollowers(listFollows *services.ListFollows) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		execListFollows(c, listFollows, services.FollowListFollowers)
+	}
+}
+
+// ListFollowing godoc
+// @Summary      List followed users
+// @Description  List the users an author follows, most recent first. Signed in viewers also learn whether they follow each of them
+// @Accept       json
+// @Produce      json
+// @Param        author_id path     string true  "Author ID or @handle"
+// @Param        page      query    int    false "Page number" default(1)
+// @Param        per_page  query    int    false "Items per page (max 100)" default(20)
+// @Success      200       {object} services.ListFollowsResp
+// @Failure      404       {object} ErrorResp
+// @Failure      500       {object} ErrorResp
+// @Router       /api/v1/users/{author_id}/following [get]
+func ListFollowing(listFollows *services.ListFollows) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		execListFollows(c, listFollows, services.FollowListFollowing)
+	}
+}
+
+func execListFollows(c *gin.Context, listFollows *services.ListFollows, list string) {
+	req, err := listFollows.ParseRequest(c, list)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+		return
+	}
+
+	resp, err := listFollows.Exec(c, req)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "author not found") {
+			c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
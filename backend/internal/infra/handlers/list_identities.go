@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListIdentities godoc
+// @Summary      List linked providers
+// @Description  List the providers I can sign in with, and the configured ones I can still link (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} services.ListIdentitiesResp
+// @Failure      401 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/identities [get]
+func ListIdentities(listIdentities *services.ListIdentities) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.ListIdentitiesReq{
+			UserID: userID.(string),
+		}
+
+		resp, err := listIdentities.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListMentions godoc
+// @Summary      List my mentions
+// @Description  List the published posts and comments where I was mentioned, newest first (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page     query    int    false  "Page number" default(1)
+// @Param        per_page query    int    false  "Items per page" default(20)
+// @Success      200      {object} services.ListMentionsResp
+// @Failure      400      {object} ErrorResp
+// @Failure      401      {object} ErrorResp
+// @Failure      500      {object} ErrorResp
+// @Router       /api/v1/me/mentions [get]
+func ListMentions(listMentions *services.ListMentions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req, err := listMentions.ParseRequest(c, userID.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := listMentions.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListModerationQueue godoc
+// @Summary      List held comments
+// @Description  List the comments on my posts that are waiting for review, or the ones that were rejected (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        status   query    string false  "Comment status" Enums(pending, rejected) default(pending)
+// @Param        page     query    int    false  "Page number" default(1)
+// @Param        per_page query    int    false  "Items per page" default(20)
+// @Success      200      {object} services.ListModerationQueueResp
+// @Failure      400      {object} ErrorResp
+// @Failure      401      {object} ErrorResp
+// @Failure      500      {object} ErrorResp
+// @Router       /api/v1/me/moderation [get]
+func ListModerationQueue(listModerationQueue *services.ListModerationQueue) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req, err := listModerationQueue.ParseRequest(c, userID.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := listModerationQueue.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListNotifications godoc
+// @Summary      List my notifications
+// @Description  List aggregated notifications with the unread count (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page        query    int  false "Page number" default(1)
+// @Param        per_page    query    int  false "Items per page" default(20)
+// @Param        unread_only query    bool false "Only unread notifications" default(false)
+// @Success      200         {object} services.ListNotificationsResp
+// @Failure      400         {object} ErrorResp
+// @Failure      401         {object} ErrorResp
+// @Failure      500         {object} ErrorResp
+// @Router       /api/v1/me/notifications [get]
+func ListNotifications(listNotifications *services.ListNotifications) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req, err := listNotifications.ParseRequest(c, userID.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := listNotifications.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListOAuthProviders godoc
+// @Summary      List sign in providers
+// @Description  List the names of the configured sign in providers, to use with /auth/{provider}
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} services.ListOAuthProvidersResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/auth/providers [get]
+func ListOAuthProviders(listOAuthProviders *services.ListOAuthProviders) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		resp, err := listOAuthProviders.Exec(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
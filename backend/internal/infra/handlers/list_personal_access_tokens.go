@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListPersonalAccessTokens godoc
+// @Summary      List personal access tokens
+// @Description  List my personal access tokens with their scopes, expiry and last use. The tokens themselves are not shown again (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} services.ListPersonalAccessTokensResp
+// @Failure      401 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/tokens [get]
+func ListPersonalAccessTokens(listPersonalAccessTokens *services.ListPersonalAccessTokens) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.ListPersonalAccessTokensReq{
+			UserID: userID.(string),
+		}
+
+		resp, err := listPersonalAccessTokens.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
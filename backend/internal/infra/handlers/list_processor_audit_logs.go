@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListProcessorAuditLogs godoc
+// @Summary      List processor audit logs
+// @Description  Calls made by a processor client, most recent first. Use "legacy" for the PROCESSOR_SECRET client (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path     string true  "Client ID"
+// @Param        page     query    int    false "Page number" default(1)
+// @Param        per_page query    int    false "Items per page" default(20)
+// @Success      200      {object} services.ListProcessorAuditLogsResp
+// @Failure      401      {object} ErrorResp
+// @Failure      403      {object} ErrorResp
+// @Failure      500      {object} ErrorResp
+// @Router       /api/v1/admin/processor-clients/{id}/audit [get]
+func ListProcessorAuditLogs(listProcessorAuditLogs *services.ListProcessorAuditLogs) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := listProcessorAuditLogs.ParseRequest(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := listProcessorAuditLogs.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
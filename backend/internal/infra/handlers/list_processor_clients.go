@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListProcessorClients godoc
+// @Summary      List processor clients
+// @Description  List the registered processor clients (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} services.ListProcessorClientsResp
+// @Failure      401 {object} ErrorResp
+// @Failure      403 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/admin/processor-clients [get]
+func ListProcessorClients(listProcessorClients *services.ListProcessorClients) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		resp, err := listProcessorClients.Exec(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
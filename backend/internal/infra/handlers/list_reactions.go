@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListReactions godoc
+// @Summary      List reactions
+// @Description  List the reactions available on posts and comments, in display order
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} services.ListReactionsResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/reactions [get]
+func ListReactions(listReactions *services.ListReactions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		resp, err := listReactions.Exec(c)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListReports godoc
+// @Summary      List reports
+// @Description  Admin queue of reports, oldest open reports first (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        status      query    string false "Report status" Enums(open, dismissed, actioned) default(open)
+// @Param        target_type query    string false "Reported target" Enums(post, comment, user)
+// @Param        page        query    int    false "Page number" default(1)
+// @Param        per_page    query    int    false "Items per page" default(20)
+// @Success      200         {object} services.ListReportsResp
+// @Failure      400         {object} ErrorResp
+// @Failure      401         {object} ErrorResp
+// @Failure      403         {object} ErrorResp
+// @Failure      500         {object} ErrorResp
+// @Router       /api/v1/admin/reports [get]
+func ListReports(listReports *services.ListReports) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := listReports.ParseRequest(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := listReports.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListTrendingPosts godoc
+// @Summary      List trending posts
+// @Description  List the posts with the best time-decayed score from their likes, comments, bookmarks and views within the window. Rankings are refreshed periodically, signed in readers don't see the posts of users they muted or blocked
+// @Accept       json
+// @Produce      json
+// @Param        window   query    string false "Ranking window" Enums(day, week, month) default(day)
+// @Param        page     query    int    false "Page number" default(1)
+// @Param        per_page query    int    false "Items per page (max 100)" default(20)
+// @Success      200      {object} services.ListTrendingPostsResp
+// @Failure      400      {object} ErrorResp
+// @Failure      500      {object} ErrorResp
+// @Router       /api/v1/posts/trending [get]
+func ListTrendingPosts(listTrendingPosts *services.ListTrendingPosts) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := listTrendingPosts.ParseRequest(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := listTrendingPosts.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
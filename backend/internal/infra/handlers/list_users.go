@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// ListUsers godoc
+// @Summary      List users
+// @Description  List users with their role and suspension, filtered by username or email (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        q         query    string false "Username or email contains"
+// @Param        role      query    string false "Role" Enums(reader, author, editor, admin)
+// @Param        suspended query    bool   false "Only suspended, or only active, users"
+// @Param        page      query    int    false "Page number" default(1)
+// @Param        per_page  query    int    false "Items per page" default(20)
+// @Success      200       {object} services.ListUsersResp
+// @Failure      400       {object} ErrorResp
+// @Failure      401       {object} ErrorResp
+// @Failure      403       {object} ErrorResp
+// @Failure      500       {object} ErrorResp
+// @Router       /api/v1/admin/users [get]
+func ListUsers(listUsers *services.ListUsers) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := listUsers.ParseRequest(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := listUsers.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// Logout godoc
+// @Summary      Log out
+// @Description  Revoke the session of the access token, its refresh token stops working (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} services.LogoutResp
+// @Failure      401 {object} ErrorResp
+// @Failure      404 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/auth/logout [post]
+func Logout(logout *services.Logout) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.LogoutReq{
+			UserID:    userID.(string),
+			SessionID: c.GetString("session_id"),
+		}
+
+		resp, err := logout.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "session not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
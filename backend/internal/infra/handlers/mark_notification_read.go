@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// MarkNotificationRead godoc
+// @Summary      Mark notification as read
+// @Description  Mark one of my notifications as read (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id  path     string true "Notification ID"
+// @Success      200 {object} services.MarkNotificationReadResp
+// @Failure      400 {object} ErrorResp
+// @Failure      401 {object} ErrorResp
+// @Failure      404 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/notifications/{id}/read [post]
+func MarkNotificationRead(markNotificationRead *services.MarkNotificationRead) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		notificationID := c.Param("id")
+		if notificationID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id is required"})
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.MarkNotificationReadReq{
+			NotificationID: notificationID,
+			UserID:         userID.(string),
+		}
+
+		resp, err := markNotificationRead.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "notification not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary      Mark all notifications as read
+// @Description  Mark every unread notification of the user as read (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} services.MarkAllNotificationsReadResp
+// @Failure      401 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/notifications/read-all [post]
+func MarkAllNotificationsRead(markAllNotificationsRead *services.MarkAllNotificationsRead) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.MarkAllNotificationsReadReq{
+			UserID: userID.(string),
+		}
+
+		resp, err := markAllNotificationsRead.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+type RejectCommentReq struct {
+	Reason string `json:"reason"`
+}
+
+// ApproveComment godoc
+// @Summary      Approve comment
+// @Description  Publish a held or rejected comment on one of my posts (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id  path     string true "Comment ID"
+// @Success      200 {object} services.ModerateCommentResp
+// @Failure      400 {object} ErrorResp
+// @Failure      401 {object} ErrorResp
+// @Failure      403 {object} ErrorResp
+// @Failure      404 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/moderation/{id}/approve [post]
+func ApproveComment(moderateComment *services.ModerateComment) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		execModerateComment(c, moderateComment, services.ModerationActionApprove, "")
+	}
+}
+
+// RejectComment godoc
+// @Summary      Reject comment
+// @Description  Hide a comment on one of my posts, with an optional reason (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path     string           true  "Comment ID"
+// @Param        body body     RejectCommentReq false "Rejection reason"
+// @Success      200  {object} services.ModerateCommentResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/me/moderation/{id}/reject [post]
+func RejectComment(moderateComment *services.ModerateComment) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body RejectCommentReq
+		if c.Request.ContentLength > 0 {
+			if err := c.ShouldBindJSON(&body); err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+				return
+			}
+		}
+
+		execModerateComment(c, moderateComment, services.ModerationActionReject, body.Reason)
+	}
+}
+
+func execModerateComment(c *gin.Context, moderateComment *services.ModerateComment, action string, reason string) {
+	commentID := c.Param("id")
+	if commentID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResp{Error: "id is required"})
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+		return
+	}
+
+	req := &services.ModerateCommentReq{
+		CommentID: commentID,
+		UserID:    userID.(string),
+		Action:    action,
+		Reason:    reason,
+	}
+
+	resp, err := moderateComment.Exec(c, req)
+	if err != nil {
+		switch {
+		case strings.HasPrefix(err.Error(), "unauthorized:"):
+			c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+		case strings.HasPrefix(err.Error(), "comment not found"), strings.HasPrefix(err.Error(), "post not found"):
+			c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+		case err.Error() == "parent comment must be approved first":
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// MuteUser godoc
+// @Summary      Mute user
+// @Description  Mute a user: their posts and comments are hidden from my lists and threads (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id path     string true "User ID"
+// @Success      200     {object} services.MuteUserResp
+// @Failure      400     {object} ErrorResp
+// @Failure      401     {object} ErrorResp
+// @Failure      404     {object} ErrorResp
+// @Failure      500     {object} ErrorResp
+// @Router       /api/v1/me/mutes/{user_id} [post]
+func MuteUser(muteUser *services.MuteUser) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		mutedID := c.Param("user_id")
+		if mutedID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "user_id is required"})
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.MuteUserReq{
+			MutedID: mutedID,
+			UserID:  userID.(string),
+		}
+
+		resp, err := muteUser.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "user not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "failed to create mute"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// UnmuteUser godoc
+// @Summary      Unmute user
+// @Description  Remove a mute (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id path     string true "User ID"
+// @Success      200     {object} services.UnmuteUserResp
+// @Failure      400     {object} ErrorResp
+// @Failure      401     {object} ErrorResp
+// @Failure      404     {object} ErrorResp
+// @Failure      500     {object} ErrorResp
+// @Router       /api/v1/me/mutes/{user_id} [delete]
+func UnmuteUser(unmuteUser *services.UnmuteUser) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		mutedID := c.Param("user_id")
+		if mutedID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "user_id is required"})
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.UnmuteUserReq{
+			MutedID: mutedID,
+			UserID:  userID.(string),
+		}
+
+		resp, err := unmuteUser.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "mute not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
@@ -10,18 +11,37 @@ import (
 
 // OAuthCallback godoc
 // @Summary      OAuthCallback
-// @Description  OAuthCallback
+// @Description  Checks the state against the state cookie, finishes the sign in and redirects to the web app with a one-time code to exchange at /auth/exchange. Provider links redirect to /settings/identities instead
 // @Accept       json
 // @Produce      json
+// @Param        provider    path    string    true    "Provider name"
+// @Param        code        query   string    true    "Authorization code from the provider"
+// @Param        state       query   string    true    "State sent to the provider"
 // @Success      307
 // @Failure      400    {object}    ErrorResp
+// @Failure      403    {object}    ErrorResp
+// @Failure      404    {object}    ErrorResp
 // @Router       /api/v1/auth/{provider}/callback [get]
 func OAuthCallback(finishOAuth *services.FinishOAuth) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		signedState, _ := c.Cookie(oauthStateCookie)
+		setOAuthStateCookie(c, "", -1)
+
 		resp, err := finishOAuth.Exec(c, services.FinishOAuthReq{
-			Code: c.Query("code"),
+			Provider:    c.Param("provider"),
+			Code:        c.Query("code"),
+			State:       c.Query("state"),
+			SignedState: signedState,
 		})
 		if err != nil {
+			if strings.HasPrefix(err.Error(), "provider not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			if strings.HasPrefix(err.Error(), "unauthorized") {
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+				return
+			}
 			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
 			return
 		}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/services"
+)
+
+type ProcessorCreatePostReq struct {
+	AuthorID    string `json:"author_id"`
+	Title       string `json:"title" binding:"required"`
+	Slug        string `json:"slug" binding:"required"`
+	RawMarkdown string `json:"raw_markdown" binding:"required"`
+	Publish     bool   `json:"publish"`
+}
+
+// ProcessorCreatePost godoc
+// @Summary      Create a post as a processor client
+// @Description  Create a post as one of the authors of the client. author_id may be left out when the client has a single author
+// @Accept       json
+// @Produce      json
+// @Security     BasicAuth
+// @Param        body body     ProcessorCreatePostReq true "Post data"
+// @Success      201  {object} services.CreatePostResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/p/v1/posts [post]
+func ProcessorCreatePost(processorCreatePost *services.ProcessorCreatePost) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body ProcessorCreatePostReq
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		req := &services.ProcessorCreatePostReq{
+			Client:      c.MustGet("processor_client").(*domain.ProcessorClient),
+			AuthorID:    body.AuthorID,
+			Title:       body.Title,
+			Slug:        body.Slug,
+			RawMarkdown: body.RawMarkdown,
+			Publish:     body.Publish,
+		}
+
+		resp, err := processorCreatePost.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "unauthorized"):
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "failed to create post"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
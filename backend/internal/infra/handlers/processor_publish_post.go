@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/services"
+)
+
+// ProcessorPublishPost godoc
+// @Summary      Publish a post as a processor client
+// @Description  Publish a post of one of the authors of the client
+// @Accept       json
+// @Produce      json
+// @Security     BasicAuth
+// @Param        slug path     string true "Post slug"
+// @Success      200  {object} services.UpdatePostResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/p/v1/posts/{slug}/publish [post]
+func ProcessorPublishPost(processorPublishPost *services.ProcessorPublishPost) gin.HandlerFunc {
+	return processorSetPublished(processorPublishPost, true)
+}
+
+// ProcessorUnpublishPost godoc
+// @Summary      Unpublish a post as a processor client
+// @Description  Take a post of one of the authors of the client back to draft
+// @Accept       json
+// @Produce      json
+// @Security     BasicAuth
+// @Param        slug path     string true "Post slug"
+// @Success      200  {object} services.UpdatePostResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/p/v1/posts/{slug}/publish [delete]
+func ProcessorUnpublishPost(processorPublishPost *services.ProcessorPublishPost) gin.HandlerFunc {
+	return processorSetPublished(processorPublishPost, false)
+}
+
+func processorSetPublished(processorPublishPost *services.ProcessorPublishPost, publish bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &services.ProcessorPublishPostReq{
+			Client:  c.MustGet("processor_client").(*domain.ProcessorClient),
+			Slug:    c.Param("slug"),
+			Publish: publish,
+		}
+
+		resp, err := processorPublishPost.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "unauthorized"):
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "post not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/services"
+)
+
+// ProcessorPurgeAccounts godoc
+// @Summary      Purge deleted accounts
+// @Description  Scheduled job: purge the accounts whose deletion grace period is over, following the deletion policy
+// @Accept       json
+// @Produce      json
+// @Security     BasicAuth
+// @Success      200 {object} services.PurgeDeletedAccountsResp
+// @Failure      401 {object} ErrorResp
+// @Failure      403 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/p/v1/jobs/purge-accounts [post]
+func ProcessorPurgeAccounts(processorPurgeAccounts *services.ProcessorPurgeAccounts) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &services.ProcessorPurgeAccountsReq{
+			Client: c.MustGet("processor_client").(*domain.ProcessorClient),
+		}
+
+		resp, err := processorPurgeAccounts.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "unauthorized"):
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/services"
+)
+
+// ProcessorRefreshRankings godoc
+// @Summary      Refresh post rankings
+// @Description  Scheduled job: rescore the trending posts of every window and prune the views older than the longest one
+// @Accept       json
+// @Produce      json
+// @Security     BasicAuth
+// @Success      200 {object} services.RefreshRankingsResp
+// @Failure      401 {object} ErrorResp
+// @Failure      403 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/p/v1/jobs/refresh-rankings [post]
+func ProcessorRefreshRankings(processorRefreshRankings *services.ProcessorRefreshRankings) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &services.ProcessorRefreshRankingsReq{
+			Client: c.MustGet("processor_client").(*domain.ProcessorClient),
+		}
+
+		resp, err := processorRefreshRankings.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "unauthorized"):
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
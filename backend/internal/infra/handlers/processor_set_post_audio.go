@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/services"
+)
+
+// ProcessorSetPostAudio godoc
+// @Summary      Set the audio of a post
+// @Description  Callback for the audio jobs: store the narration URLs of a post. Fields left out are kept
+// @Accept       json
+// @Produce      json
+// @Security     BasicAuth
+// @Param        slug path     string                            true "Post slug"
+// @Param        body body     services.ProcessorSetPostAudioReq true "Audio URLs"
+// @Success      200  {object} services.ProcessorSetPostAudioResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/p/v1/posts/{slug}/audio [put]
+func ProcessorSetPostAudio(processorSetPostAudio *services.ProcessorSetPostAudio) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req services.ProcessorSetPostAudioReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+		req.Client = c.MustGet("processor_client").(*domain.ProcessorClient)
+		req.Slug = c.Param("slug")
+
+		resp, err := processorSetPostAudio.Exec(c, &req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "unauthorized"):
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "post not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "failed to set audio"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+type RefreshSessionReq struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// RefreshSession godoc
+// @Summary      Refresh tokens
+// @Description  Trade a refresh token for a new access token and a new refresh token. Each refresh token works once
+// @Accept       json
+// @Produce      json
+// @Param        body body     RefreshSessionReq true "Refresh token"
+// @Success      200  {object} services.TokenPair
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/auth/refresh [post]
+func RefreshSession(refreshSession *services.RefreshSession) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body RefreshSessionReq
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		req := &services.RefreshSessionReq{
+			RefreshToken: body.RefreshToken,
+			Client:       clientInfo(c),
+		}
+
+		resp, err := refreshSession.Exec(c, req)
+		if err != nil {
+			switch err.Error() {
+			case "invalid refresh token", "account suspended":
+				c.JSON(http.StatusUnauthorized, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
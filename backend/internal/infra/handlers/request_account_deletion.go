@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// RequestAccountDeletion godoc
+// @Summary      Delete my account
+// @Description  Schedule the deletion of the current user's account, confirmed with their handle. The account keeps working, and the deletion can be cancelled, until the grace period is over
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body body     services.RequestAccountDeletionReq true "Confirmation"
+// @Success      202  {object} services.AccountDeletionResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/me [delete]
+func RequestAccountDeletion(requestAccountDeletion *services.RequestAccountDeletion) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		var req services.RequestAccountDeletionReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+		req.UserID = userID.(string)
+
+		resp, err := requestAccountDeletion.Exec(c, &req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "failed to delete account"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusAccepted, resp)
+	}
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/services"
+)
+
+type ResolveReportReq struct {
+	Note string `json:"note"`
+}
+
+// DismissReport godoc
+// @Summary      Dismiss report
+// @Description  Close a report, and the other open reports on the same target, without action (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path     string           true  "Report ID"
+// @Param        body body     ResolveReportReq false "Audit note"
+// @Success      200  {object} services.ResolveReportResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/admin/reports/{id}/dismiss [post]
+func DismissReport(resolveReport *services.ResolveReport) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		execResolveReport(c, resolveReport, domain.ReportActionDismiss)
+	}
+}
+
+// HideReportedContent godoc
+// @Summary      Hide reported content
+// @Description  Hide the reported post or comment from every public listing and close its open reports (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path     string           true  "Report ID"
+// @Param        body body     ResolveReportReq false "Audit note"
+// @Success      200  {object} services.ResolveReportResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/admin/reports/{id}/hide [post]
+func HideReportedContent(resolveReport *services.ResolveReport) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		execResolveReport(c, resolveReport, domain.ReportActionHideContent)
+	}
+}
+
+// SuspendReportedUser godoc
+// @Summary      Suspend reported user
+// @Description  Suspend the reported user, or the author of the reported content, and close the open reports on the target (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path     string           true  "Report ID"
+// @Param        body body     ResolveReportReq false "Audit note"
+// @Success      200  {object} services.ResolveReportResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/admin/reports/{id}/suspend [post]
+func SuspendReportedUser(resolveReport *services.ResolveReport) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		execResolveReport(c, resolveReport, domain.ReportActionSuspendUser)
+	}
+}
+
+func execResolveReport(c *gin.Context, resolveReport *services.ResolveReport, action string) {
+	reportID := c.Param("id")
+	if reportID == "" {
+		c.JSON(http.StatusBadRequest, ErrorResp{Error: "id is required"})
+		return
+	}
+
+	var body ResolveReportReq
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+		return
+	}
+
+	req := &services.ResolveReportReq{
+		ReportID: reportID,
+		AdminID:  userID.(string),
+		Action:   action,
+		Note:     body.Note,
+	}
+
+	resp, err := resolveReport.Exec(c, req)
+	if err != nil {
+		switch {
+		case strings.HasPrefix(err.Error(), "report not found"),
+			strings.HasPrefix(err.Error(), "post not found"),
+			strings.HasPrefix(err.Error(), "comment not found"),
+			strings.HasPrefix(err.Error(), "user not found"):
+			c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+		case strings.HasPrefix(err.Error(), "failed to resolve report"):
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// RevokePersonalAccessToken godoc
+// @Summary      Revoke a personal access token
+// @Description  Revoke one of my personal access tokens, it stops working right away (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id  path     string true "Token ID"
+// @Success      200 {object} services.RevokePersonalAccessTokenResp
+// @Failure      400 {object} ErrorResp
+// @Failure      401 {object} ErrorResp
+// @Failure      404 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/tokens/{id} [delete]
+func RevokePersonalAccessToken(revokePersonalAccessToken *services.RevokePersonalAccessToken) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tokenID := c.Param("id")
+		if tokenID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id is required"})
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.RevokePersonalAccessTokenReq{
+			UserID:  userID.(string),
+			TokenID: tokenID,
+		}
+
+		resp, err := revokePersonalAccessToken.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "token not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// RevokeSession godoc
+// @Summary      Revoke session
+// @Description  Sign one of my sessions out, its tokens stop working (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id  path     string true "Session ID"
+// @Success      200 {object} services.RevokeSessionResp
+// @Failure      400 {object} ErrorResp
+// @Failure      401 {object} ErrorResp
+// @Failure      404 {object} ErrorResp
+// @Failure      500 {object} ErrorResp
+// @Router       /api/v1/me/sessions/{id} [delete]
+func RevokeSession(revokeSession *services.RevokeSession) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sessionID := c.Param("id")
+		if sessionID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id is required"})
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.RevokeSessionReq{
+			UserID:    userID.(string),
+			SessionID: sessionID,
+		}
+
+		resp, err := revokeSession.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "session not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
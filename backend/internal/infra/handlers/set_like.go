@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// LikePost godoc
+// @Summary      Like post
+// @Description  Like a post, doing nothing when it is already liked (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        slug path     string true "Post slug"
+// @Success      200  {object} services.ToggleLikeResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/posts/{slug}/likes [put]
+func LikePost(setLike *services.SetLike) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		execSetLike(c, setLike, true)
+	}
+}
+
+// UnlikePost godoc
+// @Summary      Unlike post
+// @Description  Remove my like from a post, doing nothing when it isn't liked (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        slug path     string true "Post slug"
+// @Success      200  {object} services.ToggleLikeResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/posts/{slug}/likes [delete]
+func UnlikePost(setLike *services.SetLike) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		execSetLike(c, setLike, false)
+	}
+}
+
+func execSetLike(c *gin.Context, setLike *services.SetLike, liked bool) {
+	slug := c.Param("slug")
+	if slug == "" {
+		c.JSON(http.StatusBadRequest, ErrorResp{Error: "slug is required"})
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+		return
+	}
+
+	req := &services.SetLikeReq{
+		Slug:   slug,
+		UserID: userID.(string),
+		Liked:  liked,
+	}
+
+	resp, err := setLike.Exec(c, req)
+	if err != nil {
+		switch {
+		case strings.HasPrefix(err.Error(), "post not found"):
+			c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+		case strings.HasPrefix(err.Error(), "unauthorized"):
+			c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
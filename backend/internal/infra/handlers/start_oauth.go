@@ -2,28 +2,60 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
 	"blog0/internal/services"
 )
 
+// oauthStateCookie keeps the signed state and PKCE verifier of a sign in
+// between the redirect to the provider and its callback.
+const (
+	oauthStateCookie     = "oauth_state"
+	oauthStateCookiePath = "/api/v1/auth"
+)
+
 // StartOAuth godoc
 // @Summary      StartOAuth
-// @Description  StartOAuth
+// @Description  Redirects to the sign in page of the provider, one of the names listed by /auth/providers. The state and PKCE verifier of the sign in are kept in a short-lived cookie
 // @Accept       json
 // @Produce      json
+// @Param        provider    path    string    true    "Provider name, e.g. google, github, gitlab"
+// @Param        link_token  query   string    false   "Links the provider to an account instead of signing in, from POST /me/identities/{provider}"
 // @Success      307
+// @Failure      400    {object}    ErrorResp
+// @Failure      404    {object}    ErrorResp
 // @Failure      500    {object}    ErrorResp
 // @Router       /api/v1/auth/{provider} [get]
 func StartOAuth(startOAuth *services.StartOAuth) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		resp, err := startOAuth.Exec(c)
+		resp, err := startOAuth.Exec(c, services.StartOAuthReq{
+			Provider:  c.Param("provider"),
+			LinkToken: c.Query("link_token"),
+		})
 		if err != nil {
+			if strings.HasPrefix(err.Error(), "provider not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			if err.Error() == "invalid link token" {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
 			return
 		}
 
+		setOAuthStateCookie(c, resp.SignedState, resp.ExpiresIn)
 		c.Redirect(http.StatusTemporaryRedirect, resp.URL)
 	}
 }
+
+// setOAuthStateCookie sets the state cookie, or clears it for a negative
+// maxAge. SameSite=Lax lets it ride along the redirect back from the provider.
+func setOAuthStateCookie(c *gin.Context, value string, maxAge int) {
+	secure := c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https"
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oauthStateCookie, value, maxAge, oauthStateCookiePath, "", secure, true)
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/services"
+)
+
+// streamHeartbeat keeps idle connections from being closed by proxies
+const streamHeartbeat = 25 * time.Second
+
+// StreamPostEvents godoc
+// @Summary      Stream post events
+// @Description  Server-Sent Events stream of new comments and like count changes on a post
+// @Produce      text/event-stream
+// @Param        slug path     string true "Post slug"
+// @Success      200  {string} string "event stream"
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/posts/{slug}/stream [get]
+func StreamPostEvents(subscribePostEvents *services.SubscribePostEvents) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		slug := c.Param("slug")
+		if slug == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "slug is required"})
+			return
+		}
+
+		resp, err := subscribePostEvents.Exec(c, &services.SubscribePostEventsReq{Slug: slug})
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "post not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+		defer resp.Unsubscribe()
+
+		streamEvents(c, resp.Events)
+	}
+}
+
+// StreamNotifications godoc
+// @Summary      Stream my notifications
+// @Description  Server-Sent Events stream of new notifications. Authenticate with the Authorization header, or with a ticket from POST /api/v1/me/stream/ticket for EventSource
+// @Produce      text/event-stream
+// @Security     BearerAuth
+// @Param        ticket query    string false "Stream ticket"
+// @Success      200    {string} string "event stream"
+// @Failure      401    {object} ErrorResp
+// @Failure      500    {object} ErrorResp
+// @Router       /api/v1/me/stream [get]
+func StreamNotifications(subscribeNotifications *services.SubscribeNotifications) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		resp, err := subscribeNotifications.Exec(c, &services.SubscribeNotificationsReq{UserID: userID.(string)})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+		defer resp.Unsubscribe()
+
+		streamEvents(c, resp.Events)
+	}
+}
+
+func streamEvents(c *gin.Context, events <-chan domain.RealtimeEvent) {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+			c.SSEvent(event.Type, event.Data)
+			return true
+		case <-heartbeat.C:
+			_, err := io.WriteString(w, ": ping\n\n")
+			return err == nil
+		}
+	})
+}
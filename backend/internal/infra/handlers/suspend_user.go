@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+type SuspendUserReq struct {
+	Note string `json:"note"`
+}
+
+// SuspendUser godoc
+// @Summary      Suspend user
+// @Description  Suspend a user: they can no longer sign in and their requests become read-only (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id path     string         true  "User ID"
+// @Param        body    body     SuspendUserReq false "Audit note"
+// @Success      200     {object} services.AdminUserItem
+// @Failure      400     {object} ErrorResp
+// @Failure      401     {object} ErrorResp
+// @Failure      403     {object} ErrorResp
+// @Failure      404     {object} ErrorResp
+// @Failure      500     {object} ErrorResp
+// @Router       /api/v1/admin/users/{user_id}/suspension [post]
+func SuspendUser(suspendUser *services.SuspendUser) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		targetID := c.Param("user_id")
+		if targetID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "user_id is required"})
+			return
+		}
+
+		var body SuspendUserReq
+		if c.Request.ContentLength > 0 {
+			if err := c.ShouldBindJSON(&body); err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+				return
+			}
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.SuspendUserReq{
+			UserID:  targetID,
+			AdminID: userID.(string),
+			Note:    body.Note,
+		}
+
+		resp, err := suspendUser.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "unauthorized"):
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "user not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// UnsuspendUser godoc
+// @Summary      Lift user suspension
+// @Description  Give a suspended user their access back (requires admin)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id path     string         true  "User ID"
+// @Param        body    body     SuspendUserReq false "Audit note"
+// @Success      200     {object} services.AdminUserItem
+// @Failure      400     {object} ErrorResp
+// @Failure      401     {object} ErrorResp
+// @Failure      403     {object} ErrorResp
+// @Failure      404     {object} ErrorResp
+// @Failure      500     {object} ErrorResp
+// @Router       /api/v1/admin/users/{user_id}/suspension [delete]
+func UnsuspendUser(unsuspendUser *services.UnsuspendUser) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		targetID := c.Param("user_id")
+		if targetID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "user_id is required"})
+			return
+		}
+
+		var body SuspendUserReq
+		if c.Request.ContentLength > 0 {
+			if err := c.ShouldBindJSON(&body); err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+				return
+			}
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		req := &services.UnsuspendUserReq{
+			UserID:  targetID,
+			AdminID: userID.(string),
+			Note:    body.Note,
+		}
+
+		resp, err := unsuspendUser.Exec(c, req)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "user not found") {
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
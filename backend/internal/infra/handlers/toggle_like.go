@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
@@ -10,13 +11,14 @@ import (
 
 // ToggleLike godoc
 // @Summary      Toggle like on post
-// @Description  Like or unlike a post (requires authentication)
+// @Description  Like or unlike a post, same as toggling its like reaction (requires authentication)
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        slug path     string true "Post slug"
 // @Success      200  {object} services.ToggleLikeResp
 // @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
 // @Failure      404  {object} ErrorResp
 // @Failure      500  {object} ErrorResp
 // @Router       /api/v1/posts/{slug}/likes [post]
@@ -41,10 +43,14 @@ func ToggleLike(toggleLike *services.ToggleLike) gin.HandlerFunc {
 
 		resp, err := toggleLike.Exec(c, req)
 		if err != nil {
+			if strings.HasPrefix(err.Error(), "unauthorized") {
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
 			return
 		}
 
 		c.JSON(http.StatusOK, resp)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// TogglePostReaction godoc
+// @Summary      Toggle reaction on post
+// @Description  Add or remove one of the configured reactions on a post (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        slug  path     string true "Post slug"
+// @Param        emoji path     string true "Reaction name, e.g. like"
+// @Success      200   {object} services.ToggleReactionResp
+// @Failure      400   {object} ErrorResp
+// @Failure      401   {object} ErrorResp
+// @Failure      403   {object} ErrorResp
+// @Failure      404   {object} ErrorResp
+// @Failure      500   {object} ErrorResp
+// @Router       /api/v1/posts/{slug}/reactions/{emoji} [post]
+func TogglePostReaction(toggleReaction *services.ToggleReaction) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		execToggleReaction(c, toggleReaction, nil)
+	}
+}
+
+// ToggleCommentReaction godoc
+// @Summary      Toggle reaction on comment
+// @Description  Add or remove one of the configured reactions on a comment (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        slug  path     string true "Post slug"
+// @Param        id    path     string true "Comment ID"
+// @Param        emoji path     string true "Reaction name, e.g. like"
+// @Success      200   {object} services.ToggleReactionResp
+// @Failure      400   {object} ErrorResp
+// @Failure      401   {object} ErrorResp
+// @Failure      403   {object} ErrorResp
+// @Failure      404   {object} ErrorResp
+// @Failure      500   {object} ErrorResp
+// @Router       /api/v1/posts/{slug}/comments/{id}/reactions/{emoji} [post]
+func ToggleCommentReaction(toggleReaction *services.ToggleReaction) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		commentID := c.Param("id")
+		if commentID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id is required"})
+			return
+		}
+
+		execToggleReaction(c, toggleReaction, &commentID)
+	}
+}
+
+func execToggleReaction(c *gin.Context, toggleReaction *services.ToggleReaction, commentID *string) {
+	slug := c.Param("slug")
+	emoji := c.Param("emoji")
+	if slug == "" || emoji == "" {
+		c.JSON(http.StatusBadRequest, ErrorResp{Error: "slug and emoji are required"})
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+		return
+	}
+
+	req := &services.ToggleReactionReq{
+		Slug:      slug,
+		CommentID: commentID,
+		Emoji:     emoji,
+		UserID:    userID.(string),
+	}
+
+	resp, err := toggleReaction.Exec(c, req)
+	if err != nil {
+		switch {
+		case strings.HasPrefix(err.Error(), "unknown reaction"):
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+		case strings.HasPrefix(err.Error(), "unauthorized"):
+			c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+		case strings.HasPrefix(err.Error(), "post not found"), strings.HasPrefix(err.Error(), "comment not found"):
+			c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// UpdateBookmark godoc
+// @Summary      Update bookmark
+// @Description  Move one of my bookmarks to a collection (an empty collection_id takes it out), set its private note or mark it read or unread. Fields left out are unchanged (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path     string                     true "Bookmark ID"
+// @Param        body body     services.UpdateBookmarkReq true "Changes"
+// @Success      200  {object} services.UpdateBookmarkResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/me/bookmarks/{id} [put]
+func UpdateBookmark(updateBookmark *services.UpdateBookmark) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		var req services.UpdateBookmarkReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+		req.ID = c.Param("id")
+		req.UserID = userID.(string)
+
+		resp, err := updateBookmark.Exec(c, &req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "bookmark not found"), strings.HasPrefix(err.Error(), "collection not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "failed to update bookmark"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
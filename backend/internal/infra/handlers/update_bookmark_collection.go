@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// UpdateBookmarkCollection godoc
+// @Summary      Update bookmark collection
+// @Description  Rename or describe one of my collections, or make it public or private (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path     string                               true "Collection ID"
+// @Param        body body     services.UpdateBookmarkCollectionReq true "Collection"
+// @Success      200  {object} services.BookmarkCollectionInfo
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      409  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/me/collections/{id} [put]
+func UpdateBookmarkCollection(updateBookmarkCollection *services.UpdateBookmarkCollection) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		var req services.UpdateBookmarkCollectionReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+		req.ID = c.Param("id")
+		req.UserID = userID.(string)
+
+		resp, err := updateBookmarkCollection.Exec(c, &req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "collection not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "failed to update collection"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "collection name already taken"):
+				c.JSON(http.StatusConflict, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+type UpdateCommentReq struct {
+	Body string `json:"body" binding:"required"`
+}
+
+// UpdateComment godoc
+// @Summary      Edit comment
+// @Description  Edit the body of one of my comments, keeping the previous body in its history (requires authentication)
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        slug path     string           true "Post slug"
+// @Param        id   path     string           true "Comment ID"
+// @Param        body body     UpdateCommentReq true "Comment data"
+// @Success      200  {object} services.UpdateCommentResp
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      403  {object} ErrorResp
+// @Failure      404  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/posts/{slug}/comments/{id} [put]
+func UpdateComment(updateComment *services.UpdateComment) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		slug := c.Param("slug")
+		commentID := c.Param("id")
+		if slug == "" || commentID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "slug and id are required"})
+			return
+		}
+
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		var body UpdateCommentReq
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		req := &services.UpdateCommentReq{
+			Slug:      slug,
+			CommentID: commentID,
+			Body:      body.Body,
+			UserID:    userID.(string),
+		}
+
+		resp, err := updateComment.Exec(c, req)
+		if err != nil {
+			switch {
+			case err.Error() == "unauthorized: you can only edit your own comments":
+				c.JSON(http.StatusForbidden, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "post not found"), strings.HasPrefix(err.Error(), "comment not found"):
+				c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "failed to update comment"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/services"
+)
+
+// UpdateProfile godoc
+// @Summary      Update my profile
+// @Description  Replace the public profile of the current user: display name, Markdown bio, website, location, social links and handle. Leaving the handle out keeps the current one
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body body     services.UpdateProfileReq true "Profile"
+// @Success      200  {object} services.UserProfile
+// @Failure      400  {object} ErrorResp
+// @Failure      401  {object} ErrorResp
+// @Failure      409  {object} ErrorResp
+// @Failure      500  {object} ErrorResp
+// @Router       /api/v1/me/profile [put]
+func UpdateProfile(updateProfile *services.UpdateProfile) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		var req services.UpdateProfileReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+		req.UserID = userID.(string)
+
+		resp, err := updateProfile.Exec(c, &req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "failed to update profile"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			case strings.HasPrefix(err.Error(), "handle already taken"):
+				c.JSON(http.StatusConflict, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
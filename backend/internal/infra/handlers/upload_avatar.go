@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/services"
+)
+
+// UploadAvatar godoc
+// @Summary      Upload my avatar
+// @Description  Replace the avatar of the current user with a PNG, JPEG, GIF or WebP image of up to 1 MB
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        avatar formData file true "Avatar image"
+// @Success      200    {object} services.UserProfile
+// @Failure      400    {object} ErrorResp
+// @Failure      401    {object} ErrorResp
+// @Failure      500    {object} ErrorResp
+// @Router       /api/v1/me/profile/avatar [put]
+func UploadAvatar(uploadAvatar *services.UploadAvatar) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not authenticated"})
+			return
+		}
+
+		file, err := c.FormFile("avatar")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "avatar file is required"})
+			return
+		}
+
+		if file.Size > domain.MaxAvatarSize {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "avatar is too large"})
+			return
+		}
+
+		f, err := file.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+		defer f.Close()
+
+		data, err := io.ReadAll(io.LimitReader(f, domain.MaxAvatarSize+1))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		req := &services.UploadAvatarReq{
+			UserID: userID.(string),
+			Data:   data,
+		}
+
+		resp, err := uploadAvatar.Exec(c, req)
+		if err != nil {
+			switch {
+			case strings.HasPrefix(err.Error(), "failed to upload avatar"):
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -2,40 +2,178 @@ package middlewares
 
 import (
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
 )
 
-func HasAuthorization(jwtSecret string) gin.HandlerFunc {
+// lastSeenResolution is how stale the last seen time of a session may get
+// before a request refreshes it.
+const lastSeenResolution = time.Minute
+
+// tokenClaims is who a request acts for. SessionID is set for access tokens,
+// TokenID and Scopes for personal access tokens.
+type tokenClaims struct {
+	UserID    string
+	Role      string
+	SessionID string
+	TokenID   string
+	Scopes    []string
+}
+
+// Authenticator checks the access tokens and personal access tokens of
+// requests.
+type Authenticator struct {
+	jwtSecret  string
+	sessionDAO dao.SessionDAO
+	tokenDAO   dao.PersonalAccessTokenDAO
+	userDAO    dao.UserDAO
+}
+
+func NewAuthenticator(jwtSecret string, sessionDAO dao.SessionDAO, tokenDAO dao.PersonalAccessTokenDAO, userDAO dao.UserDAO) *Authenticator {
+	return &Authenticator{
+		jwtSecret:  jwtSecret,
+		sessionDAO: sessionDAO,
+		tokenDAO:   tokenDAO,
+		userDAO:    userDAO,
+	}
+}
+
+func HasAuthorization(auth *Authenticator) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		tokenString := c.GetHeader("Authorization")
+		tokenString := bearerToken(c)
 		if tokenString == "" {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Token required"})
 			return
 		}
 
-		tk, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			return []byte(jwtSecret), nil
-		})
-		if err != nil {
+		claims, ok := auth.authenticate(c, tokenString)
+		if !ok {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
 			return
 		}
 
-		if !tk.Valid {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
-			return
+		setClaims(c, claims)
+
+		c.Next()
+	}
+}
+
+// MayHaveAuthorization sets the user_id of valid tokens and lets anonymous
+// requests through, for public endpoints that show per-user details.
+// Personal access tokens without the read scope count as anonymous.
+func MayHaveAuthorization(auth *Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if tokenString := bearerToken(c); tokenString != "" {
+			if claims, ok := auth.authenticate(c, tokenString); ok && (claims.TokenID == "" || hasScope(claims.Scopes, domain.ScopeRead)) {
+				setClaims(c, claims)
+			}
 		}
 
-		userID, ok := tk.Claims.(jwt.MapClaims)["user_id"].(string)
-		if !ok {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		c.Next()
+	}
+}
+
+func setClaims(c *gin.Context, claims *tokenClaims) {
+	c.Set("user_id", claims.UserID)
+	c.Set("role", claims.Role)
+	if claims.TokenID != "" {
+		c.Set("token_id", claims.TokenID)
+		c.Set("token_scopes", claims.Scopes)
+	} else {
+		c.Set("session_id", claims.SessionID)
+	}
+}
+
+// bearerToken returns the token of the Authorization header, which may or
+// may not carry the Bearer scheme.
+func bearerToken(c *gin.Context) string {
+	return strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+}
+
+func (a *Authenticator) authenticate(c *gin.Context, tokenString string) (*tokenClaims, bool) {
+	if strings.HasPrefix(tokenString, domain.PersonalAccessTokenPrefix) {
+		return a.personalAccessTokenClaims(c, tokenString)
+	}
+
+	claims, ok := claimsFromToken(tokenString, a.jwtSecret)
+	if !ok || !isSessionActive(c, a.sessionDAO, claims) {
+		return nil, false
+	}
+	return claims, true
+}
+
+// RequireRole lets through the users whose role grants the permissions of
+// the given one. It must run after HasAuthorization.
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !domain.RoleAtLeast(c.GetString("role"), role) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Insufficient role"})
 			return
 		}
 
-		c.Set("user_id", userID)
-
 		c.Next()
 	}
 }
+
+// claimsFromToken returns the claims of a valid access token. Tokens without
+// a session, issued before sessions existed, are refused.
+func claimsFromToken(tokenString string, jwtSecret string) (*tokenClaims, bool) {
+	tk, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return []byte(jwtSecret), nil
+	})
+	if err != nil || !tk.Valid {
+		return nil, false
+	}
+
+	mapClaims := tk.Claims.(jwt.MapClaims)
+
+	// Stream tickets and OAuth state or link tokens share the secret, but
+	// aren't access tokens
+	if _, typed := mapClaims["typ"]; typed {
+		return nil, false
+	}
+
+	userID, ok := mapClaims["user_id"].(string)
+	if !ok {
+		return nil, false
+	}
+
+	sessionID, ok := mapClaims["sid"].(string)
+	if !ok {
+		return nil, false
+	}
+
+	role, ok := mapClaims["role"].(string)
+	if !ok {
+		role = domain.DefaultRole
+	}
+
+	return &tokenClaims{
+		UserID:    userID,
+		Role:      role,
+		SessionID: sessionID,
+	}, true
+}
+
+// isSessionActive checks that the session of the token was not revoked and
+// keeps its last seen time current.
+func isSessionActive(c *gin.Context, sessionDAO dao.SessionDAO, claims *tokenClaims) bool {
+	session, err := sessionDAO.FindByPk(c, claims.SessionID)
+	if err != nil || session.UserID != claims.UserID || !session.IsActive(time.Now()) {
+		return false
+	}
+
+	if time.Since(session.LastSeenAt) > lastSeenResolution {
+		_ = sessionDAO.PartialUpdate(c, session.ID, map[string]interface{}{
+			"last_seen_at": time.Now(),
+		})
+	}
+
+	return true
+}
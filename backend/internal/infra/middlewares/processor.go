@@ -1,27 +1,57 @@
 package middlewares
 
 import (
+	"crypto/subtle"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"golang.org/x/crypto/bcrypt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
 )
 
-func HasProcessorAuthorization(processorSecret string, processorUserID string) gin.HandlerFunc {
+// HasProcessorAuthorization authenticates processor clients with HTTP Basic
+// credentials, their client ID and secret, and sets processor_client. A bare
+// PROCESSOR_SECRET is still accepted as the legacy client acting as
+// PROCESSOR_USER_ID.
+func HasProcessorAuthorization(clientDAO dao.ProcessorClientDAO, processorSecret string, processorUserID string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		processorPwd := c.GetHeader("Authorization")
-		if processorPwd == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization required"})
-			return
-		}
+		var client *domain.ProcessorClient
+
+		if clientID, secret, ok := c.Request.BasicAuth(); ok {
+			found, err := clientDAO.FindOne(c, "id::text = $1", "", clientID)
+			if err != nil || !found.IsActive() ||
+				subtle.ConstantTimeCompare([]byte(found.SecretHash), []byte(domain.HashToken(secret))) != 1 {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization required"})
+				return
+			}
+
+			if found.LastUsedAt == nil || time.Since(*found.LastUsedAt) > lastSeenResolution {
+				_ = clientDAO.PartialUpdate(c, found.ID, map[string]interface{}{
+					"last_used_at": time.Now(),
+				})
+			}
+
+			client = found
+		} else {
+			processorPwd := c.GetHeader("Authorization")
+			if processorPwd == "" || processorSecret == "" {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization required"})
+				return
+			}
+
+			err := bcrypt.CompareHashAndPassword([]byte(processorSecret), []byte(processorPwd))
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization required"})
+				return
+			}
 
-		err := bcrypt.CompareHashAndPassword([]byte(processorSecret), []byte(processorPwd))
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization required"})
-			return
+			client = domain.NewLegacyProcessorClient(processorUserID)
 		}
 
-		c.Set("user_id", processorUserID)
+		c.Set("processor_client", client)
 		c.Next()
 	}
 }
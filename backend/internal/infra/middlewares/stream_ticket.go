@@ -0,0 +1,72 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+
+	"blog0/internal/domain"
+)
+
+// HasStreamAuthorization lets through the requests authenticated by
+// MayHaveAuthorization, and the ones with a valid stream ticket in the
+// ticket query parameter, for EventSource streams.
+func HasStreamAuthorization(auth *Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("user_id") != "" {
+			c.Next()
+			return
+		}
+
+		ticket := c.Query("ticket")
+		if ticket == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Token required"})
+			return
+		}
+
+		claims, ok := auth.streamTicketClaims(c, ticket)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid ticket"})
+			return
+		}
+
+		setClaims(c, claims)
+
+		c.Next()
+	}
+}
+
+func (a *Authenticator) streamTicketClaims(c *gin.Context, ticket string) (*tokenClaims, bool) {
+	tk, err := jwt.Parse(ticket, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(a.jwtSecret), nil
+	})
+	if err != nil || !tk.Valid {
+		return nil, false
+	}
+
+	mapClaims := tk.Claims.(jwt.MapClaims)
+	userID, _ := mapClaims["uid"].(string)
+	sessionID, _ := mapClaims["sid"].(string)
+	if mapClaims["typ"] != domain.StreamTicketType || userID == "" || sessionID == "" {
+		return nil, false
+	}
+
+	user, err := a.userDAO.FindByPk(c, userID)
+	if err != nil {
+		return nil, false
+	}
+
+	claims := &tokenClaims{
+		UserID:    userID,
+		Role:      user.Role,
+		SessionID: sessionID,
+	}
+	if !isSessionActive(c, a.sessionDAO, claims) {
+		return nil, false
+	}
+	return claims, true
+}
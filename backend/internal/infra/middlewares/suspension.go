@@ -0,0 +1,34 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain/dao"
+)
+
+// RejectSuspendedWrites keeps suspended users read-only: they can still load
+// their own data but every other method is refused. It must run after
+// HasAuthorization.
+func RejectSuspendedWrites(userDAO dao.UserDAO) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodGet {
+			c.Next()
+			return
+		}
+
+		user, err := userDAO.FindByPk(c, c.GetString("user_id"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+			return
+		}
+
+		if user.IsSuspended() {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Account suspended"})
+			return
+		}
+
+		c.Next()
+	}
+}
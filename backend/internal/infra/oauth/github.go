@@ -0,0 +1,51 @@
+package oauth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"blog0/internal/domain"
+)
+
+// NewGitHubInfoExtractor reads users from the GitHub API at apiBaseURL,
+// https://api.github.com unless it is a GitHub Enterprise server. The email
+// is the primary verified one, which needs the user:email scope.
+func NewGitHubInfoExtractor(apiBaseURL string) domain.InfoExtractor {
+	apiBaseURL = strings.TrimSuffix(apiBaseURL, "/")
+
+	return func(ctx context.Context, token string) (*domain.OAuthUserInfo, error) {
+		type GitHubUser struct {
+			ID    int64  `json:"id"`
+			Login string `json:"login"`
+		}
+
+		type GitHubEmail struct {
+			Email    string `json:"email"`
+			Primary  bool   `json:"primary"`
+			Verified bool   `json:"verified"`
+		}
+
+		var user GitHubUser
+		if err := fetchJSON(ctx, apiBaseURL+"/user", token, &user); err != nil {
+			return nil, err
+		}
+
+		var emails []GitHubEmail
+		if err := fetchJSON(ctx, apiBaseURL+"/user/emails", token, &emails); err != nil {
+			return nil, err
+		}
+
+		info := &domain.OAuthUserInfo{
+			Subject:  strconv.FormatInt(user.ID, 10),
+			Username: user.Login,
+		}
+		for _, email := range emails {
+			if email.Primary && email.Verified {
+				info.Email = email.Email
+			}
+		}
+
+		return info, nil
+	}
+}
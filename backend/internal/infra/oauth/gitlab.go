@@ -0,0 +1,52 @@
+package oauth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"golang.org/x/oauth2"
+
+	"blog0/internal/domain"
+)
+
+// GitLabEndpoint is the OAuth2 endpoint of the GitLab instance at baseURL,
+// https://gitlab.com or a self-managed one.
+func GitLabEndpoint(baseURL string) oauth2.Endpoint {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	return oauth2.Endpoint{
+		AuthURL:  baseURL + "/oauth/authorize",
+		TokenURL: baseURL + "/oauth/token",
+	}
+}
+
+// NewGitLabInfoExtractor reads users from the API of the GitLab instance at
+// baseURL, which needs the read_user scope.
+func NewGitLabInfoExtractor(baseURL string) domain.InfoExtractor {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	return func(ctx context.Context, token string) (*domain.OAuthUserInfo, error) {
+		type GitLabUser struct {
+			ID          int64   `json:"id"`
+			Username    string  `json:"username"`
+			Email       string  `json:"email"`
+			ConfirmedAt *string `json:"confirmed_at"`
+		}
+
+		var user GitLabUser
+		if err := fetchJSON(ctx, baseURL+"/api/v4/user", token, &user); err != nil {
+			return nil, err
+		}
+
+		info := &domain.OAuthUserInfo{
+			Subject:  strconv.FormatInt(user.ID, 10),
+			Username: user.Username,
+		}
+		if user.ConfirmedAt != nil {
+			info.Email = user.Email
+		}
+
+		return info, nil
+	}
+}
@@ -0,0 +1,28 @@
+package oauth
+
+import (
+	"context"
+
+	"blog0/internal/domain"
+)
+
+func GoogleInfoExtractor(ctx context.Context, token string) (*domain.OAuthUserInfo, error) {
+	type GoogleUser struct {
+		ID            string `json:"id"`
+		Email         string `json:"email"`
+		VerifiedEmail bool   `json:"verified_email"`
+		Name          string `json:"name"`
+	}
+
+	var user GoogleUser
+	if err := fetchJSON(ctx, "https://www.googleapis.com/oauth2/v2/userinfo", token, &user); err != nil {
+		return nil, err
+	}
+
+	info := &domain.OAuthUserInfo{Subject: user.ID, Username: user.Name}
+	if user.VerifiedEmail {
+		info.Email = user.Email
+	}
+
+	return info, nil
+}
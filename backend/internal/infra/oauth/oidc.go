@@ -0,0 +1,102 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+
+	"blog0/internal/domain"
+)
+
+// OIDCDiscovery is the part of an OpenID provider configuration needed to
+// sign users in.
+type OIDCDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// DiscoverOIDC loads the configuration the OpenID provider publishes under
+// issuer/.well-known/openid-configuration.
+func DiscoverOIDC(ctx context.Context, issuer string) (*OIDCDiscovery, error) {
+	issuer = strings.TrimSuffix(issuer, "/")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error discovering %s, status: %s", issuer, resp.Status)
+	}
+
+	var discovery OIDCDiscovery
+	if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("issuer mismatch: expected %s, got %s", issuer, discovery.Issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.UserInfoEndpoint == "" {
+		return nil, fmt.Errorf("incomplete configuration for %s", issuer)
+	}
+
+	return &discovery, nil
+}
+
+func (d *OIDCDiscovery) Endpoint() oauth2.Endpoint {
+	return oauth2.Endpoint{
+		AuthURL:  d.AuthorizationEndpoint,
+		TokenURL: d.TokenEndpoint,
+	}
+}
+
+// InfoExtractor reads users from the userinfo endpoint, which needs the
+// openid, email and profile scopes. Emails are only kept when the provider
+// asserts they are verified, as accounts are linked and admins recognized
+// by email.
+func (d *OIDCDiscovery) InfoExtractor() domain.InfoExtractor {
+	return func(ctx context.Context, token string) (*domain.OAuthUserInfo, error) {
+		type OIDCUser struct {
+			Subject           string `json:"sub"`
+			Email             string `json:"email"`
+			EmailVerified     *bool  `json:"email_verified"`
+			PreferredUsername string `json:"preferred_username"`
+			Name              string `json:"name"`
+		}
+
+		var user OIDCUser
+		if err := fetchJSON(ctx, d.UserInfoEndpoint, token, &user); err != nil {
+			return nil, err
+		}
+
+		if user.Subject == "" {
+			return nil, fmt.Errorf("userinfo without subject")
+		}
+
+		info := &domain.OAuthUserInfo{
+			Subject:  user.Subject,
+			Username: user.PreferredUsername,
+		}
+		if info.Username == "" {
+			info.Username = user.Name
+		}
+		if user.EmailVerified != nil && *user.EmailVerified {
+			info.Email = user.Email
+		}
+
+		return info, nil
+	}
+}
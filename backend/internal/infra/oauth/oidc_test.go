@@ -0,0 +1,203 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+// newFakeOIDCServer serves the discovery document, the token endpoint and
+// the userinfo endpoint of a provider that knows a single code and user. The
+// code is only redeemed with the PKCE verifier of the challenge /authorize
+// was called with.
+func newFakeOIDCServer(t *testing.T, userInfo map[string]any) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"userinfo_endpoint":      server.URL + "/userinfo",
+		})
+	})
+
+	var challenge string
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		challenge = r.URL.Query().Get("code_challenge")
+		redirect := r.URL.Query().Get("redirect_uri") + "?code=good-code&state=" + url.QueryEscape(r.URL.Query().Get("state"))
+		http.Redirect(w, r, redirect, http.StatusFound)
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("code") != "good-code" ||
+			oauth2.S256ChallengeFromVerifier(r.PostForm.Get("code_verifier")) != challenge {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, map[string]any{
+			"access_token": "access-123",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	})
+
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-123" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, userInfo)
+	})
+
+	return server
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestOIDCSignInFlow(t *testing.T) {
+	// Arrange
+	server := newFakeOIDCServer(t, map[string]any{
+		"sub":                "user-42",
+		"email":              "ada@example.com",
+		"email_verified":     true,
+		"preferred_username": "ada",
+	})
+	ctx := context.Background()
+
+	// Act
+	discovery, err := DiscoverOIDC(ctx, server.URL+"/")
+	if err != nil {
+		t.Fatalf("DiscoverOIDC failed: %v", err)
+	}
+
+	cfg := &oauth2.Config{
+		ClientID:     "blog0",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost/api/v1/auth/oidc/callback",
+		Scopes:       []string{"openid", "email", "profile"},
+		Endpoint:     discovery.Endpoint(),
+	}
+
+	verifier := oauth2.GenerateVerifier()
+	authURL, err := url.Parse(cfg.AuthCodeURL("state-1", oauth2.S256ChallengeOption(verifier)))
+	if err != nil {
+		t.Fatalf("invalid auth code url: %v", err)
+	}
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	authResp, err := client.Get(authURL.String())
+	if err != nil {
+		t.Fatalf("authorize failed: %v", err)
+	}
+	authResp.Body.Close()
+	callbackURL, err := authResp.Location()
+	if err != nil {
+		t.Fatalf("authorize did not redirect: %v", err)
+	}
+
+	_, err = cfg.Exchange(ctx, callbackURL.Query().Get("code"), oauth2.VerifierOption("wrong-verifier"))
+	if err == nil {
+		t.Fatal("expected the exchange to fail without the PKCE verifier")
+	}
+
+	token, err := cfg.Exchange(ctx, callbackURL.Query().Get("code"), oauth2.VerifierOption(verifier))
+	if err != nil {
+		t.Fatalf("Exchange failed: %v", err)
+	}
+
+	info, err := discovery.InfoExtractor()(ctx, token.AccessToken)
+	if err != nil {
+		t.Fatalf("InfoExtractor failed: %v", err)
+	}
+
+	// Assert
+	if authURL.Host+authURL.Path != server.Listener.Addr().String()+"/authorize" {
+		t.Fatalf("expected a redirect to the fake authorize endpoint, got %s", authURL)
+	}
+	if callbackURL.Path != "/api/v1/auth/oidc/callback" || callbackURL.Query().Get("state") != "state-1" {
+		t.Fatalf("expected a callback with the state, got %s", callbackURL)
+	}
+	if info.Subject != "user-42" || info.Email != "ada@example.com" || info.Username != "ada" {
+		t.Fatalf("unexpected user info: %+v", info)
+	}
+}
+
+func TestOIDCDropsUnverifiedEmail(t *testing.T) {
+	// Arrange
+	server := newFakeOIDCServer(t, map[string]any{
+		"sub":            "user-42",
+		"email":          "ada@example.com",
+		"email_verified": false,
+		"name":           "Ada",
+	})
+	discovery, err := DiscoverOIDC(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("DiscoverOIDC failed: %v", err)
+	}
+
+	// Act
+	info, err := discovery.InfoExtractor()(context.Background(), "access-123")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("InfoExtractor failed: %v", err)
+	}
+	if info.Email != "" {
+		t.Fatalf("expected the unverified email to be dropped, got %q", info.Email)
+	}
+	if info.Username != "Ada" {
+		t.Fatalf("expected the name as username, got %q", info.Username)
+	}
+}
+
+func TestOIDCDropsEmailWithoutVerification(t *testing.T) {
+	// Arrange
+	server := newFakeOIDCServer(t, map[string]any{
+		"sub":   "user-42",
+		"email": "ada@example.com",
+		"name":  "Ada",
+	})
+	discovery, err := DiscoverOIDC(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("DiscoverOIDC failed: %v", err)
+	}
+
+	// Act
+	info, err := discovery.InfoExtractor()(context.Background(), "access-123")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("InfoExtractor failed: %v", err)
+	}
+	if info.Email != "" {
+		t.Fatalf("expected the email without email_verified to be dropped, got %q", info.Email)
+	}
+}
+
+func TestDiscoverOIDCFailsForUnknownIssuer(t *testing.T) {
+	// Arrange
+	server := newFakeOIDCServer(t, nil)
+
+	// Act
+	_, err := DiscoverOIDC(context.Background(), server.URL+"/tenant")
+
+	// Assert
+	if err == nil {
+		t.Fatal("expected discovery to fail")
+	}
+}
@@ -0,0 +1,30 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// fetchJSON calls a provider API on behalf of the user and decodes the
+// answer into out.
+func fetchJSON(ctx context.Context, url string, token string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error getting user info, status: %s", resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
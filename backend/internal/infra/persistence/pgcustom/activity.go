@@ -0,0 +1,146 @@
+package pgcustom
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+)
+
+type (
+	PostCard       = customdao.PostCard
+	BookmarkedPost = customdao.BookmarkedPost
+	LikedPost      = customdao.LikedPost
+	FollowedUser   = customdao.FollowedUser
+)
+
+// ActivityDAO is written by hand, gormless doesn't generate joins.
+type ActivityDAO struct {
+	conn
+}
+
+func NewActivityDAO(db *sql.DB) *ActivityDAO {
+	return &ActivityDAO{conn{db: db}}
+}
+
+// postLikesCount counts the likes of post p.
+var postLikesCount = `(SELECT COUNT(*) FROM reactions r WHERE r.post_id = p.id AND r.emoji = '` + domain.ReactionLike + `')`
+
+// postCardColumns reads a PostCard from posts p joined with their
+// author u.
+var postCardColumns = `
+	p.id, p.title, p.slug, p.summary, p.tags, p.published_at, p.summary_audio_url,
+	u.id, u.handle, COALESCE(NULLIF(u.display_name, ''), u.username), u.avatar_url,
+	` + postLikesCount + `,
+	(SELECT COUNT(*) FROM comments c WHERE c.post_id = p.id AND c.status = '` + domain.CommentStatusApproved + `')`
+
+func postCardDest(m *PostCard) []interface{} {
+	return []interface{}{
+		&m.ID, &m.Title, &m.Slug, &m.Summary, &m.Tags, &m.PublishedAt, &m.SummaryAudioURL,
+		&m.AuthorID, &m.AuthorHandle, &m.AuthorName, &m.AuthorAvatarURL,
+		&m.LikesCount, &m.CommentsCount,
+	}
+}
+
+// page selects the page of rows of table matching where, most recent first,
+// for joining.
+func page(table string, limit, offset int, where string, args []interface{}) (string, []interface{}) {
+	query := "SELECT * FROM " + table
+	if where != "" {
+		query += " WHERE " + where
+	}
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	return "(" + query + ")", append(args, limit, offset)
+}
+
+func (dao *ActivityDAO) FindBookmarkedPosts(ctx context.Context, limit, offset int, where string, args ...interface{}) ([]*BookmarkedPost, error) {
+	bookmarks, args := page("bookmarks", limit, offset, where, args)
+	query := `
+		SELECT b.id, b.user_id, b.post_id, b.created_at, b.collection_id, b.note, b.read_at,` + postCardColumns + `
+		FROM ` + bookmarks + ` b
+		JOIN posts p ON p.id = b.post_id
+		JOIN users u ON u.id = p.author_id
+		ORDER BY b.created_at DESC, b.id DESC
+	`
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*BookmarkedPost
+	for rows.Next() {
+		var m BookmarkedPost
+		dest := []interface{}{
+			&m.Bookmark.ID, &m.Bookmark.UserID, &m.Bookmark.PostID, &m.Bookmark.CreatedAt,
+			&m.Bookmark.CollectionID, &m.Bookmark.Note, &m.Bookmark.ReadAt,
+		}
+		if err := rows.Scan(append(dest, postCardDest(&m.Post)...)...); err != nil {
+			return nil, err
+		}
+		results = append(results, &m)
+	}
+
+	return results, rows.Err()
+}
+
+func (dao *ActivityDAO) FindLikedPosts(ctx context.Context, limit, offset int, where string, args ...interface{}) ([]*LikedPost, error) {
+	reactions, args := page("reactions", limit, offset, where, args)
+	query := `
+		SELECT r.created_at,` + postCardColumns + `
+		FROM ` + reactions + ` r
+		JOIN posts p ON p.id = r.post_id
+		JOIN users u ON u.id = p.author_id
+		ORDER BY r.created_at DESC, r.id DESC
+	`
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*LikedPost
+	for rows.Next() {
+		var m LikedPost
+		if err := rows.Scan(append([]interface{}{&m.LikedAt}, postCardDest(&m.Post)...)...); err != nil {
+			return nil, err
+		}
+		results = append(results, &m)
+	}
+
+	return results, rows.Err()
+}
+
+func (dao *ActivityDAO) FindFollowedUsers(ctx context.Context, limit, offset int, where string, args ...interface{}) ([]*FollowedUser, error) {
+	follows, args := page("follows", limit, offset, where, args)
+	query := `
+		SELECT f.created_at, u.id, u.handle, COALESCE(NULLIF(u.display_name, ''), u.username), u.avatar_url, u.bio,
+			(SELECT COUNT(*) FROM posts p WHERE p.author_id = u.id AND p.published_at IS NOT NULL AND p.hidden_at IS NULL),
+			(SELECT COUNT(*) FROM follows ff WHERE ff.followee_id = u.id)
+		FROM ` + follows + ` f
+		JOIN users u ON u.id = f.followee_id
+		ORDER BY f.created_at DESC, f.id DESC
+	`
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*FollowedUser
+	for rows.Next() {
+		var m FollowedUser
+		err := rows.Scan(&m.FollowedAt, &m.ID, &m.Handle, &m.Name, &m.AvatarURL, &m.Bio, &m.PostsCount, &m.FollowersCount)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &m)
+	}
+
+	return results, rows.Err()
+}
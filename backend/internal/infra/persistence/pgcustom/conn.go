@@ -0,0 +1,56 @@
+// Package pgcustom holds the hand-written Postgres DAOs, for what gormless
+// doesn't generate. `make gen` wipes the generated packages, not this one.
+package pgcustom
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// conn runs queries in the transaction of the context when there is one,
+// like the generated DAOs do.
+type conn struct {
+	db *sql.DB
+}
+
+func (c conn) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return c.db.ExecContext(ctx, query, args...)
+}
+
+func (c conn) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return c.db.QueryContext(ctx, query, args...)
+}
+
+func (c conn) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return c.db.QueryRowContext(ctx, query, args...)
+}
+
+// WithTransaction executes a function within a database transaction
+func (c conn) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
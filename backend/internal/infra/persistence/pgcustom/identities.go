@@ -0,0 +1,38 @@
+package pgcustom
+
+import (
+	"context"
+	"database/sql"
+)
+
+// IdentityDAO is written by hand, gormless doesn't generate row locks.
+type IdentityDAO struct {
+	conn
+}
+
+func NewIdentityDAO(db *sql.DB) *IdentityDAO {
+	return &IdentityDAO{conn{db: db}}
+}
+
+func (dao *IdentityDAO) LockLinked(ctx context.Context, userID string) (int, error) {
+	// A concurrent unlink waits here, then only sees the rows still there
+	query := `
+		SELECT id
+		FROM identities
+		WHERE user_id = $1
+		FOR UPDATE
+	`
+
+	rows, err := dao.queryContext(ctx, query, userID)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	linked := 0
+	for rows.Next() {
+		linked++
+	}
+
+	return linked, rows.Err()
+}
@@ -0,0 +1,45 @@
+package pgcustom
+
+import (
+	"context"
+	"database/sql"
+
+	"blog0/internal/domain"
+)
+
+// NotificationGroupDAO is written by hand, gormless doesn't generate
+// conflict handling.
+type NotificationGroupDAO struct {
+	conn
+}
+
+func NewNotificationGroupDAO(db *sql.DB) *NotificationGroupDAO {
+	return &NotificationGroupDAO{conn{db: db}}
+}
+
+func (dao *NotificationGroupDAO) MergeUnread(ctx context.Context, m *domain.Notification) (*domain.Notification, error) {
+	// The new notification has a single actor, an actor already in the group
+	// moves last so the list stays ordered by recency. New comments point the
+	// group to the latest one.
+	query := `
+		INSERT INTO notifications (id, recipient_id, type, post_id, comment_id, report_id, group_key, actor_ids, read_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (recipient_id, group_key) WHERE read_at IS NULL DO UPDATE SET
+			actor_ids = (notifications.actor_ids - (EXCLUDED.actor_ids->>0)) || EXCLUDED.actor_ids,
+			comment_id = CASE WHEN EXCLUDED.type = '` + domain.NotificationTypeComment + `'
+				THEN COALESCE(EXCLUDED.comment_id, notifications.comment_id)
+				ELSE notifications.comment_id END,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id, recipient_id, type, post_id, comment_id, report_id, group_key, actor_ids, read_at, created_at, updated_at
+	`
+
+	var n domain.Notification
+	err := dao.queryRowContext(ctx, query,
+		m.ID, m.RecipientID, m.Type, m.PostID, m.CommentID, m.ReportID, m.GroupKey, m.ActorIDs, m.ReadAt, m.CreatedAt, m.UpdatedAt,
+	).Scan(&n.ID, &n.RecipientID, &n.Type, &n.PostID, &n.CommentID, &n.ReportID, &n.GroupKey, &n.ActorIDs, &n.ReadAt, &n.CreatedAt, &n.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &n, nil
+}
@@ -0,0 +1,36 @@
+package pgcustom
+
+import (
+	"context"
+	"database/sql"
+
+	"blog0/internal/domain"
+)
+
+// OAuthCodeDAO is written by hand, gormless doesn't generate deletes that
+// return the deleted row.
+type OAuthCodeDAO struct {
+	conn
+}
+
+func NewOAuthCodeDAO(db *sql.DB) *OAuthCodeDAO {
+	return &OAuthCodeDAO{conn{db: db}}
+}
+
+func (dao *OAuthCodeDAO) Consume(ctx context.Context, codeHash string) (*domain.OAuthCode, error) {
+	query := `
+		DELETE FROM oauth_codes
+		WHERE code_hash = $1
+		RETURNING id, code_hash, user_id, provider, created_at, expires_at
+	`
+
+	var m domain.OAuthCode
+	err := dao.queryRowContext(ctx, query, codeHash).Scan(
+		&m.ID, &m.CodeHash, &m.UserID, &m.Provider, &m.CreatedAt, &m.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
@@ -0,0 +1,196 @@
+package pgcustom
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+)
+
+type RankedPost = customdao.RankedPost
+
+// RankingDAO is written by hand, gormless doesn't generate conflict
+// handling, aggregates or joins.
+type RankingDAO struct {
+	conn
+}
+
+func NewRankingDAO(db *sql.DB) *RankingDAO {
+	return &RankingDAO{conn{db: db}}
+}
+
+func (dao *RankingDAO) InsertView(ctx context.Context, postID string, visitorKey string, now time.Time) (bool, error) {
+	query := `
+		INSERT INTO post_views (post_id, visitor_key, viewed_on, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (post_id, visitor_key, viewed_on) DO NOTHING
+	`
+
+	result, err := dao.execContext(ctx, query, postID, visitorKey, now.UTC().Format(time.DateOnly), now)
+	if err != nil {
+		return false, err
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return inserted > 0, nil
+}
+
+func (dao *RankingDAO) DeleteViewsBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := dao.execContext(ctx, "DELETE FROM post_views WHERE created_at < $1", before)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (dao *RankingDAO) Refresh(ctx context.Context, window domain.RankingWindow, weights domain.RankingWeights, now time.Time) (int64, error) {
+	// Postgres keeps microseconds, the rows written now must not look older
+	// than now when pruning
+	now = now.Truncate(time.Microsecond)
+
+	// Every event within the window weighs its kind weight, halved every
+	// half-life since it happened. Authors don't rank their own posts up.
+	query := `
+		INSERT INTO post_rankings (period, post_id, score, likes_count, comments_count, bookmarks_count, views_count, refreshed_at)
+		SELECT $1, e.post_id,
+			SUM(e.weight * EXP(-LN(2) * EXTRACT(EPOCH FROM ($2::timestamptz - e.created_at)) / $4::float8)),
+			COUNT(*) FILTER (WHERE e.kind = 'like'),
+			COUNT(*) FILTER (WHERE e.kind = 'comment'),
+			COUNT(*) FILTER (WHERE e.kind = 'bookmark'),
+			COUNT(*) FILTER (WHERE e.kind = 'view'),
+			$2
+		FROM (
+			SELECT post_id, user_id, 'like' AS kind, $5::float8 AS weight, created_at
+			FROM reactions WHERE post_id IS NOT NULL AND emoji = $9 AND created_at > $3
+			UNION ALL
+			SELECT post_id, author_id, 'comment', $6::float8, created_at
+			FROM comments WHERE status = $10 AND created_at > $3
+			UNION ALL
+			SELECT post_id, user_id, 'bookmark', $7::float8, created_at
+			FROM bookmarks WHERE created_at > $3
+			UNION ALL
+			SELECT post_id, NULL, 'view', $8::float8, created_at
+			FROM post_views WHERE created_at > $3
+		) e
+		JOIN posts p ON p.id = e.post_id
+		WHERE p.published_at IS NOT NULL AND p.hidden_at IS NULL
+			AND (e.user_id IS NULL OR e.user_id <> p.author_id)
+		GROUP BY e.post_id
+		ON CONFLICT (period, post_id) DO UPDATE SET
+			score = EXCLUDED.score,
+			likes_count = EXCLUDED.likes_count,
+			comments_count = EXCLUDED.comments_count,
+			bookmarks_count = EXCLUDED.bookmarks_count,
+			views_count = EXCLUDED.views_count,
+			refreshed_at = EXCLUDED.refreshed_at
+	`
+
+	result, err := dao.execContext(ctx, query,
+		window.Period, now, now.Add(-window.Span), window.HalfLife.Seconds(),
+		weights.Like, weights.Comment, weights.Bookmark, weights.View,
+		domain.ReactionLike, domain.CommentStatusApproved,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	ranked, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	// Posts without events in the window anymore drop out
+	_, err = dao.execContext(ctx, "DELETE FROM post_rankings WHERE period = $1 AND refreshed_at < $2", window.Period, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return ranked, nil
+}
+
+func (dao *RankingDAO) FindRankedPosts(ctx context.Context, limit, offset int, where string, args ...interface{}) ([]*RankedPost, error) {
+	rankings := "SELECT * FROM post_rankings"
+	if where != "" {
+		rankings += " WHERE " + where
+	}
+	rankings += fmt.Sprintf(" ORDER BY score DESC, post_id LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	query := `
+		SELECT r.score, r.likes_count, r.comments_count, r.bookmarks_count, r.views_count, r.refreshed_at,` + postCardColumns + `
+		FROM (` + rankings + `) r
+		JOIN posts p ON p.id = r.post_id
+		JOIN users u ON u.id = p.author_id
+		ORDER BY r.score DESC, r.post_id
+	`
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*RankedPost
+	for rows.Next() {
+		var m RankedPost
+		dest := []interface{}{&m.Score, &m.LikesCount, &m.CommentsCount, &m.BookmarksCount, &m.ViewsCount, &m.RefreshedAt}
+		if err := rows.Scan(append(dest, postCardDest(&m.Post)...)...); err != nil {
+			return nil, err
+		}
+		results = append(results, &m)
+	}
+
+	return results, rows.Err()
+}
+
+func (dao *RankingDAO) CountRankedPosts(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM post_rankings"
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	var count int64
+	err := dao.queryRowContext(ctx, query, args...).Scan(&count)
+	return count, err
+}
+
+func (dao *RankingDAO) FindMostLikedPosts(ctx context.Context, limit int, where string, args ...interface{}) ([]*PostCard, error) {
+	posts := "SELECT * FROM posts"
+	if where != "" {
+		posts += " WHERE " + where
+	}
+	args = append(args, limit)
+
+	query := `
+		SELECT ` + postCardColumns + `
+		FROM (` + posts + `) p
+		JOIN users u ON u.id = p.author_id
+		ORDER BY ` + postLikesCount + ` DESC, p.published_at DESC, p.id
+		LIMIT $` + fmt.Sprint(len(args)) + `
+	`
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*PostCard
+	for rows.Next() {
+		var m PostCard
+		if err := rows.Scan(postCardDest(&m)...); err != nil {
+			return nil, err
+		}
+		results = append(results, &m)
+	}
+
+	return results, rows.Err()
+}
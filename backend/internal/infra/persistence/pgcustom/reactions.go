@@ -0,0 +1,65 @@
+package pgcustom
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"blog0/internal/domain/customdao"
+)
+
+// ReactionTallyDAO is written by hand, gormless doesn't generate
+// aggregates.
+type ReactionTallyDAO struct {
+	conn
+}
+
+func NewReactionTallyDAO(db *sql.DB) *ReactionTallyDAO {
+	return &ReactionTallyDAO{conn{db: db}}
+}
+
+func (dao *ReactionTallyDAO) CountForPosts(ctx context.Context, postIDs []string) ([]*customdao.ReactionTally, error) {
+	return dao.count(ctx, "post_id", postIDs)
+}
+
+func (dao *ReactionTallyDAO) CountForComments(ctx context.Context, commentIDs []string) ([]*customdao.ReactionTally, error) {
+	return dao.count(ctx, "comment_id", commentIDs)
+}
+
+func (dao *ReactionTallyDAO) count(ctx context.Context, column string, targetIDs []string) ([]*customdao.ReactionTally, error) {
+	if len(targetIDs) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, 0, len(targetIDs))
+	placeholders := make([]string, 0, len(targetIDs))
+	for _, id := range targetIDs {
+		args = append(args, id)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+	}
+
+	query := `
+		SELECT ` + column + `, emoji, COUNT(*)
+		FROM reactions
+		WHERE ` + column + ` IN (` + strings.Join(placeholders, ",") + `)
+		GROUP BY ` + column + `, emoji
+	`
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*customdao.ReactionTally
+	for rows.Next() {
+		var m customdao.ReactionTally
+		if err := rows.Scan(&m.TargetID, &m.Emoji, &m.Count); err != nil {
+			return nil, err
+		}
+		results = append(results, &m)
+	}
+
+	return results, rows.Err()
+}
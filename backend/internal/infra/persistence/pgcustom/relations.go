@@ -0,0 +1,96 @@
+package pgcustom
+
+import (
+	"context"
+	"database/sql"
+
+	"blog0/internal/domain"
+)
+
+// RelationDAO is written by hand, gormless doesn't generate conflict
+// handling.
+type RelationDAO struct {
+	conn
+}
+
+func NewRelationDAO(db *sql.DB) *RelationDAO {
+	return &RelationDAO{conn{db: db}}
+}
+
+func (dao *RelationDAO) insert(ctx context.Context, query string, args ...interface{}) (bool, error) {
+	result, err := dao.execContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return inserted > 0, nil
+}
+
+func (dao *RelationDAO) InsertFollow(ctx context.Context, m *domain.Follow) (bool, error) {
+	query := `
+		INSERT INTO follows (id, follower_id, followee_id, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (follower_id, followee_id) DO NOTHING
+	`
+
+	return dao.insert(ctx, query, m.ID, m.FollowerID, m.FolloweeID, m.CreatedAt)
+}
+
+func (dao *RelationDAO) InsertBlock(ctx context.Context, m *domain.Block) (bool, error) {
+	query := `
+		INSERT INTO user_blocks (id, blocker_id, blocked_id, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (blocker_id, blocked_id) DO NOTHING
+	`
+
+	return dao.insert(ctx, query, m.ID, m.BlockerID, m.BlockedID, m.CreatedAt)
+}
+
+func (dao *RelationDAO) InsertMute(ctx context.Context, m *domain.Mute) (bool, error) {
+	query := `
+		INSERT INTO user_mutes (id, muter_id, muted_id, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (muter_id, muted_id) DO NOTHING
+	`
+
+	return dao.insert(ctx, query, m.ID, m.MuterID, m.MutedID, m.CreatedAt)
+}
+
+func (dao *RelationDAO) InsertBookmark(ctx context.Context, m *domain.Bookmark) (bool, error) {
+	query := `
+		INSERT INTO bookmarks (id, user_id, post_id, created_at, collection_id, note, read_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id, post_id) DO NOTHING
+	`
+
+	return dao.insert(ctx, query, m.ID, m.UserID, m.PostID, m.CreatedAt, m.CollectionID, m.Note, m.ReadAt)
+}
+
+func (dao *RelationDAO) InsertReaction(ctx context.Context, m *domain.Reaction) (bool, error) {
+	// Either (user_id, post_id, emoji) or (user_id, comment_id, emoji) is the
+	// key, depending on the target
+	query := `
+		INSERT INTO reactions (id, user_id, post_id, comment_id, emoji, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT DO NOTHING
+	`
+
+	return dao.insert(ctx, query, m.ID, m.UserID, m.PostID, m.CommentID, m.Emoji, m.CreatedAt)
+}
+
+func (dao *RelationDAO) InsertMention(ctx context.Context, m *domain.Mention) (bool, error) {
+	// Either (post_id, mentioned_user_id) or (comment_id, mentioned_user_id)
+	// is the key, depending on where the mention is
+	query := `
+		INSERT INTO mentions (id, mentioned_user_id, author_id, post_id, comment_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT DO NOTHING
+	`
+
+	return dao.insert(ctx, query, m.ID, m.MentionedUserID, m.AuthorID, m.PostID, m.CommentID, m.CreatedAt)
+}
@@ -0,0 +1,41 @@
+package pgcustom
+
+import (
+	"context"
+	"database/sql"
+
+	"blog0/internal/domain"
+)
+
+// ReportDAO is written by hand, gormless doesn't generate conflict handling.
+type ReportDAO struct {
+	conn
+}
+
+func NewReportDAO(db *sql.DB) *ReportDAO {
+	return &ReportDAO{conn{db: db}}
+}
+
+func (dao *ReportDAO) InsertOpen(ctx context.Context, m *domain.Report) (bool, error) {
+	// idx_reports_open_reporter_target is the key
+	query := `
+		INSERT INTO reports (id, reporter_id, target_type, post_id, comment_id, reported_user_id, reason, details, status, resolution, resolved_by, resolved_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT DO NOTHING
+	`
+
+	result, err := dao.execContext(ctx, query,
+		m.ID, m.ReporterID, m.TargetType, m.PostID, m.CommentID, m.ReportedUserID,
+		m.Reason, m.Details, m.Status, m.Resolution, m.ResolvedBy, m.ResolvedAt, m.CreatedAt,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return inserted > 0, nil
+}
@@ -0,0 +1,44 @@
+package pgcustom
+
+import (
+	"context"
+	"database/sql"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+)
+
+// SessionRotationDAO is written by hand, gormless doesn't generate conditional
+// updates.
+type SessionRotationDAO struct {
+	conn
+}
+
+func NewSessionRotationDAO(db *sql.DB) *SessionRotationDAO {
+	return &SessionRotationDAO{conn{db: db}}
+}
+
+func (dao *SessionRotationDAO) Rotate(ctx context.Context, oldTokenHash string, r *customdao.SessionRotation) (*domain.Session, error) {
+	query := `
+		UPDATE sessions
+		SET previous_token_hash = refresh_token_hash,
+			refresh_token_hash = $2,
+			user_agent = $3,
+			ip = $4,
+			last_seen_at = $5,
+			expires_at = $6
+		WHERE refresh_token_hash = $1 AND revoked_at IS NULL AND expires_at > $5
+		RETURNING id, user_id, refresh_token_hash, previous_token_hash, user_agent, ip, created_at, last_seen_at, expires_at, revoked_at
+	`
+
+	var m domain.Session
+	err := dao.queryRowContext(ctx, query, oldTokenHash, r.RefreshTokenHash, r.UserAgent, r.IP, r.Now, r.ExpiresAt).Scan(
+		&m.ID, &m.UserID, &m.RefreshTokenHash, &m.PreviousTokenHash, &m.UserAgent, &m.IP,
+		&m.CreatedAt, &m.LastSeenAt, &m.ExpiresAt, &m.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
@@ -0,0 +1,407 @@
+package postgres
+
+import (
+	"blog0/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type AdminAction = domain.AdminAction
+
+type AdminActionDAO struct {
+	db *sql.DB
+}
+
+func NewAdminActionDAO(db *sql.DB) *AdminActionDAO {
+	return &AdminActionDAO{db: db}
+}
+
+func (dao *AdminActionDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *AdminActionDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *AdminActionDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *AdminActionDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *AdminActionDAO) Create(ctx context.Context, m *AdminAction) error {
+	query := `
+		INSERT INTO admin_actions (id, admin_id, action, report_id, target_type, target_id, note, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.AdminID,
+		m.Action,
+		m.ReportID,
+		m.TargetType,
+		m.TargetID,
+		m.Note,
+		m.CreatedAt,
+	)
+
+	return err
+}
+
+func (dao *AdminActionDAO) Update(ctx context.Context, m *AdminAction) error {
+	query := `
+		UPDATE admin_actions
+		SET admin_id = $1,
+			action = $2,
+			report_id = $3,
+			target_type = $4,
+			target_id = $5,
+			note = $6,
+			created_at = $7
+		WHERE id = $8
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.AdminID,
+		m.Action,
+		m.ReportID,
+		m.TargetType,
+		m.TargetID,
+		m.Note,
+		m.CreatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *AdminActionDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE admin_actions SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *AdminActionDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM admin_actions WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *AdminActionDAO) FindByPk(ctx context.Context, pk string) (*AdminAction, error) {
+	query := `
+		SELECT id, admin_id, action, report_id, target_type, target_id, note, created_at
+		FROM admin_actions
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m AdminAction
+	err := row.Scan(
+		&m.ID,
+		&m.AdminID,
+		&m.Action,
+		&m.ReportID,
+		&m.TargetType,
+		&m.TargetID,
+		&m.Note,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *AdminActionDAO) CreateMany(ctx context.Context, models []*AdminAction) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*8)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*8+1, i*8+2, i*8+3, i*8+4, i*8+5, i*8+6, i*8+7, i*8+8)
+
+		args = append(args,
+			model.ID,
+			model.AdminID,
+			model.Action,
+			model.ReportID,
+			model.TargetType,
+			model.TargetID,
+			model.Note,
+			model.CreatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO admin_actions (id, admin_id, action, report_id, target_type, target_id, note, created_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *AdminActionDAO) UpdateMany(ctx context.Context, models []*AdminAction) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE admin_actions
+		SET admin_id = $1,
+			action = $2,
+			report_id = $3,
+			target_type = $4,
+			target_id = $5,
+			note = $6,
+			created_at = $7
+		WHERE id = $8
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.AdminID,
+			model.Action,
+			model.ReportID,
+			model.TargetType,
+			model.TargetID,
+			model.Note,
+			model.CreatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *AdminActionDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM admin_actions WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *AdminActionDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*AdminAction, error) {
+	query := `
+		SELECT id, admin_id, action, report_id, target_type, target_id, note, created_at
+		FROM admin_actions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m AdminAction
+	err := row.Scan(
+		&m.ID,
+		&m.AdminID,
+		&m.Action,
+		&m.ReportID,
+		&m.TargetType,
+		&m.TargetID,
+		&m.Note,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *AdminActionDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*AdminAction, error) {
+	query := `
+		SELECT id, admin_id, action, report_id, target_type, target_id, note, created_at
+		FROM admin_actions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*AdminAction
+	for rows.Next() {
+		var m AdminAction
+		err := rows.Scan(
+			&m.ID,
+			&m.AdminID,
+			&m.Action,
+			&m.ReportID,
+			&m.TargetType,
+			&m.TargetID,
+			&m.Note,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *AdminActionDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*AdminAction, error) {
+	query := `
+		SELECT id, admin_id, action, report_id, target_type, target_id, note, created_at
+		FROM admin_actions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*AdminAction
+	for rows.Next() {
+		var m AdminAction
+		err := rows.Scan(
+			&m.ID,
+			&m.AdminID,
+			&m.Action,
+			&m.ReportID,
+			&m.TargetType,
+			&m.TargetID,
+			&m.Note,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *AdminActionDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM admin_actions"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *AdminActionDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,367 @@
+package postgres
+
+import (
+	"blog0/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type Avatar = domain.Avatar
+
+type AvatarDAO struct {
+	db *sql.DB
+}
+
+func NewAvatarDAO(db *sql.DB) *AvatarDAO {
+	return &AvatarDAO{db: db}
+}
+
+func (dao *AvatarDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *AvatarDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *AvatarDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *AvatarDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *AvatarDAO) Create(ctx context.Context, m *Avatar) error {
+	query := `
+		INSERT INTO avatars (user_id, content_type, data, updated_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.UserID,
+		m.ContentType,
+		m.Data,
+		m.UpdatedAt,
+	)
+
+	return err
+}
+
+func (dao *AvatarDAO) Update(ctx context.Context, m *Avatar) error {
+	query := `
+		UPDATE avatars
+		SET content_type = $1,
+			data = $2,
+			updated_at = $3
+		WHERE user_id = $4
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.ContentType,
+		m.Data,
+		m.UpdatedAt,
+		m.UserID,
+	)
+	return err
+}
+
+func (dao *AvatarDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE avatars SET %s WHERE user_id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *AvatarDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM avatars WHERE user_id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *AvatarDAO) FindByPk(ctx context.Context, pk string) (*Avatar, error) {
+	query := `
+		SELECT user_id, content_type, data, updated_at
+		FROM avatars
+		WHERE user_id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m Avatar
+	err := row.Scan(
+		&m.UserID,
+		&m.ContentType,
+		&m.Data,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *AvatarDAO) CreateMany(ctx context.Context, models []*Avatar) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*4)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d)",
+			i*4+1, i*4+2, i*4+3, i*4+4)
+
+		args = append(args,
+			model.UserID,
+			model.ContentType,
+			model.Data,
+			model.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO avatars (user_id, content_type, data, updated_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *AvatarDAO) UpdateMany(ctx context.Context, models []*Avatar) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE avatars
+		SET content_type = $1,
+			data = $2,
+			updated_at = $3
+		WHERE user_id = $4
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.ContentType,
+			model.Data,
+			model.UpdatedAt,
+			model.UserID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *AvatarDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM avatars WHERE user_id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *AvatarDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Avatar, error) {
+	query := `
+		SELECT user_id, content_type, data, updated_at
+		FROM avatars
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m Avatar
+	err := row.Scan(
+		&m.UserID,
+		&m.ContentType,
+		&m.Data,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *AvatarDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Avatar, error) {
+	query := `
+		SELECT user_id, content_type, data, updated_at
+		FROM avatars
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Avatar
+	for rows.Next() {
+		var m Avatar
+		err := rows.Scan(
+			&m.UserID,
+			&m.ContentType,
+			&m.Data,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *AvatarDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Avatar, error) {
+	query := `
+		SELECT user_id, content_type, data, updated_at
+		FROM avatars
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Avatar
+	for rows.Next() {
+		var m Avatar
+		err := rows.Scan(
+			&m.UserID,
+			&m.ContentType,
+			&m.Data,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *AvatarDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM avatars"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *AvatarDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
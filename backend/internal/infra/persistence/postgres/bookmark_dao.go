@@ -48,8 +48,8 @@ func (dao *BookmarkDAO) queryContext(ctx context.Context, query string, args ...
 
 func (dao *BookmarkDAO) Create(ctx context.Context, m *Bookmark) error {
 	query := `
-		INSERT INTO bookmarks (id, user_id, post_id, created_at)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO bookmarks (id, user_id, post_id, created_at, collection_id, note, read_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
 	_, err := dao.execContext(
@@ -59,6 +59,9 @@ func (dao *BookmarkDAO) Create(ctx context.Context, m *Bookmark) error {
 		m.UserID,
 		m.PostID,
 		m.CreatedAt,
+		m.CollectionID,
+		m.Note,
+		m.ReadAt,
 	)
 
 	return err
@@ -69,14 +72,20 @@ func (dao *BookmarkDAO) Update(ctx context.Context, m *Bookmark) error {
 		UPDATE bookmarks
 		SET user_id = $1,
 			post_id = $2,
-			created_at = $3
-		WHERE id = $4
+			created_at = $3,
+			collection_id = $4,
+			note = $5,
+			read_at = $6
+		WHERE id = $7
 	`
 
 	_, err := dao.execContext(ctx, query,
 		m.UserID,
 		m.PostID,
 		m.CreatedAt,
+		m.CollectionID,
+		m.Note,
+		m.ReadAt,
 		m.ID,
 	)
 	return err
@@ -113,7 +122,7 @@ func (dao *BookmarkDAO) DeleteByPk(ctx context.Context, pk string) error {
 
 func (dao *BookmarkDAO) FindByPk(ctx context.Context, pk string) (*Bookmark, error) {
 	query := `
-		SELECT id, user_id, post_id, created_at
+		SELECT id, user_id, post_id, created_at, collection_id, note, read_at
 		FROM bookmarks
 		WHERE id = $1
 	`
@@ -125,6 +134,9 @@ func (dao *BookmarkDAO) FindByPk(ctx context.Context, pk string) (*Bookmark, err
 		&m.UserID,
 		&m.PostID,
 		&m.CreatedAt,
+		&m.CollectionID,
+		&m.Note,
+		&m.ReadAt,
 	)
 
 	if err != nil {
@@ -140,22 +152,25 @@ func (dao *BookmarkDAO) CreateMany(ctx context.Context, models []*Bookmark) erro
 	}
 
 	placeholders := make([]string, len(models))
-	args := make([]interface{}, 0, len(models)*4)
+	args := make([]interface{}, 0, len(models)*7)
 
 	for i, model := range models {
-		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d)",
-			i*4+1, i*4+2, i*4+3, i*4+4)
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*7+1, i*7+2, i*7+3, i*7+4, i*7+5, i*7+6, i*7+7)
 
 		args = append(args,
 			model.ID,
 			model.UserID,
 			model.PostID,
 			model.CreatedAt,
+			model.CollectionID,
+			model.Note,
+			model.ReadAt,
 		)
 	}
 
 	query := fmt.Sprintf(`
-		INSERT INTO bookmarks (id, user_id, post_id, created_at)
+		INSERT INTO bookmarks (id, user_id, post_id, created_at, collection_id, note, read_at)
 		VALUES %s
 	`, strings.Join(placeholders, ", "))
 
@@ -172,8 +187,11 @@ func (dao *BookmarkDAO) UpdateMany(ctx context.Context, models []*Bookmark) erro
 		UPDATE bookmarks
 		SET user_id = $1,
 			post_id = $2,
-			created_at = $3
-		WHERE id = $4
+			created_at = $3,
+			collection_id = $4,
+			note = $5,
+			read_at = $6
+		WHERE id = $7
 	`
 
 	for _, model := range models {
@@ -181,6 +199,9 @@ func (dao *BookmarkDAO) UpdateMany(ctx context.Context, models []*Bookmark) erro
 			model.UserID,
 			model.PostID,
 			model.CreatedAt,
+			model.CollectionID,
+			model.Note,
+			model.ReadAt,
 			model.ID,
 		)
 		if err != nil {
@@ -210,7 +231,7 @@ func (dao *BookmarkDAO) DeleteManyByPks(ctx context.Context, pks []string) error
 
 func (dao *BookmarkDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Bookmark, error) {
 	query := `
-		SELECT id, user_id, post_id, created_at
+		SELECT id, user_id, post_id, created_at, collection_id, note, read_at
 		FROM bookmarks
 	`
 
@@ -230,6 +251,9 @@ func (dao *BookmarkDAO) FindOne(ctx context.Context, where string, sort string,
 		&m.UserID,
 		&m.PostID,
 		&m.CreatedAt,
+		&m.CollectionID,
+		&m.Note,
+		&m.ReadAt,
 	)
 
 	if err != nil {
@@ -241,7 +265,7 @@ func (dao *BookmarkDAO) FindOne(ctx context.Context, where string, sort string,
 
 func (dao *BookmarkDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Bookmark, error) {
 	query := `
-		SELECT id, user_id, post_id, created_at
+		SELECT id, user_id, post_id, created_at, collection_id, note, read_at
 		FROM bookmarks
 	`
 
@@ -267,6 +291,9 @@ func (dao *BookmarkDAO) FindAll(ctx context.Context, where string, sort string,
 			&m.UserID,
 			&m.PostID,
 			&m.CreatedAt,
+			&m.CollectionID,
+			&m.Note,
+			&m.ReadAt,
 		)
 		if err != nil {
 			return nil, err
@@ -283,7 +310,7 @@ func (dao *BookmarkDAO) FindAll(ctx context.Context, where string, sort string,
 
 func (dao *BookmarkDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Bookmark, error) {
 	query := `
-		SELECT id, user_id, post_id, created_at
+		SELECT id, user_id, post_id, created_at, collection_id, note, read_at
 		FROM bookmarks
 	`
 
@@ -311,6 +338,9 @@ func (dao *BookmarkDAO) FindPaginated(ctx context.Context, limit, offset int, wh
 			&m.UserID,
 			&m.PostID,
 			&m.CreatedAt,
+			&m.CollectionID,
+			&m.Note,
+			&m.ReadAt,
 		)
 		if err != nil {
 			return nil, err
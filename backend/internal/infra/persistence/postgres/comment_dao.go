@@ -48,8 +48,8 @@ func (dao *CommentDAO) queryContext(ctx context.Context, query string, args ...i
 
 func (dao *CommentDAO) Create(ctx context.Context, m *Comment) error {
 	query := `
-		INSERT INTO comments (id, post_id, author_id, parent_id, body, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO comments (id, post_id, author_id, parent_id, body, reply_count, depth, created_at, updated_at, edited_at, deleted_at, status, moderation_reason, moderated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 	`
 
 	_, err := dao.execContext(
@@ -60,8 +60,15 @@ func (dao *CommentDAO) Create(ctx context.Context, m *Comment) error {
 		m.AuthorID,
 		m.ParentID,
 		m.Body,
+		m.ReplyCount,
+		m.Depth,
 		m.CreatedAt,
 		m.UpdatedAt,
+		m.EditedAt,
+		m.DeletedAt,
+		m.Status,
+		m.ModerationReason,
+		m.ModeratedAt,
 	)
 
 	return err
@@ -74,9 +81,16 @@ func (dao *CommentDAO) Update(ctx context.Context, m *Comment) error {
 			author_id = $2,
 			parent_id = $3,
 			body = $4,
-			created_at = $5,
-			updated_at = $6
-		WHERE id = $7
+			reply_count = $5,
+			depth = $6,
+			created_at = $7,
+			updated_at = $8,
+			edited_at = $9,
+			deleted_at = $10,
+			status = $11,
+			moderation_reason = $12,
+			moderated_at = $13
+		WHERE id = $14
 	`
 
 	_, err := dao.execContext(ctx, query,
@@ -84,8 +98,15 @@ func (dao *CommentDAO) Update(ctx context.Context, m *Comment) error {
 		m.AuthorID,
 		m.ParentID,
 		m.Body,
+		m.ReplyCount,
+		m.Depth,
 		m.CreatedAt,
 		m.UpdatedAt,
+		m.EditedAt,
+		m.DeletedAt,
+		m.Status,
+		m.ModerationReason,
+		m.ModeratedAt,
 		m.ID,
 	)
 	return err
@@ -122,7 +143,7 @@ func (dao *CommentDAO) DeleteByPk(ctx context.Context, pk string) error {
 
 func (dao *CommentDAO) FindByPk(ctx context.Context, pk string) (*Comment, error) {
 	query := `
-		SELECT id, post_id, author_id, parent_id, body, created_at, updated_at
+		SELECT id, post_id, author_id, parent_id, body, reply_count, depth, created_at, updated_at, edited_at, deleted_at, status, moderation_reason, moderated_at
 		FROM comments
 		WHERE id = $1
 	`
@@ -135,8 +156,15 @@ func (dao *CommentDAO) FindByPk(ctx context.Context, pk string) (*Comment, error
 		&m.AuthorID,
 		&m.ParentID,
 		&m.Body,
+		&m.ReplyCount,
+		&m.Depth,
 		&m.CreatedAt,
 		&m.UpdatedAt,
+		&m.EditedAt,
+		&m.DeletedAt,
+		&m.Status,
+		&m.ModerationReason,
+		&m.ModeratedAt,
 	)
 
 	if err != nil {
@@ -152,11 +180,11 @@ func (dao *CommentDAO) CreateMany(ctx context.Context, models []*Comment) error
 	}
 
 	placeholders := make([]string, len(models))
-	args := make([]interface{}, 0, len(models)*7)
+	args := make([]interface{}, 0, len(models)*14)
 
 	for i, model := range models {
-		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
-			i*7+1, i*7+2, i*7+3, i*7+4, i*7+5, i*7+6, i*7+7)
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*14+1, i*14+2, i*14+3, i*14+4, i*14+5, i*14+6, i*14+7, i*14+8, i*14+9, i*14+10, i*14+11, i*14+12, i*14+13, i*14+14)
 
 		args = append(args,
 			model.ID,
@@ -164,13 +192,20 @@ func (dao *CommentDAO) CreateMany(ctx context.Context, models []*Comment) error
 			model.AuthorID,
 			model.ParentID,
 			model.Body,
+			model.ReplyCount,
+			model.Depth,
 			model.CreatedAt,
 			model.UpdatedAt,
+			model.EditedAt,
+			model.DeletedAt,
+			model.Status,
+			model.ModerationReason,
+			model.ModeratedAt,
 		)
 	}
 
 	query := fmt.Sprintf(`
-		INSERT INTO comments (id, post_id, author_id, parent_id, body, created_at, updated_at)
+		INSERT INTO comments (id, post_id, author_id, parent_id, body, reply_count, depth, created_at, updated_at, edited_at, deleted_at, status, moderation_reason, moderated_at)
 		VALUES %s
 	`, strings.Join(placeholders, ", "))
 
@@ -189,9 +224,16 @@ func (dao *CommentDAO) UpdateMany(ctx context.Context, models []*Comment) error
 			author_id = $2,
 			parent_id = $3,
 			body = $4,
-			created_at = $5,
-			updated_at = $6
-		WHERE id = $7
+			reply_count = $5,
+			depth = $6,
+			created_at = $7,
+			updated_at = $8,
+			edited_at = $9,
+			deleted_at = $10,
+			status = $11,
+			moderation_reason = $12,
+			moderated_at = $13
+		WHERE id = $14
 	`
 
 	for _, model := range models {
@@ -200,8 +242,15 @@ func (dao *CommentDAO) UpdateMany(ctx context.Context, models []*Comment) error
 			model.AuthorID,
 			model.ParentID,
 			model.Body,
+			model.ReplyCount,
+			model.Depth,
 			model.CreatedAt,
 			model.UpdatedAt,
+			model.EditedAt,
+			model.DeletedAt,
+			model.Status,
+			model.ModerationReason,
+			model.ModeratedAt,
 			model.ID,
 		)
 		if err != nil {
@@ -231,7 +280,7 @@ func (dao *CommentDAO) DeleteManyByPks(ctx context.Context, pks []string) error
 
 func (dao *CommentDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Comment, error) {
 	query := `
-		SELECT id, post_id, author_id, parent_id, body, created_at, updated_at
+		SELECT id, post_id, author_id, parent_id, body, reply_count, depth, created_at, updated_at, edited_at, deleted_at, status, moderation_reason, moderated_at
 		FROM comments
 	`
 
@@ -252,8 +301,15 @@ func (dao *CommentDAO) FindOne(ctx context.Context, where string, sort string, a
 		&m.AuthorID,
 		&m.ParentID,
 		&m.Body,
+		&m.ReplyCount,
+		&m.Depth,
 		&m.CreatedAt,
 		&m.UpdatedAt,
+		&m.EditedAt,
+		&m.DeletedAt,
+		&m.Status,
+		&m.ModerationReason,
+		&m.ModeratedAt,
 	)
 
 	if err != nil {
@@ -265,7 +321,7 @@ func (dao *CommentDAO) FindOne(ctx context.Context, where string, sort string, a
 
 func (dao *CommentDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Comment, error) {
 	query := `
-		SELECT id, post_id, author_id, parent_id, body, created_at, updated_at
+		SELECT id, post_id, author_id, parent_id, body, reply_count, depth, created_at, updated_at, edited_at, deleted_at, status, moderation_reason, moderated_at
 		FROM comments
 	`
 
@@ -292,8 +348,15 @@ func (dao *CommentDAO) FindAll(ctx context.Context, where string, sort string, a
 			&m.AuthorID,
 			&m.ParentID,
 			&m.Body,
+			&m.ReplyCount,
+			&m.Depth,
 			&m.CreatedAt,
 			&m.UpdatedAt,
+			&m.EditedAt,
+			&m.DeletedAt,
+			&m.Status,
+			&m.ModerationReason,
+			&m.ModeratedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -310,7 +373,7 @@ func (dao *CommentDAO) FindAll(ctx context.Context, where string, sort string, a
 
 func (dao *CommentDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Comment, error) {
 	query := `
-		SELECT id, post_id, author_id, parent_id, body, created_at, updated_at
+		SELECT id, post_id, author_id, parent_id, body, reply_count, depth, created_at, updated_at, edited_at, deleted_at, status, moderation_reason, moderated_at
 		FROM comments
 	`
 
@@ -339,8 +402,15 @@ func (dao *CommentDAO) FindPaginated(ctx context.Context, limit, offset int, whe
 			&m.AuthorID,
 			&m.ParentID,
 			&m.Body,
+			&m.ReplyCount,
+			&m.Depth,
 			&m.CreatedAt,
 			&m.UpdatedAt,
+			&m.EditedAt,
+			&m.DeletedAt,
+			&m.Status,
+			&m.ModerationReason,
+			&m.ModeratedAt,
 		)
 		if err != nil {
 			return nil, err
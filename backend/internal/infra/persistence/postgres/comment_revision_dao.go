@@ -0,0 +1,377 @@
+package postgres
+
+import (
+	"blog0/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type CommentRevision = domain.CommentRevision
+
+type CommentRevisionDAO struct {
+	db *sql.DB
+}
+
+func NewCommentRevisionDAO(db *sql.DB) *CommentRevisionDAO {
+	return &CommentRevisionDAO{db: db}
+}
+
+func (dao *CommentRevisionDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *CommentRevisionDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *CommentRevisionDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *CommentRevisionDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *CommentRevisionDAO) Create(ctx context.Context, m *CommentRevision) error {
+	query := `
+		INSERT INTO comment_revisions (id, comment_id, editor_id, body, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.CommentID,
+		m.EditorID,
+		m.Body,
+		m.CreatedAt,
+	)
+
+	return err
+}
+
+func (dao *CommentRevisionDAO) Update(ctx context.Context, m *CommentRevision) error {
+	query := `
+		UPDATE comment_revisions
+		SET comment_id = $1,
+			editor_id = $2,
+			body = $3,
+			created_at = $4
+		WHERE id = $5
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.CommentID,
+		m.EditorID,
+		m.Body,
+		m.CreatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *CommentRevisionDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE comment_revisions SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *CommentRevisionDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM comment_revisions WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *CommentRevisionDAO) FindByPk(ctx context.Context, pk string) (*CommentRevision, error) {
+	query := `
+		SELECT id, comment_id, editor_id, body, created_at
+		FROM comment_revisions
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m CommentRevision
+	err := row.Scan(
+		&m.ID,
+		&m.CommentID,
+		&m.EditorID,
+		&m.Body,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *CommentRevisionDAO) CreateMany(ctx context.Context, models []*CommentRevision) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*5)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)",
+			i*5+1, i*5+2, i*5+3, i*5+4, i*5+5)
+
+		args = append(args,
+			model.ID,
+			model.CommentID,
+			model.EditorID,
+			model.Body,
+			model.CreatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO comment_revisions (id, comment_id, editor_id, body, created_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *CommentRevisionDAO) UpdateMany(ctx context.Context, models []*CommentRevision) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE comment_revisions
+		SET comment_id = $1,
+			editor_id = $2,
+			body = $3,
+			created_at = $4
+		WHERE id = $5
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.CommentID,
+			model.EditorID,
+			model.Body,
+			model.CreatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *CommentRevisionDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM comment_revisions WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *CommentRevisionDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*CommentRevision, error) {
+	query := `
+		SELECT id, comment_id, editor_id, body, created_at
+		FROM comment_revisions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m CommentRevision
+	err := row.Scan(
+		&m.ID,
+		&m.CommentID,
+		&m.EditorID,
+		&m.Body,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *CommentRevisionDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*CommentRevision, error) {
+	query := `
+		SELECT id, comment_id, editor_id, body, created_at
+		FROM comment_revisions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*CommentRevision
+	for rows.Next() {
+		var m CommentRevision
+		err := rows.Scan(
+			&m.ID,
+			&m.CommentID,
+			&m.EditorID,
+			&m.Body,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *CommentRevisionDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*CommentRevision, error) {
+	query := `
+		SELECT id, comment_id, editor_id, body, created_at
+		FROM comment_revisions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*CommentRevision
+	for rows.Next() {
+		var m CommentRevision
+		err := rows.Scan(
+			&m.ID,
+			&m.CommentID,
+			&m.EditorID,
+			&m.Body,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *CommentRevisionDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM comment_revisions"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *CommentRevisionDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
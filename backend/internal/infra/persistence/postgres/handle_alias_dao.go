@@ -0,0 +1,367 @@
+package postgres
+
+import (
+	"blog0/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type HandleAlias = domain.HandleAlias
+
+type HandleAliasDAO struct {
+	db *sql.DB
+}
+
+func NewHandleAliasDAO(db *sql.DB) *HandleAliasDAO {
+	return &HandleAliasDAO{db: db}
+}
+
+func (dao *HandleAliasDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *HandleAliasDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *HandleAliasDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *HandleAliasDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *HandleAliasDAO) Create(ctx context.Context, m *HandleAlias) error {
+	query := `
+		INSERT INTO handle_aliases (handle, user_id, created_at, expires_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.Handle,
+		m.UserID,
+		m.CreatedAt,
+		m.ExpiresAt,
+	)
+
+	return err
+}
+
+func (dao *HandleAliasDAO) Update(ctx context.Context, m *HandleAlias) error {
+	query := `
+		UPDATE handle_aliases
+		SET user_id = $1,
+			created_at = $2,
+			expires_at = $3
+		WHERE handle = $4
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.UserID,
+		m.CreatedAt,
+		m.ExpiresAt,
+		m.Handle,
+	)
+	return err
+}
+
+func (dao *HandleAliasDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE handle_aliases SET %s WHERE handle = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *HandleAliasDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM handle_aliases WHERE handle = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *HandleAliasDAO) FindByPk(ctx context.Context, pk string) (*HandleAlias, error) {
+	query := `
+		SELECT handle, user_id, created_at, expires_at
+		FROM handle_aliases
+		WHERE handle = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m HandleAlias
+	err := row.Scan(
+		&m.Handle,
+		&m.UserID,
+		&m.CreatedAt,
+		&m.ExpiresAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *HandleAliasDAO) CreateMany(ctx context.Context, models []*HandleAlias) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*4)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d)",
+			i*4+1, i*4+2, i*4+3, i*4+4)
+
+		args = append(args,
+			model.Handle,
+			model.UserID,
+			model.CreatedAt,
+			model.ExpiresAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO handle_aliases (handle, user_id, created_at, expires_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *HandleAliasDAO) UpdateMany(ctx context.Context, models []*HandleAlias) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE handle_aliases
+		SET user_id = $1,
+			created_at = $2,
+			expires_at = $3
+		WHERE handle = $4
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.UserID,
+			model.CreatedAt,
+			model.ExpiresAt,
+			model.Handle,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *HandleAliasDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM handle_aliases WHERE handle IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *HandleAliasDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*HandleAlias, error) {
+	query := `
+		SELECT handle, user_id, created_at, expires_at
+		FROM handle_aliases
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m HandleAlias
+	err := row.Scan(
+		&m.Handle,
+		&m.UserID,
+		&m.CreatedAt,
+		&m.ExpiresAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *HandleAliasDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*HandleAlias, error) {
+	query := `
+		SELECT handle, user_id, created_at, expires_at
+		FROM handle_aliases
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*HandleAlias
+	for rows.Next() {
+		var m HandleAlias
+		err := rows.Scan(
+			&m.Handle,
+			&m.UserID,
+			&m.CreatedAt,
+			&m.ExpiresAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *HandleAliasDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*HandleAlias, error) {
+	query := `
+		SELECT handle, user_id, created_at, expires_at
+		FROM handle_aliases
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*HandleAlias
+	for rows.Next() {
+		var m HandleAlias
+		err := rows.Scan(
+			&m.Handle,
+			&m.UserID,
+			&m.CreatedAt,
+			&m.ExpiresAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *HandleAliasDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM handle_aliases"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *HandleAliasDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,397 @@
+package postgres
+
+import (
+	"blog0/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type Identity = domain.Identity
+
+type IdentityDAO struct {
+	db *sql.DB
+}
+
+func NewIdentityDAO(db *sql.DB) *IdentityDAO {
+	return &IdentityDAO{db: db}
+}
+
+func (dao *IdentityDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *IdentityDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *IdentityDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *IdentityDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *IdentityDAO) Create(ctx context.Context, m *Identity) error {
+	query := `
+		INSERT INTO identities (id, user_id, provider, subject, email, created_at, last_used_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.UserID,
+		m.Provider,
+		m.Subject,
+		m.Email,
+		m.CreatedAt,
+		m.LastUsedAt,
+	)
+
+	return err
+}
+
+func (dao *IdentityDAO) Update(ctx context.Context, m *Identity) error {
+	query := `
+		UPDATE identities
+		SET user_id = $1,
+			provider = $2,
+			subject = $3,
+			email = $4,
+			created_at = $5,
+			last_used_at = $6
+		WHERE id = $7
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.UserID,
+		m.Provider,
+		m.Subject,
+		m.Email,
+		m.CreatedAt,
+		m.LastUsedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *IdentityDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE identities SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *IdentityDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM identities WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *IdentityDAO) FindByPk(ctx context.Context, pk string) (*Identity, error) {
+	query := `
+		SELECT id, user_id, provider, subject, email, created_at, last_used_at
+		FROM identities
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m Identity
+	err := row.Scan(
+		&m.ID,
+		&m.UserID,
+		&m.Provider,
+		&m.Subject,
+		&m.Email,
+		&m.CreatedAt,
+		&m.LastUsedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *IdentityDAO) CreateMany(ctx context.Context, models []*Identity) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*7)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*7+1, i*7+2, i*7+3, i*7+4, i*7+5, i*7+6, i*7+7)
+
+		args = append(args,
+			model.ID,
+			model.UserID,
+			model.Provider,
+			model.Subject,
+			model.Email,
+			model.CreatedAt,
+			model.LastUsedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO identities (id, user_id, provider, subject, email, created_at, last_used_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *IdentityDAO) UpdateMany(ctx context.Context, models []*Identity) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE identities
+		SET user_id = $1,
+			provider = $2,
+			subject = $3,
+			email = $4,
+			created_at = $5,
+			last_used_at = $6
+		WHERE id = $7
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.UserID,
+			model.Provider,
+			model.Subject,
+			model.Email,
+			model.CreatedAt,
+			model.LastUsedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *IdentityDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM identities WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *IdentityDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Identity, error) {
+	query := `
+		SELECT id, user_id, provider, subject, email, created_at, last_used_at
+		FROM identities
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m Identity
+	err := row.Scan(
+		&m.ID,
+		&m.UserID,
+		&m.Provider,
+		&m.Subject,
+		&m.Email,
+		&m.CreatedAt,
+		&m.LastUsedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *IdentityDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Identity, error) {
+	query := `
+		SELECT id, user_id, provider, subject, email, created_at, last_used_at
+		FROM identities
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Identity
+	for rows.Next() {
+		var m Identity
+		err := rows.Scan(
+			&m.ID,
+			&m.UserID,
+			&m.Provider,
+			&m.Subject,
+			&m.Email,
+			&m.CreatedAt,
+			&m.LastUsedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *IdentityDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Identity, error) {
+	query := `
+		SELECT id, user_id, provider, subject, email, created_at, last_used_at
+		FROM identities
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Identity
+	for rows.Next() {
+		var m Identity
+		err := rows.Scan(
+			&m.ID,
+			&m.UserID,
+			&m.Provider,
+			&m.Subject,
+			&m.Email,
+			&m.CreatedAt,
+			&m.LastUsedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *IdentityDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM identities"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *IdentityDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,387 @@
+package postgres
+
+import (
+	"blog0/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type Mention = domain.Mention
+
+type MentionDAO struct {
+	db *sql.DB
+}
+
+func NewMentionDAO(db *sql.DB) *MentionDAO {
+	return &MentionDAO{db: db}
+}
+
+func (dao *MentionDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *MentionDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *MentionDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *MentionDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *MentionDAO) Create(ctx context.Context, m *Mention) error {
+	query := `
+		INSERT INTO mentions (id, mentioned_user_id, author_id, post_id, comment_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.MentionedUserID,
+		m.AuthorID,
+		m.PostID,
+		m.CommentID,
+		m.CreatedAt,
+	)
+
+	return err
+}
+
+func (dao *MentionDAO) Update(ctx context.Context, m *Mention) error {
+	query := `
+		UPDATE mentions
+		SET mentioned_user_id = $1,
+			author_id = $2,
+			post_id = $3,
+			comment_id = $4,
+			created_at = $5
+		WHERE id = $6
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.MentionedUserID,
+		m.AuthorID,
+		m.PostID,
+		m.CommentID,
+		m.CreatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *MentionDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE mentions SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *MentionDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM mentions WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *MentionDAO) FindByPk(ctx context.Context, pk string) (*Mention, error) {
+	query := `
+		SELECT id, mentioned_user_id, author_id, post_id, comment_id, created_at
+		FROM mentions
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m Mention
+	err := row.Scan(
+		&m.ID,
+		&m.MentionedUserID,
+		&m.AuthorID,
+		&m.PostID,
+		&m.CommentID,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *MentionDAO) CreateMany(ctx context.Context, models []*Mention) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*6)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)",
+			i*6+1, i*6+2, i*6+3, i*6+4, i*6+5, i*6+6)
+
+		args = append(args,
+			model.ID,
+			model.MentionedUserID,
+			model.AuthorID,
+			model.PostID,
+			model.CommentID,
+			model.CreatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO mentions (id, mentioned_user_id, author_id, post_id, comment_id, created_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *MentionDAO) UpdateMany(ctx context.Context, models []*Mention) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE mentions
+		SET mentioned_user_id = $1,
+			author_id = $2,
+			post_id = $3,
+			comment_id = $4,
+			created_at = $5
+		WHERE id = $6
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.MentionedUserID,
+			model.AuthorID,
+			model.PostID,
+			model.CommentID,
+			model.CreatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *MentionDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM mentions WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *MentionDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Mention, error) {
+	query := `
+		SELECT id, mentioned_user_id, author_id, post_id, comment_id, created_at
+		FROM mentions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m Mention
+	err := row.Scan(
+		&m.ID,
+		&m.MentionedUserID,
+		&m.AuthorID,
+		&m.PostID,
+		&m.CommentID,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *MentionDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Mention, error) {
+	query := `
+		SELECT id, mentioned_user_id, author_id, post_id, comment_id, created_at
+		FROM mentions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Mention
+	for rows.Next() {
+		var m Mention
+		err := rows.Scan(
+			&m.ID,
+			&m.MentionedUserID,
+			&m.AuthorID,
+			&m.PostID,
+			&m.CommentID,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *MentionDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Mention, error) {
+	query := `
+		SELECT id, mentioned_user_id, author_id, post_id, comment_id, created_at
+		FROM mentions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Mention
+	for rows.Next() {
+		var m Mention
+		err := rows.Scan(
+			&m.ID,
+			&m.MentionedUserID,
+			&m.AuthorID,
+			&m.PostID,
+			&m.CommentID,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *MentionDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM mentions"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *MentionDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
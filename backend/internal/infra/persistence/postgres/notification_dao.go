@@ -0,0 +1,437 @@
+package postgres
+
+import (
+	"blog0/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type Notification = domain.Notification
+
+type NotificationDAO struct {
+	db *sql.DB
+}
+
+func NewNotificationDAO(db *sql.DB) *NotificationDAO {
+	return &NotificationDAO{db: db}
+}
+
+func (dao *NotificationDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *NotificationDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *NotificationDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *NotificationDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *NotificationDAO) Create(ctx context.Context, m *Notification) error {
+	query := `
+		INSERT INTO notifications (id, recipient_id, type, post_id, comment_id, report_id, group_key, actor_ids, read_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.RecipientID,
+		m.Type,
+		m.PostID,
+		m.CommentID,
+		m.ReportID,
+		m.GroupKey,
+		m.ActorIDs,
+		m.ReadAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+
+	return err
+}
+
+func (dao *NotificationDAO) Update(ctx context.Context, m *Notification) error {
+	query := `
+		UPDATE notifications
+		SET recipient_id = $1,
+			type = $2,
+			post_id = $3,
+			comment_id = $4,
+			report_id = $5,
+			group_key = $6,
+			actor_ids = $7,
+			read_at = $8,
+			created_at = $9,
+			updated_at = $10
+		WHERE id = $11
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.RecipientID,
+		m.Type,
+		m.PostID,
+		m.CommentID,
+		m.ReportID,
+		m.GroupKey,
+		m.ActorIDs,
+		m.ReadAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *NotificationDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE notifications SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *NotificationDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM notifications WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *NotificationDAO) FindByPk(ctx context.Context, pk string) (*Notification, error) {
+	query := `
+		SELECT id, recipient_id, type, post_id, comment_id, report_id, group_key, actor_ids, read_at, created_at, updated_at
+		FROM notifications
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m Notification
+	err := row.Scan(
+		&m.ID,
+		&m.RecipientID,
+		&m.Type,
+		&m.PostID,
+		&m.CommentID,
+		&m.ReportID,
+		&m.GroupKey,
+		&m.ActorIDs,
+		&m.ReadAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *NotificationDAO) CreateMany(ctx context.Context, models []*Notification) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*11)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*11+1, i*11+2, i*11+3, i*11+4, i*11+5, i*11+6, i*11+7, i*11+8, i*11+9, i*11+10, i*11+11)
+
+		args = append(args,
+			model.ID,
+			model.RecipientID,
+			model.Type,
+			model.PostID,
+			model.CommentID,
+			model.ReportID,
+			model.GroupKey,
+			model.ActorIDs,
+			model.ReadAt,
+			model.CreatedAt,
+			model.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO notifications (id, recipient_id, type, post_id, comment_id, report_id, group_key, actor_ids, read_at, created_at, updated_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *NotificationDAO) UpdateMany(ctx context.Context, models []*Notification) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE notifications
+		SET recipient_id = $1,
+			type = $2,
+			post_id = $3,
+			comment_id = $4,
+			report_id = $5,
+			group_key = $6,
+			actor_ids = $7,
+			read_at = $8,
+			created_at = $9,
+			updated_at = $10
+		WHERE id = $11
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.RecipientID,
+			model.Type,
+			model.PostID,
+			model.CommentID,
+			model.ReportID,
+			model.GroupKey,
+			model.ActorIDs,
+			model.ReadAt,
+			model.CreatedAt,
+			model.UpdatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *NotificationDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM notifications WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *NotificationDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Notification, error) {
+	query := `
+		SELECT id, recipient_id, type, post_id, comment_id, report_id, group_key, actor_ids, read_at, created_at, updated_at
+		FROM notifications
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m Notification
+	err := row.Scan(
+		&m.ID,
+		&m.RecipientID,
+		&m.Type,
+		&m.PostID,
+		&m.CommentID,
+		&m.ReportID,
+		&m.GroupKey,
+		&m.ActorIDs,
+		&m.ReadAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *NotificationDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Notification, error) {
+	query := `
+		SELECT id, recipient_id, type, post_id, comment_id, report_id, group_key, actor_ids, read_at, created_at, updated_at
+		FROM notifications
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Notification
+	for rows.Next() {
+		var m Notification
+		err := rows.Scan(
+			&m.ID,
+			&m.RecipientID,
+			&m.Type,
+			&m.PostID,
+			&m.CommentID,
+			&m.ReportID,
+			&m.GroupKey,
+			&m.ActorIDs,
+			&m.ReadAt,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *NotificationDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Notification, error) {
+	query := `
+		SELECT id, recipient_id, type, post_id, comment_id, report_id, group_key, actor_ids, read_at, created_at, updated_at
+		FROM notifications
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Notification
+	for rows.Next() {
+		var m Notification
+		err := rows.Scan(
+			&m.ID,
+			&m.RecipientID,
+			&m.Type,
+			&m.PostID,
+			&m.CommentID,
+			&m.ReportID,
+			&m.GroupKey,
+			&m.ActorIDs,
+			&m.ReadAt,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *NotificationDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM notifications"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *NotificationDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
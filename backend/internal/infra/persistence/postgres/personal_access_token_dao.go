@@ -0,0 +1,427 @@
+package postgres
+
+import (
+	"blog0/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type PersonalAccessToken = domain.PersonalAccessToken
+
+type PersonalAccessTokenDAO struct {
+	db *sql.DB
+}
+
+func NewPersonalAccessTokenDAO(db *sql.DB) *PersonalAccessTokenDAO {
+	return &PersonalAccessTokenDAO{db: db}
+}
+
+func (dao *PersonalAccessTokenDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *PersonalAccessTokenDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *PersonalAccessTokenDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *PersonalAccessTokenDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *PersonalAccessTokenDAO) Create(ctx context.Context, m *PersonalAccessToken) error {
+	query := `
+		INSERT INTO personal_access_tokens (id, user_id, name, token_hash, token_prefix, scopes, created_at, expires_at, last_used_at, revoked_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.UserID,
+		m.Name,
+		m.TokenHash,
+		m.TokenPrefix,
+		m.Scopes,
+		m.CreatedAt,
+		m.ExpiresAt,
+		m.LastUsedAt,
+		m.RevokedAt,
+	)
+
+	return err
+}
+
+func (dao *PersonalAccessTokenDAO) Update(ctx context.Context, m *PersonalAccessToken) error {
+	query := `
+		UPDATE personal_access_tokens
+		SET user_id = $1,
+			name = $2,
+			token_hash = $3,
+			token_prefix = $4,
+			scopes = $5,
+			created_at = $6,
+			expires_at = $7,
+			last_used_at = $8,
+			revoked_at = $9
+		WHERE id = $10
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.UserID,
+		m.Name,
+		m.TokenHash,
+		m.TokenPrefix,
+		m.Scopes,
+		m.CreatedAt,
+		m.ExpiresAt,
+		m.LastUsedAt,
+		m.RevokedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *PersonalAccessTokenDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE personal_access_tokens SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *PersonalAccessTokenDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM personal_access_tokens WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *PersonalAccessTokenDAO) FindByPk(ctx context.Context, pk string) (*PersonalAccessToken, error) {
+	query := `
+		SELECT id, user_id, name, token_hash, token_prefix, scopes, created_at, expires_at, last_used_at, revoked_at
+		FROM personal_access_tokens
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m PersonalAccessToken
+	err := row.Scan(
+		&m.ID,
+		&m.UserID,
+		&m.Name,
+		&m.TokenHash,
+		&m.TokenPrefix,
+		&m.Scopes,
+		&m.CreatedAt,
+		&m.ExpiresAt,
+		&m.LastUsedAt,
+		&m.RevokedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *PersonalAccessTokenDAO) CreateMany(ctx context.Context, models []*PersonalAccessToken) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*10)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*10+1, i*10+2, i*10+3, i*10+4, i*10+5, i*10+6, i*10+7, i*10+8, i*10+9, i*10+10)
+
+		args = append(args,
+			model.ID,
+			model.UserID,
+			model.Name,
+			model.TokenHash,
+			model.TokenPrefix,
+			model.Scopes,
+			model.CreatedAt,
+			model.ExpiresAt,
+			model.LastUsedAt,
+			model.RevokedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO personal_access_tokens (id, user_id, name, token_hash, token_prefix, scopes, created_at, expires_at, last_used_at, revoked_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *PersonalAccessTokenDAO) UpdateMany(ctx context.Context, models []*PersonalAccessToken) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE personal_access_tokens
+		SET user_id = $1,
+			name = $2,
+			token_hash = $3,
+			token_prefix = $4,
+			scopes = $5,
+			created_at = $6,
+			expires_at = $7,
+			last_used_at = $8,
+			revoked_at = $9
+		WHERE id = $10
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.UserID,
+			model.Name,
+			model.TokenHash,
+			model.TokenPrefix,
+			model.Scopes,
+			model.CreatedAt,
+			model.ExpiresAt,
+			model.LastUsedAt,
+			model.RevokedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *PersonalAccessTokenDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM personal_access_tokens WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *PersonalAccessTokenDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*PersonalAccessToken, error) {
+	query := `
+		SELECT id, user_id, name, token_hash, token_prefix, scopes, created_at, expires_at, last_used_at, revoked_at
+		FROM personal_access_tokens
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m PersonalAccessToken
+	err := row.Scan(
+		&m.ID,
+		&m.UserID,
+		&m.Name,
+		&m.TokenHash,
+		&m.TokenPrefix,
+		&m.Scopes,
+		&m.CreatedAt,
+		&m.ExpiresAt,
+		&m.LastUsedAt,
+		&m.RevokedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *PersonalAccessTokenDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*PersonalAccessToken, error) {
+	query := `
+		SELECT id, user_id, name, token_hash, token_prefix, scopes, created_at, expires_at, last_used_at, revoked_at
+		FROM personal_access_tokens
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*PersonalAccessToken
+	for rows.Next() {
+		var m PersonalAccessToken
+		err := rows.Scan(
+			&m.ID,
+			&m.UserID,
+			&m.Name,
+			&m.TokenHash,
+			&m.TokenPrefix,
+			&m.Scopes,
+			&m.CreatedAt,
+			&m.ExpiresAt,
+			&m.LastUsedAt,
+			&m.RevokedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *PersonalAccessTokenDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*PersonalAccessToken, error) {
+	query := `
+		SELECT id, user_id, name, token_hash, token_prefix, scopes, created_at, expires_at, last_used_at, revoked_at
+		FROM personal_access_tokens
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*PersonalAccessToken
+	for rows.Next() {
+		var m PersonalAccessToken
+		err := rows.Scan(
+			&m.ID,
+			&m.UserID,
+			&m.Name,
+			&m.TokenHash,
+			&m.TokenPrefix,
+			&m.Scopes,
+			&m.CreatedAt,
+			&m.ExpiresAt,
+			&m.LastUsedAt,
+			&m.RevokedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *PersonalAccessTokenDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM personal_access_tokens"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *PersonalAccessTokenDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -48,8 +48,8 @@ func (dao *PostDAO) queryContext(ctx context.Context, query string, args ...inte
 
 func (dao *PostDAO) Create(ctx context.Context, m *Post) error {
 	query := `
-		INSERT INTO posts (id, author_id, title, slug, raw_markdown, summary, tags, published_at, created_at, updated_at, raw_markdown_audio_url, summary_audio_url)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		INSERT INTO posts (id, author_id, title, slug, raw_markdown, summary, tags, published_at, hidden_at, created_at, updated_at, raw_markdown_audio_url, summary_audio_url)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 	`
 
 	_, err := dao.execContext(
@@ -63,6 +63,7 @@ func (dao *PostDAO) Create(ctx context.Context, m *Post) error {
 		m.Summary,
 		m.Tags,
 		m.PublishedAt,
+		m.HiddenAt,
 		m.CreatedAt,
 		m.UpdatedAt,
 		m.RawMarkdownAudioURL,
@@ -82,11 +83,12 @@ func (dao *PostDAO) Update(ctx context.Context, m *Post) error {
 			summary = $5,
 			tags = $6,
 			published_at = $7,
-			created_at = $8,
-			updated_at = $9,
-			raw_markdown_audio_url = $10,
-			summary_audio_url = $11
-		WHERE id = $12
+			hidden_at = $8,
+			created_at = $9,
+			updated_at = $10,
+			raw_markdown_audio_url = $11,
+			summary_audio_url = $12
+		WHERE id = $13
 	`
 
 	_, err := dao.execContext(ctx, query,
@@ -97,6 +99,7 @@ func (dao *PostDAO) Update(ctx context.Context, m *Post) error {
 		m.Summary,
 		m.Tags,
 		m.PublishedAt,
+		m.HiddenAt,
 		m.CreatedAt,
 		m.UpdatedAt,
 		m.RawMarkdownAudioURL,
@@ -137,7 +140,7 @@ func (dao *PostDAO) DeleteByPk(ctx context.Context, pk string) error {
 
 func (dao *PostDAO) FindByPk(ctx context.Context, pk string) (*Post, error) {
 	query := `
-		SELECT id, author_id, title, slug, raw_markdown, summary, tags, published_at, created_at, updated_at, raw_markdown_audio_url, summary_audio_url
+		SELECT id, author_id, title, slug, raw_markdown, summary, tags, published_at, hidden_at, created_at, updated_at, raw_markdown_audio_url, summary_audio_url
 		FROM posts
 		WHERE id = $1
 	`
@@ -153,6 +156,7 @@ func (dao *PostDAO) FindByPk(ctx context.Context, pk string) (*Post, error) {
 		&m.Summary,
 		&m.Tags,
 		&m.PublishedAt,
+		&m.HiddenAt,
 		&m.CreatedAt,
 		&m.UpdatedAt,
 		&m.RawMarkdownAudioURL,
@@ -172,11 +176,11 @@ func (dao *PostDAO) CreateMany(ctx context.Context, models []*Post) error {
 	}
 
 	placeholders := make([]string, len(models))
-	args := make([]interface{}, 0, len(models)*12)
+	args := make([]interface{}, 0, len(models)*13)
 
 	for i, model := range models {
-		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
-			i*12+1, i*12+2, i*12+3, i*12+4, i*12+5, i*12+6, i*12+7, i*12+8, i*12+9, i*12+10, i*12+11, i*12+12)
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*13+1, i*13+2, i*13+3, i*13+4, i*13+5, i*13+6, i*13+7, i*13+8, i*13+9, i*13+10, i*13+11, i*13+12, i*13+13)
 
 		args = append(args,
 			model.ID,
@@ -187,6 +191,7 @@ func (dao *PostDAO) CreateMany(ctx context.Context, models []*Post) error {
 			model.Summary,
 			model.Tags,
 			model.PublishedAt,
+			model.HiddenAt,
 			model.CreatedAt,
 			model.UpdatedAt,
 			model.RawMarkdownAudioURL,
@@ -195,7 +200,7 @@ func (dao *PostDAO) CreateMany(ctx context.Context, models []*Post) error {
 	}
 
 	query := fmt.Sprintf(`
-		INSERT INTO posts (id, author_id, title, slug, raw_markdown, summary, tags, published_at, created_at, updated_at, raw_markdown_audio_url, summary_audio_url)
+		INSERT INTO posts (id, author_id, title, slug, raw_markdown, summary, tags, published_at, hidden_at, created_at, updated_at, raw_markdown_audio_url, summary_audio_url)
 		VALUES %s
 	`, strings.Join(placeholders, ", "))
 
@@ -217,11 +222,12 @@ func (dao *PostDAO) UpdateMany(ctx context.Context, models []*Post) error {
 			summary = $5,
 			tags = $6,
 			published_at = $7,
-			created_at = $8,
-			updated_at = $9,
-			raw_markdown_audio_url = $10,
-			summary_audio_url = $11
-		WHERE id = $12
+			hidden_at = $8,
+			created_at = $9,
+			updated_at = $10,
+			raw_markdown_audio_url = $11,
+			summary_audio_url = $12
+		WHERE id = $13
 	`
 
 	for _, model := range models {
@@ -233,6 +239,7 @@ func (dao *PostDAO) UpdateMany(ctx context.Context, models []*Post) error {
 			model.Summary,
 			model.Tags,
 			model.PublishedAt,
+			model.HiddenAt,
 			model.CreatedAt,
 			model.UpdatedAt,
 			model.RawMarkdownAudioURL,
@@ -266,7 +273,7 @@ func (dao *PostDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
 
 func (dao *PostDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Post, error) {
 	query := `
-		SELECT id, author_id, title, slug, raw_markdown, summary, tags, published_at, created_at, updated_at, raw_markdown_audio_url, summary_audio_url
+		SELECT id, author_id, title, slug, raw_markdown, summary, tags, published_at, hidden_at, created_at, updated_at, raw_markdown_audio_url, summary_audio_url
 		FROM posts
 	`
 
@@ -290,6 +297,7 @@ func (dao *PostDAO) FindOne(ctx context.Context, where string, sort string, args
 		&m.Summary,
 		&m.Tags,
 		&m.PublishedAt,
+		&m.HiddenAt,
 		&m.CreatedAt,
 		&m.UpdatedAt,
 		&m.RawMarkdownAudioURL,
@@ -305,7 +313,7 @@ func (dao *PostDAO) FindOne(ctx context.Context, where string, sort string, args
 
 func (dao *PostDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Post, error) {
 	query := `
-		SELECT id, author_id, title, slug, raw_markdown, summary, tags, published_at, created_at, updated_at, raw_markdown_audio_url, summary_audio_url
+		SELECT id, author_id, title, slug, raw_markdown, summary, tags, published_at, hidden_at, created_at, updated_at, raw_markdown_audio_url, summary_audio_url
 		FROM posts
 	`
 
@@ -335,6 +343,7 @@ func (dao *PostDAO) FindAll(ctx context.Context, where string, sort string, args
 			&m.Summary,
 			&m.Tags,
 			&m.PublishedAt,
+			&m.HiddenAt,
 			&m.CreatedAt,
 			&m.UpdatedAt,
 			&m.RawMarkdownAudioURL,
@@ -355,7 +364,7 @@ func (dao *PostDAO) FindAll(ctx context.Context, where string, sort string, args
 
 func (dao *PostDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Post, error) {
 	query := `
-		SELECT id, author_id, title, slug, raw_markdown, summary, tags, published_at, created_at, updated_at, raw_markdown_audio_url, summary_audio_url
+		SELECT id, author_id, title, slug, raw_markdown, summary, tags, published_at, hidden_at, created_at, updated_at, raw_markdown_audio_url, summary_audio_url
 		FROM posts
 	`
 
@@ -387,6 +396,7 @@ func (dao *PostDAO) FindPaginated(ctx context.Context, limit, offset int, where
 			&m.Summary,
 			&m.Tags,
 			&m.PublishedAt,
+			&m.HiddenAt,
 			&m.CreatedAt,
 			&m.UpdatedAt,
 			&m.RawMarkdownAudioURL,
@@ -0,0 +1,407 @@
+package postgres
+
+import (
+	"blog0/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type ProcessorAuditLog = domain.ProcessorAuditLog
+
+type ProcessorAuditLogDAO struct {
+	db *sql.DB
+}
+
+func NewProcessorAuditLogDAO(db *sql.DB) *ProcessorAuditLogDAO {
+	return &ProcessorAuditLogDAO{db: db}
+}
+
+func (dao *ProcessorAuditLogDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *ProcessorAuditLogDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *ProcessorAuditLogDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *ProcessorAuditLogDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *ProcessorAuditLogDAO) Create(ctx context.Context, m *ProcessorAuditLog) error {
+	query := `
+		INSERT INTO processor_audit_logs (id, client_id, op, author_id, post_id, succeeded, error, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.ClientID,
+		m.Op,
+		m.AuthorID,
+		m.PostID,
+		m.Succeeded,
+		m.Error,
+		m.CreatedAt,
+	)
+
+	return err
+}
+
+func (dao *ProcessorAuditLogDAO) Update(ctx context.Context, m *ProcessorAuditLog) error {
+	query := `
+		UPDATE processor_audit_logs
+		SET client_id = $1,
+			op = $2,
+			author_id = $3,
+			post_id = $4,
+			succeeded = $5,
+			error = $6,
+			created_at = $7
+		WHERE id = $8
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.ClientID,
+		m.Op,
+		m.AuthorID,
+		m.PostID,
+		m.Succeeded,
+		m.Error,
+		m.CreatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *ProcessorAuditLogDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE processor_audit_logs SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *ProcessorAuditLogDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM processor_audit_logs WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *ProcessorAuditLogDAO) FindByPk(ctx context.Context, pk string) (*ProcessorAuditLog, error) {
+	query := `
+		SELECT id, client_id, op, author_id, post_id, succeeded, error, created_at
+		FROM processor_audit_logs
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m ProcessorAuditLog
+	err := row.Scan(
+		&m.ID,
+		&m.ClientID,
+		&m.Op,
+		&m.AuthorID,
+		&m.PostID,
+		&m.Succeeded,
+		&m.Error,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *ProcessorAuditLogDAO) CreateMany(ctx context.Context, models []*ProcessorAuditLog) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*8)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*8+1, i*8+2, i*8+3, i*8+4, i*8+5, i*8+6, i*8+7, i*8+8)
+
+		args = append(args,
+			model.ID,
+			model.ClientID,
+			model.Op,
+			model.AuthorID,
+			model.PostID,
+			model.Succeeded,
+			model.Error,
+			model.CreatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO processor_audit_logs (id, client_id, op, author_id, post_id, succeeded, error, created_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *ProcessorAuditLogDAO) UpdateMany(ctx context.Context, models []*ProcessorAuditLog) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE processor_audit_logs
+		SET client_id = $1,
+			op = $2,
+			author_id = $3,
+			post_id = $4,
+			succeeded = $5,
+			error = $6,
+			created_at = $7
+		WHERE id = $8
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.ClientID,
+			model.Op,
+			model.AuthorID,
+			model.PostID,
+			model.Succeeded,
+			model.Error,
+			model.CreatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *ProcessorAuditLogDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM processor_audit_logs WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *ProcessorAuditLogDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*ProcessorAuditLog, error) {
+	query := `
+		SELECT id, client_id, op, author_id, post_id, succeeded, error, created_at
+		FROM processor_audit_logs
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m ProcessorAuditLog
+	err := row.Scan(
+		&m.ID,
+		&m.ClientID,
+		&m.Op,
+		&m.AuthorID,
+		&m.PostID,
+		&m.Succeeded,
+		&m.Error,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *ProcessorAuditLogDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*ProcessorAuditLog, error) {
+	query := `
+		SELECT id, client_id, op, author_id, post_id, succeeded, error, created_at
+		FROM processor_audit_logs
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*ProcessorAuditLog
+	for rows.Next() {
+		var m ProcessorAuditLog
+		err := rows.Scan(
+			&m.ID,
+			&m.ClientID,
+			&m.Op,
+			&m.AuthorID,
+			&m.PostID,
+			&m.Succeeded,
+			&m.Error,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *ProcessorAuditLogDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*ProcessorAuditLog, error) {
+	query := `
+		SELECT id, client_id, op, author_id, post_id, succeeded, error, created_at
+		FROM processor_audit_logs
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*ProcessorAuditLog
+	for rows.Next() {
+		var m ProcessorAuditLog
+		err := rows.Scan(
+			&m.ID,
+			&m.ClientID,
+			&m.Op,
+			&m.AuthorID,
+			&m.PostID,
+			&m.Succeeded,
+			&m.Error,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *ProcessorAuditLogDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM processor_audit_logs"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *ProcessorAuditLogDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
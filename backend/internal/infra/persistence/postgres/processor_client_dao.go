@@ -0,0 +1,417 @@
+package postgres
+
+import (
+	"blog0/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type ProcessorClient = domain.ProcessorClient
+
+type ProcessorClientDAO struct {
+	db *sql.DB
+}
+
+func NewProcessorClientDAO(db *sql.DB) *ProcessorClientDAO {
+	return &ProcessorClientDAO{db: db}
+}
+
+func (dao *ProcessorClientDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *ProcessorClientDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *ProcessorClientDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *ProcessorClientDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *ProcessorClientDAO) Create(ctx context.Context, m *ProcessorClient) error {
+	query := `
+		INSERT INTO processor_clients (id, name, secret_hash, allowed_author_ids, allowed_ops, created_by, created_at, last_used_at, disabled_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.Name,
+		m.SecretHash,
+		m.AllowedAuthorIDs,
+		m.AllowedOps,
+		m.CreatedBy,
+		m.CreatedAt,
+		m.LastUsedAt,
+		m.DisabledAt,
+	)
+
+	return err
+}
+
+func (dao *ProcessorClientDAO) Update(ctx context.Context, m *ProcessorClient) error {
+	query := `
+		UPDATE processor_clients
+		SET name = $1,
+			secret_hash = $2,
+			allowed_author_ids = $3,
+			allowed_ops = $4,
+			created_by = $5,
+			created_at = $6,
+			last_used_at = $7,
+			disabled_at = $8
+		WHERE id = $9
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.Name,
+		m.SecretHash,
+		m.AllowedAuthorIDs,
+		m.AllowedOps,
+		m.CreatedBy,
+		m.CreatedAt,
+		m.LastUsedAt,
+		m.DisabledAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *ProcessorClientDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE processor_clients SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *ProcessorClientDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM processor_clients WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *ProcessorClientDAO) FindByPk(ctx context.Context, pk string) (*ProcessorClient, error) {
+	query := `
+		SELECT id, name, secret_hash, allowed_author_ids, allowed_ops, created_by, created_at, last_used_at, disabled_at
+		FROM processor_clients
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m ProcessorClient
+	err := row.Scan(
+		&m.ID,
+		&m.Name,
+		&m.SecretHash,
+		&m.AllowedAuthorIDs,
+		&m.AllowedOps,
+		&m.CreatedBy,
+		&m.CreatedAt,
+		&m.LastUsedAt,
+		&m.DisabledAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *ProcessorClientDAO) CreateMany(ctx context.Context, models []*ProcessorClient) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*9)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*9+1, i*9+2, i*9+3, i*9+4, i*9+5, i*9+6, i*9+7, i*9+8, i*9+9)
+
+		args = append(args,
+			model.ID,
+			model.Name,
+			model.SecretHash,
+			model.AllowedAuthorIDs,
+			model.AllowedOps,
+			model.CreatedBy,
+			model.CreatedAt,
+			model.LastUsedAt,
+			model.DisabledAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO processor_clients (id, name, secret_hash, allowed_author_ids, allowed_ops, created_by, created_at, last_used_at, disabled_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *ProcessorClientDAO) UpdateMany(ctx context.Context, models []*ProcessorClient) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE processor_clients
+		SET name = $1,
+			secret_hash = $2,
+			allowed_author_ids = $3,
+			allowed_ops = $4,
+			created_by = $5,
+			created_at = $6,
+			last_used_at = $7,
+			disabled_at = $8
+		WHERE id = $9
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.Name,
+			model.SecretHash,
+			model.AllowedAuthorIDs,
+			model.AllowedOps,
+			model.CreatedBy,
+			model.CreatedAt,
+			model.LastUsedAt,
+			model.DisabledAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *ProcessorClientDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM processor_clients WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *ProcessorClientDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*ProcessorClient, error) {
+	query := `
+		SELECT id, name, secret_hash, allowed_author_ids, allowed_ops, created_by, created_at, last_used_at, disabled_at
+		FROM processor_clients
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m ProcessorClient
+	err := row.Scan(
+		&m.ID,
+		&m.Name,
+		&m.SecretHash,
+		&m.AllowedAuthorIDs,
+		&m.AllowedOps,
+		&m.CreatedBy,
+		&m.CreatedAt,
+		&m.LastUsedAt,
+		&m.DisabledAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *ProcessorClientDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*ProcessorClient, error) {
+	query := `
+		SELECT id, name, secret_hash, allowed_author_ids, allowed_ops, created_by, created_at, last_used_at, disabled_at
+		FROM processor_clients
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*ProcessorClient
+	for rows.Next() {
+		var m ProcessorClient
+		err := rows.Scan(
+			&m.ID,
+			&m.Name,
+			&m.SecretHash,
+			&m.AllowedAuthorIDs,
+			&m.AllowedOps,
+			&m.CreatedBy,
+			&m.CreatedAt,
+			&m.LastUsedAt,
+			&m.DisabledAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *ProcessorClientDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*ProcessorClient, error) {
+	query := `
+		SELECT id, name, secret_hash, allowed_author_ids, allowed_ops, created_by, created_at, last_used_at, disabled_at
+		FROM processor_clients
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*ProcessorClient
+	for rows.Next() {
+		var m ProcessorClient
+		err := rows.Scan(
+			&m.ID,
+			&m.Name,
+			&m.SecretHash,
+			&m.AllowedAuthorIDs,
+			&m.AllowedOps,
+			&m.CreatedBy,
+			&m.CreatedAt,
+			&m.LastUsedAt,
+			&m.DisabledAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *ProcessorClientDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM processor_clients"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *ProcessorClientDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
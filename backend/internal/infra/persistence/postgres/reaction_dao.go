@@ -8,48 +8,48 @@ import (
 	"strings"
 )
 
-type PostLike = domain.PostLike
+type Reaction = domain.Reaction
 
-type PostLikeDAO struct {
+type ReactionDAO struct {
 	db *sql.DB
 }
 
-func NewPostLikeDAO(db *sql.DB) *PostLikeDAO {
-	return &PostLikeDAO{db: db}
+func NewReactionDAO(db *sql.DB) *ReactionDAO {
+	return &ReactionDAO{db: db}
 }
 
-func (dao *PostLikeDAO) getTx(ctx context.Context) *sql.Tx {
+func (dao *ReactionDAO) getTx(ctx context.Context) *sql.Tx {
 	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
 		return tx
 	}
 	return nil
 }
 
-func (dao *PostLikeDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+func (dao *ReactionDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
 	if tx := dao.getTx(ctx); tx != nil {
 		return tx.ExecContext(ctx, query, args...)
 	}
 	return dao.db.ExecContext(ctx, query, args...)
 }
 
-func (dao *PostLikeDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+func (dao *ReactionDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
 	if tx := dao.getTx(ctx); tx != nil {
 		return tx.QueryRowContext(ctx, query, args...)
 	}
 	return dao.db.QueryRowContext(ctx, query, args...)
 }
 
-func (dao *PostLikeDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+func (dao *ReactionDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
 	if tx := dao.getTx(ctx); tx != nil {
 		return tx.QueryContext(ctx, query, args...)
 	}
 	return dao.db.QueryContext(ctx, query, args...)
 }
 
-func (dao *PostLikeDAO) Create(ctx context.Context, m *PostLike) error {
+func (dao *ReactionDAO) Create(ctx context.Context, m *Reaction) error {
 	query := `
-		INSERT INTO post_likes (id, user_id, post_id, created_at)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO reactions (id, user_id, post_id, comment_id, emoji, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
 	`
 
 	_, err := dao.execContext(
@@ -58,31 +58,37 @@ func (dao *PostLikeDAO) Create(ctx context.Context, m *PostLike) error {
 		m.ID,
 		m.UserID,
 		m.PostID,
+		m.CommentID,
+		m.Emoji,
 		m.CreatedAt,
 	)
 
 	return err
 }
 
-func (dao *PostLikeDAO) Update(ctx context.Context, m *PostLike) error {
+func (dao *ReactionDAO) Update(ctx context.Context, m *Reaction) error {
 	query := `
-		UPDATE post_likes
+		UPDATE reactions
 		SET user_id = $1,
 			post_id = $2,
-			created_at = $3
-		WHERE id = $4
+			comment_id = $3,
+			emoji = $4,
+			created_at = $5
+		WHERE id = $6
 	`
 
 	_, err := dao.execContext(ctx, query,
 		m.UserID,
 		m.PostID,
+		m.CommentID,
+		m.Emoji,
 		m.CreatedAt,
 		m.ID,
 	)
 	return err
 }
 
-func (dao *PostLikeDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+func (dao *ReactionDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
 	if len(fields) == 0 {
 		return nil
 	}
@@ -99,31 +105,33 @@ func (dao *PostLikeDAO) PartialUpdate(ctx context.Context, pk string, fields map
 
 	args = append(args, pk)
 
-	query := fmt.Sprintf(`UPDATE post_likes SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+	query := fmt.Sprintf(`UPDATE reactions SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
 
 	_, err := dao.execContext(ctx, query, args...)
 	return err
 }
 
-func (dao *PostLikeDAO) DeleteByPk(ctx context.Context, pk string) error {
-	query := `DELETE FROM post_likes WHERE id = $1`
+func (dao *ReactionDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM reactions WHERE id = $1`
 	_, err := dao.execContext(ctx, query, pk)
 	return err
 }
 
-func (dao *PostLikeDAO) FindByPk(ctx context.Context, pk string) (*PostLike, error) {
+func (dao *ReactionDAO) FindByPk(ctx context.Context, pk string) (*Reaction, error) {
 	query := `
-		SELECT id, user_id, post_id, created_at
-		FROM post_likes
+		SELECT id, user_id, post_id, comment_id, emoji, created_at
+		FROM reactions
 		WHERE id = $1
 	`
 	row := dao.queryRowContext(ctx, query, pk)
 
-	var m PostLike
+	var m Reaction
 	err := row.Scan(
 		&m.ID,
 		&m.UserID,
 		&m.PostID,
+		&m.CommentID,
+		&m.Emoji,
 		&m.CreatedAt,
 	)
 
@@ -134,28 +142,30 @@ func (dao *PostLikeDAO) FindByPk(ctx context.Context, pk string) (*PostLike, err
 	return &m, nil
 }
 
-func (dao *PostLikeDAO) CreateMany(ctx context.Context, models []*PostLike) error {
+func (dao *ReactionDAO) CreateMany(ctx context.Context, models []*Reaction) error {
 	if len(models) == 0 {
 		return nil
 	}
 
 	placeholders := make([]string, len(models))
-	args := make([]interface{}, 0, len(models)*4)
+	args := make([]interface{}, 0, len(models)*6)
 
 	for i, model := range models {
-		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d)",
-			i*4+1, i*4+2, i*4+3, i*4+4)
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)",
+			i*6+1, i*6+2, i*6+3, i*6+4, i*6+5, i*6+6)
 
 		args = append(args,
 			model.ID,
 			model.UserID,
 			model.PostID,
+			model.CommentID,
+			model.Emoji,
 			model.CreatedAt,
 		)
 	}
 
 	query := fmt.Sprintf(`
-		INSERT INTO post_likes (id, user_id, post_id, created_at)
+		INSERT INTO reactions (id, user_id, post_id, comment_id, emoji, created_at)
 		VALUES %s
 	`, strings.Join(placeholders, ", "))
 
@@ -163,23 +173,27 @@ func (dao *PostLikeDAO) CreateMany(ctx context.Context, models []*PostLike) erro
 	return err
 }
 
-func (dao *PostLikeDAO) UpdateMany(ctx context.Context, models []*PostLike) error {
+func (dao *ReactionDAO) UpdateMany(ctx context.Context, models []*Reaction) error {
 	if len(models) == 0 {
 		return nil
 	}
 
 	query := `
-		UPDATE post_likes
+		UPDATE reactions
 		SET user_id = $1,
 			post_id = $2,
-			created_at = $3
-		WHERE id = $4
+			comment_id = $3,
+			emoji = $4,
+			created_at = $5
+		WHERE id = $6
 	`
 
 	for _, model := range models {
 		_, err := dao.execContext(ctx, query,
 			model.UserID,
 			model.PostID,
+			model.CommentID,
+			model.Emoji,
 			model.CreatedAt,
 			model.ID,
 		)
@@ -191,7 +205,7 @@ func (dao *PostLikeDAO) UpdateMany(ctx context.Context, models []*PostLike) erro
 	return nil
 }
 
-func (dao *PostLikeDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+func (dao *ReactionDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
 	if len(pks) == 0 {
 		return nil
 	}
@@ -203,15 +217,15 @@ func (dao *PostLikeDAO) DeleteManyByPks(ctx context.Context, pks []string) error
 		args[i] = pk
 	}
 
-	query := fmt.Sprintf(`DELETE FROM post_likes WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	query := fmt.Sprintf(`DELETE FROM reactions WHERE id IN (%s)`, strings.Join(placeholders, ","))
 	_, err := dao.execContext(ctx, query, args...)
 	return err
 }
 
-func (dao *PostLikeDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*PostLike, error) {
+func (dao *ReactionDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Reaction, error) {
 	query := `
-		SELECT id, user_id, post_id, created_at
-		FROM post_likes
+		SELECT id, user_id, post_id, comment_id, emoji, created_at
+		FROM reactions
 	`
 
 	if where != "" {
@@ -224,11 +238,13 @@ func (dao *PostLikeDAO) FindOne(ctx context.Context, where string, sort string,
 
 	row := dao.queryRowContext(ctx, query, args...)
 
-	var m PostLike
+	var m Reaction
 	err := row.Scan(
 		&m.ID,
 		&m.UserID,
 		&m.PostID,
+		&m.CommentID,
+		&m.Emoji,
 		&m.CreatedAt,
 	)
 
@@ -239,10 +255,10 @@ func (dao *PostLikeDAO) FindOne(ctx context.Context, where string, sort string,
 	return &m, nil
 }
 
-func (dao *PostLikeDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*PostLike, error) {
+func (dao *ReactionDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Reaction, error) {
 	query := `
-		SELECT id, user_id, post_id, created_at
-		FROM post_likes
+		SELECT id, user_id, post_id, comment_id, emoji, created_at
+		FROM reactions
 	`
 
 	if where != "" {
@@ -259,13 +275,15 @@ func (dao *PostLikeDAO) FindAll(ctx context.Context, where string, sort string,
 	}
 	defer rows.Close()
 
-	var models []*PostLike
+	var models []*Reaction
 	for rows.Next() {
-		var m PostLike
+		var m Reaction
 		err := rows.Scan(
 			&m.ID,
 			&m.UserID,
 			&m.PostID,
+			&m.CommentID,
+			&m.Emoji,
 			&m.CreatedAt,
 		)
 		if err != nil {
@@ -281,10 +299,10 @@ func (dao *PostLikeDAO) FindAll(ctx context.Context, where string, sort string,
 	return models, nil
 }
 
-func (dao *PostLikeDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*PostLike, error) {
+func (dao *ReactionDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Reaction, error) {
 	query := `
-		SELECT id, user_id, post_id, created_at
-		FROM post_likes
+		SELECT id, user_id, post_id, comment_id, emoji, created_at
+		FROM reactions
 	`
 
 	if where != "" {
@@ -303,13 +321,15 @@ func (dao *PostLikeDAO) FindPaginated(ctx context.Context, limit, offset int, wh
 	}
 	defer rows.Close()
 
-	var models []*PostLike
+	var models []*Reaction
 	for rows.Next() {
-		var m PostLike
+		var m Reaction
 		err := rows.Scan(
 			&m.ID,
 			&m.UserID,
 			&m.PostID,
+			&m.CommentID,
+			&m.Emoji,
 			&m.CreatedAt,
 		)
 		if err != nil {
@@ -325,8 +345,8 @@ func (dao *PostLikeDAO) FindPaginated(ctx context.Context, limit, offset int, wh
 	return models, nil
 }
 
-func (dao *PostLikeDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
-	query := "SELECT COUNT(*) FROM post_likes"
+func (dao *ReactionDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM reactions"
 
 	if where != "" {
 		query += " WHERE " + where
@@ -343,7 +363,7 @@ func (dao *PostLikeDAO) Count(ctx context.Context, where string, args ...interfa
 	return count, nil
 }
 
-func (dao *PostLikeDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+func (dao *ReactionDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
 	tx, err := dao.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
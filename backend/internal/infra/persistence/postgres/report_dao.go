@@ -0,0 +1,457 @@
+package postgres
+
+import (
+	"blog0/internal/domain"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+type Report = domain.Report
+
+type ReportDAO struct {
+	db *sql.DB
+}
+
+func NewReportDAO(db *sql.DB) *ReportDAO {
+	return &ReportDAO{db: db}
+}
+
+func (dao *ReportDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *ReportDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *ReportDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *ReportDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *ReportDAO) Create(ctx context.Context, m *Report) error {
+	query := `
+		INSERT INTO reports (id, reporter_id, target_type, post_id, comment_id, reported_user_id, reason, details, status, resolution, resolved_by, resolved_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.ReporterID,
+		m.TargetType,
+		m.PostID,
+		m.CommentID,
+		m.ReportedUserID,
+		m.Reason,
+		m.Details,
+		m.Status,
+		m.Resolution,
+		m.ResolvedBy,
+		m.ResolvedAt,
+		m.CreatedAt,
+	)
+
+	return err
+}
+
+func (dao *ReportDAO) Update(ctx context.Context, m *Report) error {
+	query := `
+		UPDATE reports
+		SET reporter_id = $1,
+			target_type = $2,
+			post_id = $3,
+			comment_id = $4,
+			reported_user_id = $5,
+			reason = $6,
+			details = $7,
+			status = $8,
+			resolution = $9,
+			resolved_by = $10,
+			resolved_at = $11,
+			created_at = $12
+		WHERE id = $13
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.ReporterID,
+		m.TargetType,
+		m.PostID,
+		m.CommentID,
+		m.ReportedUserID,
+		m.Reason,
+		m.Details,
+		m.Status,
+		m.Resolution,
+		m.ResolvedBy,
+		m.ResolvedAt,
+		m.CreatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *ReportDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE reports SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *ReportDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM reports WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *ReportDAO) FindByPk(ctx context.Context, pk string) (*Report, error) {
+	query := `
+		SELECT id, reporter_id, target_type, post_id, comment_id, reported_user_id, reason, details, status, resolution, resolved_by, resolved_at, created_at
+		FROM reports
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m Report
+	err := row.Scan(
+		&m.ID,
+		&m.ReporterID,
+		&m.TargetType,
+		&m.PostID,
+		&m.CommentID,
+		&m.ReportedUserID,
+		&m.Reason,
+		&m.Details,
+		&m.Status,
+		&m.Resolution,
+		&m.ResolvedBy,
+		&m.ResolvedAt,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *ReportDAO) CreateMany(ctx context.Context, models []*Report) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*13)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*13+1, i*13+2, i*13+3, i*13+4, i*13+5, i*13+6, i*13+7, i*13+8, i*13+9, i*13+10, i*13+11, i*13+12, i*13+13)
+
+		args = append(args,
+			model.ID,
+			model.ReporterID,
+			model.TargetType,
+			model.PostID,
+			model.CommentID,
+			model.ReportedUserID,
+			model.Reason,
+			model.Details,
+			model.Status,
+			model.Resolution,
+			model.ResolvedBy,
+			model.ResolvedAt,
+			model.CreatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO reports (id, reporter_id, target_type, post_id, comment_id, reported_user_id, reason, details, status, resolution, resolved_by, resolved_at, created_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *ReportDAO) UpdateMany(ctx context.Context, models []*Report) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE reports
+		SET reporter_id = $1,
+			target_type = $2,
+			post_id = $3,
+			comment_id = $4,
+			reported_user_id = $5,
+			reason = $6,
+			details = $7,
+			status = $8,
+			resolution = $9,
+			resolved_by = $10,
+			resolved_at = $11,
+			created_at = $12
+		WHERE id = $13
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.ReporterID,
+			model.TargetType,
+			model.PostID,
+			model.CommentID,
+			model.ReportedUserID,
+			model.Reason,
+			model.Details,
+			model.Status,
+			model.Resolution,
+			model.ResolvedBy,
+			model.ResolvedAt,
+			model.CreatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *ReportDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM reports WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *ReportDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Report, error) {
+	query := `
+		SELECT id, reporter_id, target_type, post_id, comment_id, reported_user_id, reason, details, status, resolution, resolved_by, resolved_at, created_at
+		FROM reports
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m Report
+	err := row.Scan(
+		&m.ID,
+		&m.ReporterID,
+		&m.TargetType,
+		&m.PostID,
+		&m.CommentID,
+		&m.ReportedUserID,
+		&m.Reason,
+		&m.Details,
+		&m.Status,
+		&m.Resolution,
+		&m.ResolvedBy,
+		&m.ResolvedAt,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *ReportDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Report, error) {
+	query := `
+		SELECT id, reporter_id, target_type, post_id, comment_id, reported_user_id, reason, details, status, resolution, resolved_by, resolved_at, created_at
+		FROM reports
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Report
+	for rows.Next() {
+		var m Report
+		err := rows.Scan(
+			&m.ID,
+			&m.ReporterID,
+			&m.TargetType,
+			&m.PostID,
+			&m.CommentID,
+			&m.ReportedUserID,
+			&m.Reason,
+			&m.Details,
+			&m.Status,
+			&m.Resolution,
+			&m.ResolvedBy,
+			&m.ResolvedAt,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *ReportDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Report, error) {
+	query := `
+		SELECT id, reporter_id, target_type, post_id, comment_id, reported_user_id, reason, details, status, resolution, resolved_by, resolved_at, created_at
+		FROM reports
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Report
+	for rows.Next() {
+		var m Report
+		err := rows.Scan(
+			&m.ID,
+			&m.ReporterID,
+			&m.TargetType,
+			&m.PostID,
+			&m.CommentID,
+			&m.ReportedUserID,
+			&m.Reason,
+			&m.Details,
+			&m.Status,
+			&m.Resolution,
+			&m.ResolvedBy,
+			&m.ResolvedAt,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *ReportDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM reports"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *ReportDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -48,8 +48,8 @@ func (dao *UserDAO) queryContext(ctx context.Context, query string, args ...inte
 
 func (dao *UserDAO) Create(ctx context.Context, m *User) error {
 	query := `
-		INSERT INTO users (id, email, username)
-		VALUES ($1, $2, $3)
+		INSERT INTO users (id, email, username, role, suspended_at, handle, handle_changed_at, display_name, bio, avatar_url, website, location, social_links, deletion_scheduled_at, deleted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 	`
 
 	_, err := dao.execContext(
@@ -58,6 +58,18 @@ func (dao *UserDAO) Create(ctx context.Context, m *User) error {
 		m.ID,
 		m.Email,
 		m.Username,
+		m.Role,
+		m.SuspendedAt,
+		m.Handle,
+		m.HandleChangedAt,
+		m.DisplayName,
+		m.Bio,
+		m.AvatarURL,
+		m.Website,
+		m.Location,
+		m.SocialLinks,
+		m.DeletionScheduledAt,
+		m.DeletedAt,
 	)
 
 	return err
@@ -67,13 +79,37 @@ func (dao *UserDAO) Update(ctx context.Context, m *User) error {
 	query := `
 		UPDATE users
 		SET email = $1,
-			username = $2
-		WHERE id = $3
+			username = $2,
+			role = $3,
+			suspended_at = $4,
+			handle = $5,
+			handle_changed_at = $6,
+			display_name = $7,
+			bio = $8,
+			avatar_url = $9,
+			website = $10,
+			location = $11,
+			social_links = $12,
+			deletion_scheduled_at = $13,
+			deleted_at = $14
+		WHERE id = $15
 	`
 
 	_, err := dao.execContext(ctx, query,
 		m.Email,
 		m.Username,
+		m.Role,
+		m.SuspendedAt,
+		m.Handle,
+		m.HandleChangedAt,
+		m.DisplayName,
+		m.Bio,
+		m.AvatarURL,
+		m.Website,
+		m.Location,
+		m.SocialLinks,
+		m.DeletionScheduledAt,
+		m.DeletedAt,
 		m.ID,
 	)
 	return err
@@ -110,7 +146,7 @@ func (dao *UserDAO) DeleteByPk(ctx context.Context, pk string) error {
 
 func (dao *UserDAO) FindByPk(ctx context.Context, pk string) (*User, error) {
 	query := `
-		SELECT id, email, username
+		SELECT id, email, username, role, suspended_at, handle, handle_changed_at, display_name, bio, avatar_url, website, location, social_links, deletion_scheduled_at, deleted_at
 		FROM users
 		WHERE id = $1
 	`
@@ -121,6 +157,18 @@ func (dao *UserDAO) FindByPk(ctx context.Context, pk string) (*User, error) {
 		&m.ID,
 		&m.Email,
 		&m.Username,
+		&m.Role,
+		&m.SuspendedAt,
+		&m.Handle,
+		&m.HandleChangedAt,
+		&m.DisplayName,
+		&m.Bio,
+		&m.AvatarURL,
+		&m.Website,
+		&m.Location,
+		&m.SocialLinks,
+		&m.DeletionScheduledAt,
+		&m.DeletedAt,
 	)
 
 	if err != nil {
@@ -136,21 +184,33 @@ func (dao *UserDAO) CreateMany(ctx context.Context, models []*User) error {
 	}
 
 	placeholders := make([]string, len(models))
-	args := make([]interface{}, 0, len(models)*3)
+	args := make([]interface{}, 0, len(models)*15)
 
 	for i, model := range models {
-		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d)",
-			i*3+1, i*3+2, i*3+3)
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*15+1, i*15+2, i*15+3, i*15+4, i*15+5, i*15+6, i*15+7, i*15+8, i*15+9, i*15+10, i*15+11, i*15+12, i*15+13, i*15+14, i*15+15)
 
 		args = append(args,
 			model.ID,
 			model.Email,
 			model.Username,
+			model.Role,
+			model.SuspendedAt,
+			model.Handle,
+			model.HandleChangedAt,
+			model.DisplayName,
+			model.Bio,
+			model.AvatarURL,
+			model.Website,
+			model.Location,
+			model.SocialLinks,
+			model.DeletionScheduledAt,
+			model.DeletedAt,
 		)
 	}
 
 	query := fmt.Sprintf(`
-		INSERT INTO users (id, email, username)
+		INSERT INTO users (id, email, username, role, suspended_at, handle, handle_changed_at, display_name, bio, avatar_url, website, location, social_links, deletion_scheduled_at, deleted_at)
 		VALUES %s
 	`, strings.Join(placeholders, ", "))
 
@@ -166,14 +226,38 @@ func (dao *UserDAO) UpdateMany(ctx context.Context, models []*User) error {
 	query := `
 		UPDATE users
 		SET email = $1,
-			username = $2
-		WHERE id = $3
+			username = $2,
+			role = $3,
+			suspended_at = $4,
+			handle = $5,
+			handle_changed_at = $6,
+			display_name = $7,
+			bio = $8,
+			avatar_url = $9,
+			website = $10,
+			location = $11,
+			social_links = $12,
+			deletion_scheduled_at = $13,
+			deleted_at = $14
+		WHERE id = $15
 	`
 
 	for _, model := range models {
 		_, err := dao.execContext(ctx, query,
 			model.Email,
 			model.Username,
+			model.Role,
+			model.SuspendedAt,
+			model.Handle,
+			model.HandleChangedAt,
+			model.DisplayName,
+			model.Bio,
+			model.AvatarURL,
+			model.Website,
+			model.Location,
+			model.SocialLinks,
+			model.DeletionScheduledAt,
+			model.DeletedAt,
 			model.ID,
 		)
 		if err != nil {
@@ -203,7 +287,7 @@ func (dao *UserDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
 
 func (dao *UserDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*User, error) {
 	query := `
-		SELECT id, email, username
+		SELECT id, email, username, role, suspended_at, handle, handle_changed_at, display_name, bio, avatar_url, website, location, social_links, deletion_scheduled_at, deleted_at
 		FROM users
 	`
 
@@ -222,6 +306,18 @@ func (dao *UserDAO) FindOne(ctx context.Context, where string, sort string, args
 		&m.ID,
 		&m.Email,
 		&m.Username,
+		&m.Role,
+		&m.SuspendedAt,
+		&m.Handle,
+		&m.HandleChangedAt,
+		&m.DisplayName,
+		&m.Bio,
+		&m.AvatarURL,
+		&m.Website,
+		&m.Location,
+		&m.SocialLinks,
+		&m.DeletionScheduledAt,
+		&m.DeletedAt,
 	)
 
 	if err != nil {
@@ -233,7 +329,7 @@ func (dao *UserDAO) FindOne(ctx context.Context, where string, sort string, args
 
 func (dao *UserDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*User, error) {
 	query := `
-		SELECT id, email, username
+		SELECT id, email, username, role, suspended_at, handle, handle_changed_at, display_name, bio, avatar_url, website, location, social_links, deletion_scheduled_at, deleted_at
 		FROM users
 	`
 
@@ -258,6 +354,18 @@ func (dao *UserDAO) FindAll(ctx context.Context, where string, sort string, args
 			&m.ID,
 			&m.Email,
 			&m.Username,
+			&m.Role,
+			&m.SuspendedAt,
+			&m.Handle,
+			&m.HandleChangedAt,
+			&m.DisplayName,
+			&m.Bio,
+			&m.AvatarURL,
+			&m.Website,
+			&m.Location,
+			&m.SocialLinks,
+			&m.DeletionScheduledAt,
+			&m.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -274,7 +382,7 @@ func (dao *UserDAO) FindAll(ctx context.Context, where string, sort string, args
 
 func (dao *UserDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*User, error) {
 	query := `
-		SELECT id, email, username
+		SELECT id, email, username, role, suspended_at, handle, handle_changed_at, display_name, bio, avatar_url, website, location, social_links, deletion_scheduled_at, deleted_at
 		FROM users
 	`
 
@@ -301,6 +409,18 @@ func (dao *UserDAO) FindPaginated(ctx context.Context, limit, offset int, where
 			&m.ID,
 			&m.Email,
 			&m.Username,
+			&m.Role,
+			&m.SuspendedAt,
+			&m.Handle,
+			&m.HandleChangedAt,
+			&m.DisplayName,
+			&m.Bio,
+			&m.AvatarURL,
+			&m.Website,
+			&m.Location,
+			&m.SocialLinks,
+			&m.DeletionScheduledAt,
+			&m.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	openai "github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/option"
+
+	"blog0/internal/domain"
+)
+
+type OpenAICommentClassifier struct {
+	client *openai.Client
+	model  string
+}
+
+func NewOpenAICommentClassifier(apiKey string, model string) *OpenAICommentClassifier {
+	client := openai.NewClient(option.WithAPIKey(apiKey))
+	return &OpenAICommentClassifier{
+		client: &client,
+		model:  model,
+	}
+}
+
+func (c *OpenAICommentClassifier) ClassifyComment(ctx context.Context, body string) (*domain.ModerationVerdict, error) {
+	if body == "" {
+		return nil, errors.New("comment body cannot be empty")
+	}
+
+	prompt := "Classify the following blog comment:\n\n" + body +
+		"\n\nAnswer with a JSON object {\"label\": \"ok\" | \"suspicious\" | \"spam\", \"reason\": \"...\"} without quote marks. " +
+		"Use \"spam\" for advertising, scams or abuse and \"suspicious\" when a human should take a look"
+
+	params := openai.ChatCompletionNewParams{
+		Model: c.model,
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			openai.SystemMessage("You moderate comments on a blog."),
+			openai.UserMessage(prompt),
+		},
+	}
+
+	resp, err := c.client.Chat.Completions.New(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, errors.New("no response from OpenAI")
+	}
+
+	var result struct {
+		Label  string `json:"label"`
+		Reason string `json:"reason"`
+	}
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &result); err != nil {
+		return nil, err
+	}
+
+	switch result.Label {
+	case "ok":
+		return nil, nil
+	case "suspicious":
+		return &domain.ModerationVerdict{Status: domain.CommentStatusPending, Reason: result.Reason}, nil
+	case "spam":
+		return &domain.ModerationVerdict{Status: domain.CommentStatusRejected, Reason: result.Reason}, nil
+	default:
+		return nil, errors.New("unexpected label from OpenAI: " + result.Label)
+	}
+}
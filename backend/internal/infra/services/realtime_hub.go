@@ -0,0 +1,159 @@
+package services
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+
+	"blog0/internal/domain"
+)
+
+const (
+	realtimeChannel = "blog0_realtime"
+	// Postgres rejects NOTIFY payloads of 8000 bytes or more
+	maxNotifyPayload   = 7900
+	subscriberBuffer   = 16
+	listenerMinBackoff = 2 * time.Second
+	listenerMaxBackoff = time.Minute
+)
+
+// PostgresRealtimeHub delivers events to in-process subscribers. Publishing
+// goes through Postgres NOTIFY and delivery through LISTEN, so every instance
+// connected to the same database sees the same events. The listener
+// connection is opened lazily on the first subscription, a failed LISTEN is
+// retried on the next one.
+type PostgresRealtimeHub struct {
+	db          *sql.DB
+	postgresURI string
+
+	mu          sync.RWMutex
+	subscribers map[string]map[chan domain.RealtimeEvent]struct{}
+
+	listenMu  sync.Mutex
+	listener  *pq.Listener
+	listening bool
+}
+
+func NewPostgresRealtimeHub(db *sql.DB, postgresURI string) *PostgresRealtimeHub {
+	return &PostgresRealtimeHub{
+		db:          db,
+		postgresURI: postgresURI,
+		subscribers: make(map[string]map[chan domain.RealtimeEvent]struct{}),
+	}
+}
+
+func (h *PostgresRealtimeHub) Publish(event domain.RealtimeEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal realtime event: %w", err)
+	}
+
+	// Too large for NOTIFY: send the envelope only, clients refetch the data
+	if len(payload) > maxNotifyPayload {
+		payload, err = json.Marshal(domain.RealtimeEvent{Topic: event.Topic, Type: event.Type})
+		if err != nil {
+			return fmt.Errorf("failed to marshal realtime event: %w", err)
+		}
+	}
+
+	_, err = h.db.Exec("SELECT pg_notify($1, $2)", realtimeChannel, string(payload))
+	if err != nil {
+		return fmt.Errorf("failed to notify realtime event: %w", err)
+	}
+
+	return nil
+}
+
+func (h *PostgresRealtimeHub) Subscribe(topic string) (<-chan domain.RealtimeEvent, func(), error) {
+	if err := h.listen(); err != nil {
+		return nil, nil, err
+	}
+
+	ch := make(chan domain.RealtimeEvent, subscriberBuffer)
+
+	h.mu.Lock()
+	if h.subscribers[topic] == nil {
+		h.subscribers[topic] = make(map[chan domain.RealtimeEvent]struct{})
+	}
+	h.subscribers[topic][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subscribers[topic], ch)
+			if len(h.subscribers[topic]) == 0 {
+				delete(h.subscribers, topic)
+			}
+			h.mu.Unlock()
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe, nil
+}
+
+// listen makes sure the listener is LISTENing on the channel. The listener
+// reconnects by itself once listening, only the LISTEN itself can fail.
+func (h *PostgresRealtimeHub) listen() error {
+	h.listenMu.Lock()
+	defer h.listenMu.Unlock()
+
+	if h.listening {
+		return nil
+	}
+
+	if h.listener == nil {
+		h.listener = pq.NewListener(h.postgresURI, listenerMinBackoff, listenerMaxBackoff, func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				log.Printf("realtime listener: %v", err)
+			}
+		})
+		go h.deliver(h.listener)
+	}
+
+	err := h.listener.Listen(realtimeChannel)
+	if err != nil && !errors.Is(err, pq.ErrChannelAlreadyOpen) {
+		return fmt.Errorf("failed to listen on %s: %w", realtimeChannel, err)
+	}
+
+	h.listening = true
+	return nil
+}
+
+func (h *PostgresRealtimeHub) deliver(listener *pq.Listener) {
+	for notification := range listener.NotificationChannel() {
+		// A nil notification means the connection was re-established
+		if notification == nil {
+			continue
+		}
+
+		var event domain.RealtimeEvent
+		if err := json.Unmarshal([]byte(notification.Extra), &event); err != nil {
+			log.Printf("realtime listener: invalid payload: %v", err)
+			continue
+		}
+
+		h.dispatch(event)
+	}
+}
+
+func (h *PostgresRealtimeHub) dispatch(event domain.RealtimeEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for ch := range h.subscribers[event.Topic] {
+		select {
+		case ch <- event:
+		default:
+			// Slow subscriber, drop the event rather than block the others
+		}
+	}
+}
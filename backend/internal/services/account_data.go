@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+
+	"blog0/internal/domain/dao"
+)
+
+// AccountData gathers the DAOs of every table holding data about users, for
+// exporting and purging accounts.
+type AccountData struct {
+	Users                dao.UserDAO
+	Posts                dao.PostDAO
+	Comments             dao.CommentDAO
+	CommentRevisions     dao.CommentRevisionDAO
+	Reactions            dao.ReactionDAO
+	Bookmarks            dao.BookmarkDAO
+	BookmarkCollections  dao.BookmarkCollectionDAO
+	Follows              dao.FollowDAO
+	Notifications        dao.NotificationDAO
+	Mentions             dao.MentionDAO
+	Blocks               dao.BlockDAO
+	Mutes                dao.MuteDAO
+	Reports              dao.ReportDAO
+	AdminActions         dao.AdminActionDAO
+	Sessions             dao.SessionDAO
+	OAuthCodes           dao.OAuthCodeDAO
+	Identities           dao.IdentityDAO
+	PersonalAccessTokens dao.PersonalAccessTokenDAO
+	Avatars              dao.AvatarDAO
+	HandleAliases        dao.HandleAliasDAO
+}
+
+// deleteWhere deletes the rows find returns for the where clause.
+func deleteWhere[T any](
+	ctx context.Context,
+	find func(ctx context.Context, where string, sort string, args ...interface{}) ([]*T, error),
+	deleteMany func(ctx context.Context, pks []string) error,
+	pk func(*T) string,
+	where string,
+	args ...interface{},
+) error {
+	rows, err := find(ctx, where, "", args...)
+	if err != nil {
+		return err
+	}
+
+	pks := make([]string, 0, len(rows))
+	for _, row := range rows {
+		pks = append(pks, pk(row))
+	}
+
+	return deleteMany(ctx, pks)
+}
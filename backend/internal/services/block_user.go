@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+type BlockUser struct {
+	userDAO     dao.UserDAO
+	blockDAO    dao.BlockDAO
+	followDAO   dao.FollowDAO
+	relationDAO customdao.RelationDAO
+	nextID      domain.NextID
+}
+
+type BlockUserReq struct {
+	BlockedID string `json:"-"`
+	UserID    string `json:"-"`
+}
+
+type BlockUserResp struct {
+	Blocked bool `json:"blocked"`
+}
+
+func NewBlockUser(userDAO dao.UserDAO, blockDAO dao.BlockDAO, followDAO dao.FollowDAO, relationDAO customdao.RelationDAO, nextID domain.NextID) *BlockUser {
+	return &BlockUser{
+		userDAO:     userDAO,
+		blockDAO:    blockDAO,
+		followDAO:   followDAO,
+		relationDAO: relationDAO,
+		nextID:      nextID,
+	}
+}
+
+func (s *BlockUser) Exec(ctx context.Context, req *BlockUserReq) (*BlockUserResp, error) {
+	_, err := s.userDAO.FindByPk(ctx, req.BlockedID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	block, err := domain.NewBlock(s.nextID(), req.UserID, req.BlockedID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create block: %w", err)
+	}
+
+	err = s.blockDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		// Blocking twice is a no op, the follows ended with the first block
+		inserted, err := s.relationDAO.InsertBlock(ctx, block)
+		if err != nil {
+			return fmt.Errorf("failed to save block: %w", err)
+		}
+		if !inserted {
+			return nil
+		}
+
+		// A block ends the follows in both directions
+		follows, err := s.followDAO.FindAll(ctx,
+			"(follower_id = $1 AND followee_id = $2) OR (follower_id = $2 AND followee_id = $1)", "",
+			req.UserID, req.BlockedID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to load follows: %w", err)
+		}
+
+		followIDs := make([]string, 0, len(follows))
+		for _, follow := range follows {
+			followIDs = append(followIDs, follow.ID)
+		}
+		if len(followIDs) > 0 {
+			if err := s.followDAO.DeleteManyByPks(ctx, followIDs); err != nil {
+				return fmt.Errorf("failed to delete follows: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlockUserResp{Blocked: true}, nil
+}
+
+// isBlocked tells whether blockerID blocked blockedID.
+func isBlocked(ctx context.Context, blockDAO dao.BlockDAO, blockerID string, blockedID string) (bool, error) {
+	count, err := blockDAO.Count(ctx, "blocker_id = $1 AND blocked_id = $2", blockerID, blockedID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check blocks: %w", err)
+	}
+	return count > 0, nil
+}
@@ -6,12 +6,14 @@ import (
 	"time"
 
 	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
 	"blog0/internal/domain/dao"
 )
 
 type BookmarkPost struct {
 	postDAO     dao.PostDAO
 	bookmarkDAO dao.BookmarkDAO
+	relationDAO customdao.RelationDAO
 	nextID      domain.NextID
 }
 
@@ -25,42 +27,50 @@ type BookmarkPostResp struct {
 	BookmarkID string    `json:"bookmark_id"`
 	PostSlug   string    `json:"post_slug"`
 	CreatedAt  time.Time `json:"created_at"`
+	// Created is false when the post was already bookmarked
+	Created bool `json:"-"`
 }
 
-func NewBookmarkPost(postDAO dao.PostDAO, bookmarkDAO dao.BookmarkDAO, nextID domain.NextID) *BookmarkPost {
+func NewBookmarkPost(postDAO dao.PostDAO, bookmarkDAO dao.BookmarkDAO, relationDAO customdao.RelationDAO, nextID domain.NextID) *BookmarkPost {
 	return &BookmarkPost{
 		postDAO:     postDAO,
 		bookmarkDAO: bookmarkDAO,
+		relationDAO: relationDAO,
 		nextID:      nextID,
 	}
 }
 
 func (s *BookmarkPost) Exec(ctx context.Context, req *BookmarkPostReq) (*BookmarkPostResp, error) {
-	post, err := s.postDAO.FindOne(ctx, "slug = $1", "", req.Slug)
+	post, err := s.postDAO.FindOne(ctx, "slug = $1 AND hidden_at IS NULL", "", req.Slug)
 	if err != nil {
 		return nil, fmt.Errorf("post not found: %w", err)
 	}
 
-	existingBookmark, err := s.bookmarkDAO.FindOne(ctx, "user_id = $1 AND post_id = $2", "", req.UserID, post.ID)
-	if err == nil && existingBookmark != nil {
-		return nil, fmt.Errorf("post is already bookmarked")
-	}
-
 	bookmarkID := s.nextID()
 	newBookmark, err := domain.NewBookmark(bookmarkID, req.UserID, post.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create bookmark: %w", err)
 	}
 
-	err = s.bookmarkDAO.Create(ctx, newBookmark)
+	// Bookmarking twice keeps the first bookmark
+	created, err := s.relationDAO.InsertBookmark(ctx, newBookmark)
 	if err != nil {
 		return nil, fmt.Errorf("failed to save bookmark: %w", err)
 	}
 
+	bookmark := newBookmark
+	if !created {
+		bookmark, err = s.bookmarkDAO.FindOne(ctx, "user_id = $1 AND post_id = $2", "", req.UserID, post.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load bookmark: %w", err)
+		}
+	}
+
 	return &BookmarkPostResp{
 		Bookmarked: true,
-		BookmarkID: newBookmark.ID,
+		BookmarkID: bookmark.ID,
 		PostSlug:   req.Slug,
-		CreatedAt:  newBookmark.CreatedAt,
+		CreatedAt:  bookmark.CreatedAt,
+		Created:    created,
 	}, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain/dao"
+)
+
+type CancelAccountDeletion struct {
+	userDAO dao.UserDAO
+}
+
+type CancelAccountDeletionReq struct {
+	UserID string
+}
+
+func NewCancelAccountDeletion(userDAO dao.UserDAO) *CancelAccountDeletion {
+	return &CancelAccountDeletion{
+		userDAO: userDAO,
+	}
+}
+
+func (s *CancelAccountDeletion) Exec(ctx context.Context, req *CancelAccountDeletionReq) (*AccountDeletionResp, error) {
+	user, err := s.userDAO.FindByPk(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	if user.IsDeletionScheduled() {
+		user.CancelDeletion()
+		if err := s.userDAO.PartialUpdate(ctx, user.ID, map[string]interface{}{
+			"deletion_scheduled_at": nil,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to cancel deletion: %w", err)
+		}
+	}
+
+	return &AccountDeletionResp{
+		Scheduled: false,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type ChangeHandle struct {
+	userDAO  dao.UserDAO
+	aliasDAO dao.HandleAliasDAO
+	handles  *Handles
+	cooldown time.Duration
+	grace    time.Duration
+}
+
+type ChangeHandleReq struct {
+	UserID string `json:"-"`
+	Handle string `json:"handle"`
+}
+
+type ChangeHandleResp struct {
+	Handle          string     `json:"handle"`
+	PreviousHandle  string     `json:"previous_handle"`
+	AliasExpiresAt  *time.Time `json:"alias_expires_at"`
+	NextChangeAfter time.Time  `json:"next_change_after"`
+}
+
+func NewChangeHandle(userDAO dao.UserDAO, aliasDAO dao.HandleAliasDAO, handles *Handles, cooldown time.Duration, grace time.Duration) *ChangeHandle {
+	return &ChangeHandle{
+		userDAO:  userDAO,
+		aliasDAO: aliasDAO,
+		handles:  handles,
+		cooldown: cooldown,
+		grace:    grace,
+	}
+}
+
+// Exec renames the user. The previous handle becomes an alias that redirects
+// to the user, and that nobody else can take, for the grace period.
+func (s *ChangeHandle) Exec(ctx context.Context, req *ChangeHandleReq) (*ChangeHandleResp, error) {
+	user, err := s.userDAO.FindByPk(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	handle, err := domain.ParseHandle(req.Handle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to change handle: %w", err)
+	}
+
+	if handle == user.Handle {
+		return &ChangeHandleResp{
+			Handle:          user.Handle,
+			NextChangeAfter: s.nextChangeAfter(user),
+		}, nil
+	}
+
+	taken, err := s.handles.IsTaken(ctx, handle, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, fmt.Errorf("handle already taken: %s", handle)
+	}
+
+	now := time.Now()
+	previous, err := user.ChangeHandle(handle, now, s.cooldown)
+	if err != nil {
+		return nil, fmt.Errorf("handle change too soon: %w", err)
+	}
+
+	alias, err := domain.NewHandleAlias(previous, user.ID, now.Add(s.grace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create handle alias: %w", err)
+	}
+
+	err = s.userDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		// Taking back one of your aliases, or an expired one, ends it
+		if _, err := s.aliasDAO.FindByPk(ctx, handle); err == nil {
+			if err := s.aliasDAO.DeleteByPk(ctx, handle); err != nil {
+				return fmt.Errorf("failed to delete handle alias: %w", err)
+			}
+		}
+
+		if _, err := s.aliasDAO.FindByPk(ctx, previous); err == nil {
+			if err := s.aliasDAO.Update(ctx, alias); err != nil {
+				return fmt.Errorf("failed to save handle alias: %w", err)
+			}
+		} else if err := s.aliasDAO.Create(ctx, alias); err != nil {
+			return fmt.Errorf("failed to save handle alias: %w", err)
+		}
+
+		if err := s.userDAO.PartialUpdate(ctx, user.ID, map[string]interface{}{
+			"handle":            user.Handle,
+			"handle_changed_at": user.HandleChangedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to save handle: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChangeHandleResp{
+		Handle:          user.Handle,
+		PreviousHandle:  previous,
+		AliasExpiresAt:  &alias.ExpiresAt,
+		NextChangeAfter: s.nextChangeAfter(user),
+	}, nil
+}
+
+func (s *ChangeHandle) nextChangeAfter(user *domain.User) time.Time {
+	if user.HandleChangedAt == nil {
+		return time.Now()
+	}
+	return user.HandleChangedAt.Add(s.cooldown)
+}
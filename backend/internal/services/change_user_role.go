@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type ChangeUserRole struct {
+	userDAO        dao.UserDAO
+	adminActionDAO dao.AdminActionDAO
+	nextID         domain.NextID
+}
+
+type ChangeUserRoleReq struct {
+	UserID  string `json:"-"`
+	AdminID string `json:"-"`
+	Role    string `json:"role"`
+}
+
+func NewChangeUserRole(userDAO dao.UserDAO, adminActionDAO dao.AdminActionDAO, nextID domain.NextID) *ChangeUserRole {
+	return &ChangeUserRole{
+		userDAO:        userDAO,
+		adminActionDAO: adminActionDAO,
+		nextID:         nextID,
+	}
+}
+
+// Exec changes the role of a user. The new role is in the claims of the
+// access tokens issued from their next refresh.
+func (s *ChangeUserRole) Exec(ctx context.Context, req *ChangeUserRoleReq) (*AdminUserItem, error) {
+	// Admins cannot lock themselves out
+	if req.UserID == req.AdminID {
+		return nil, fmt.Errorf("unauthorized: you cannot change your own role")
+	}
+
+	user, err := s.userDAO.FindByPk(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	previousRole := user.Role
+	if err := user.ChangeRole(req.Role); err != nil {
+		return nil, fmt.Errorf("failed to change role: %w", err)
+	}
+
+	if user.Role == previousRole {
+		item := newAdminUserItem(user)
+		return &item, nil
+	}
+
+	adminAction, err := domain.NewAdminAction(s.nextID(), req.AdminID, domain.AdminActionChangeRole, nil, domain.ReportTargetUser, user.ID, previousRole+" -> "+user.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin action: %w", err)
+	}
+
+	err = s.userDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userDAO.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to save user: %w", err)
+		}
+
+		if err := s.adminActionDAO.Create(ctx, adminAction); err != nil {
+			return fmt.Errorf("failed to save admin action: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	item := newAdminUserItem(user)
+	return &item, nil
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+// ModerationPipeline runs every moderator in order. A rejection wins over a
+// hold, and a comment nobody objects to is approved. Moderators return a nil
+// verdict when they have nothing to say about a comment.
+type ModerationPipeline struct {
+	moderators []domain.CommentModerator
+}
+
+func NewModerationPipeline(moderators ...domain.CommentModerator) *ModerationPipeline {
+	return &ModerationPipeline{
+		moderators: moderators,
+	}
+}
+
+func (p *ModerationPipeline) Moderate(ctx context.Context, comment *domain.Comment) (*domain.ModerationVerdict, error) {
+	var held *domain.ModerationVerdict
+	for _, moderator := range p.moderators {
+		verdict, err := moderator.Moderate(ctx, comment)
+		if err != nil {
+			return nil, err
+		}
+		if verdict == nil {
+			continue
+		}
+
+		switch verdict.Status {
+		case domain.CommentStatusRejected:
+			return verdict, nil
+		case domain.CommentStatusPending:
+			if held == nil {
+				held = verdict
+			}
+		}
+	}
+
+	if held != nil {
+		return held, nil
+	}
+
+	return &domain.ModerationVerdict{Status: domain.CommentStatusApproved}, nil
+}
+
+var linkPattern = regexp.MustCompile(`(?i)https?://|www\.`)
+
+// LinkCountModerator holds comments with more links than allowed.
+type LinkCountModerator struct {
+	maxLinks int
+}
+
+func NewLinkCountModerator(maxLinks int) *LinkCountModerator {
+	return &LinkCountModerator{
+		maxLinks: maxLinks,
+	}
+}
+
+func (m *LinkCountModerator) Moderate(ctx context.Context, comment *domain.Comment) (*domain.ModerationVerdict, error) {
+	links := len(linkPattern.FindAllStringIndex(comment.Body, -1))
+	if links <= m.maxLinks {
+		return nil, nil
+	}
+
+	return &domain.ModerationVerdict{
+		Status: domain.CommentStatusPending,
+		Reason: fmt.Sprintf("comment has %d links, at most %d are allowed", links, m.maxLinks),
+	}, nil
+}
+
+// BlockedWordsModerator rejects comments containing any of the blocked words.
+type BlockedWordsModerator struct {
+	pattern *regexp.Regexp
+}
+
+func NewBlockedWordsModerator(words []string) *BlockedWordsModerator {
+	quoted := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+
+	var pattern *regexp.Regexp
+	if len(quoted) > 0 {
+		pattern = regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)\b`)
+	}
+
+	return &BlockedWordsModerator{
+		pattern: pattern,
+	}
+}
+
+func (m *BlockedWordsModerator) Moderate(ctx context.Context, comment *domain.Comment) (*domain.ModerationVerdict, error) {
+	if m.pattern == nil {
+		return nil, nil
+	}
+
+	word := m.pattern.FindString(comment.Body)
+	if word == "" {
+		return nil, nil
+	}
+
+	return &domain.ModerationVerdict{
+		Status: domain.CommentStatusRejected,
+		Reason: fmt.Sprintf("comment contains the blocked word %q", strings.ToLower(word)),
+	}, nil
+}
+
+// FirstTimeCommenterModerator holds comments from users who have no approved
+// comment yet.
+type FirstTimeCommenterModerator struct {
+	commentDAO dao.CommentDAO
+}
+
+func NewFirstTimeCommenterModerator(commentDAO dao.CommentDAO) *FirstTimeCommenterModerator {
+	return &FirstTimeCommenterModerator{
+		commentDAO: commentDAO,
+	}
+}
+
+func (m *FirstTimeCommenterModerator) Moderate(ctx context.Context, comment *domain.Comment) (*domain.ModerationVerdict, error) {
+	approved, err := m.commentDAO.Count(ctx, "author_id = $1 AND status = $2 AND id <> $3", comment.AuthorID, domain.CommentStatusApproved, comment.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count approved comments: %w", err)
+	}
+
+	if approved > 0 {
+		return nil, nil
+	}
+
+	return &domain.ModerationVerdict{
+		Status: domain.CommentStatusPending,
+		Reason: "first comment from this user",
+	}, nil
+}
+
+// ClassifierModerator asks a CommentClassifier about the comment. Classifier
+// failures never block a comment, the other moderators still apply.
+type ClassifierModerator struct {
+	classifier domain.CommentClassifier
+}
+
+func NewClassifierModerator(classifier domain.CommentClassifier) *ClassifierModerator {
+	return &ClassifierModerator{
+		classifier: classifier,
+	}
+}
+
+func (m *ClassifierModerator) Moderate(ctx context.Context, comment *domain.Comment) (*domain.ModerationVerdict, error) {
+	verdict, err := m.classifier.ClassifyComment(ctx, comment.Body)
+	if err != nil {
+		log.Printf("failed to classify comment %s: %v", comment.ID, err)
+		return nil, nil
+	}
+
+	return verdict, nil
+}
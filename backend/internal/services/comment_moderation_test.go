@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"blog0/internal/domain"
+)
+
+// fixedModerator always gives the same verdict.
+type fixedModerator struct {
+	verdict *domain.ModerationVerdict
+}
+
+func (m fixedModerator) Moderate(ctx context.Context, comment *domain.Comment) (*domain.ModerationVerdict, error) {
+	return m.verdict, nil
+}
+
+func TestModerationPipelineVerdictOrder(t *testing.T) {
+	hold := fixedModerator{&domain.ModerationVerdict{Status: domain.CommentStatusPending, Reason: "held"}}
+	reject := fixedModerator{&domain.ModerationVerdict{Status: domain.CommentStatusRejected, Reason: "rejected"}}
+	silent := fixedModerator{}
+
+	tests := []struct {
+		name       string
+		moderators []domain.CommentModerator
+		wantStatus string
+		wantReason string
+	}{
+		{"no moderators approve", nil, domain.CommentStatusApproved, ""},
+		{"silent moderators approve", []domain.CommentModerator{silent, silent}, domain.CommentStatusApproved, ""},
+		{"a hold holds", []domain.CommentModerator{silent, hold}, domain.CommentStatusPending, "held"},
+		{"reject beats an earlier hold", []domain.CommentModerator{hold, reject}, domain.CommentStatusRejected, "rejected"},
+		{"reject beats a later hold", []domain.CommentModerator{reject, hold}, domain.CommentStatusRejected, "rejected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			pipeline := NewModerationPipeline(tt.moderators...)
+			comment, err := domain.NewComment("comment-1", "post-1", "user-1", "hello")
+			if err != nil {
+				t.Fatalf("NewComment failed: %v", err)
+			}
+
+			// Act
+			verdict, err := pipeline.Moderate(context.Background(), comment)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Moderate failed: %v", err)
+			}
+			if verdict.Status != tt.wantStatus || verdict.Reason != tt.wantReason {
+				t.Fatalf("expected %s (%q), got %s (%q)", tt.wantStatus, tt.wantReason, verdict.Status, verdict.Reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type CreateBookmarkCollection struct {
+	collectionDAO dao.BookmarkCollectionDAO
+	nextID        domain.NextID
+}
+
+type CreateBookmarkCollectionReq struct {
+	UserID      string `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsPublic    bool   `json:"is_public"`
+}
+
+// BookmarkCollectionInfo is a collection as its owner sees it.
+type BookmarkCollectionInfo struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	IsPublic       bool      `json:"is_public"`
+	BookmarksCount int       `json:"bookmarks_count"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func NewCreateBookmarkCollection(collectionDAO dao.BookmarkCollectionDAO, nextID domain.NextID) *CreateBookmarkCollection {
+	return &CreateBookmarkCollection{
+		collectionDAO: collectionDAO,
+		nextID:        nextID,
+	}
+}
+
+func (s *CreateBookmarkCollection) Exec(ctx context.Context, req *CreateBookmarkCollectionReq) (*BookmarkCollectionInfo, error) {
+	collection, err := domain.NewBookmarkCollection(s.nextID(), req.UserID, req.Name, req.Description, req.IsPublic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create collection: %w", err)
+	}
+
+	err = checkCollectionName(ctx, s.collectionDAO, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.collectionDAO.Create(ctx, collection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save collection: %w", err)
+	}
+
+	info := newBookmarkCollectionInfo(collection, 0)
+	return &info, nil
+}
+
+// checkCollectionName fails when the user has another collection with the
+// same name, ignoring case.
+func checkCollectionName(ctx context.Context, collectionDAO dao.BookmarkCollectionDAO, collection *domain.BookmarkCollection) error {
+	taken, err := collectionDAO.Count(ctx, "user_id = $1 AND LOWER(name) = LOWER($2) AND id <> $3", collection.UserID, collection.Name, collection.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check collection name: %w", err)
+	}
+	if taken > 0 {
+		return fmt.Errorf("collection name already taken: %s", collection.Name)
+	}
+
+	return nil
+}
+
+func newBookmarkCollectionInfo(collection *domain.BookmarkCollection, bookmarksCount int) BookmarkCollectionInfo {
+	return BookmarkCollectionInfo{
+		ID:             collection.ID,
+		Name:           collection.Name,
+		Description:    collection.Description,
+		IsPublic:       collection.IsPublic,
+		BookmarksCount: bookmarksCount,
+		CreatedAt:      collection.CreatedAt,
+		UpdatedAt:      collection.UpdatedAt,
+	}
+}
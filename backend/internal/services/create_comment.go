@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"blog0/internal/domain"
@@ -13,6 +14,11 @@ type CreateComment struct {
 	postDAO    dao.PostDAO
 	userDAO    dao.UserDAO
 	commentDAO dao.CommentDAO
+	blockDAO   dao.BlockDAO
+	moderator  domain.CommentModerator
+	mentions   *MentionTracker
+	notifier   *Notifier
+	realtime   domain.RealtimeHub
 	nextID     domain.NextID
 }
 
@@ -24,25 +30,32 @@ type CreateCommentReq struct {
 }
 
 type CreateCommentResp struct {
-	ID        string     `json:"id"`
-	PostSlug  string     `json:"post_slug"`
-	Author    AuthorInfo `json:"author"`
-	ParentID  *string    `json:"parent_id"`
-	Body      string     `json:"body"`
-	CreatedAt time.Time  `json:"created_at"`
+	ID               string     `json:"id"`
+	PostSlug         string     `json:"post_slug"`
+	Author           AuthorInfo `json:"author"`
+	ParentID         *string    `json:"parent_id"`
+	Body             string     `json:"body"`
+	Status           string     `json:"status"`
+	ModerationReason *string    `json:"moderation_reason"`
+	CreatedAt        time.Time  `json:"created_at"`
 }
 
-func NewCreateComment(postDAO dao.PostDAO, userDAO dao.UserDAO, commentDAO dao.CommentDAO, nextID domain.NextID) *CreateComment {
+func NewCreateComment(postDAO dao.PostDAO, userDAO dao.UserDAO, commentDAO dao.CommentDAO, blockDAO dao.BlockDAO, moderator domain.CommentModerator, mentions *MentionTracker, notifier *Notifier, realtime domain.RealtimeHub, nextID domain.NextID) *CreateComment {
 	return &CreateComment{
 		postDAO:    postDAO,
 		userDAO:    userDAO,
 		commentDAO: commentDAO,
+		blockDAO:   blockDAO,
+		moderator:  moderator,
+		mentions:   mentions,
+		notifier:   notifier,
+		realtime:   realtime,
 		nextID:     nextID,
 	}
 }
 
 func (s *CreateComment) Exec(ctx context.Context, req *CreateCommentReq) (*CreateCommentResp, error) {
-	post, err := s.postDAO.FindOne(ctx, "slug = $1", "", req.Slug)
+	post, err := s.postDAO.FindOne(ctx, "slug = $1 AND hidden_at IS NULL", "", req.Slug)
 	if err != nil {
 		return nil, fmt.Errorf("post not found: %w", err)
 	}
@@ -52,11 +65,35 @@ func (s *CreateComment) Exec(ctx context.Context, req *CreateCommentReq) (*Creat
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
+	blocked, err := isBlocked(ctx, s.blockDAO, post.AuthorID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, fmt.Errorf("unauthorized: you cannot comment on this post")
+	}
+
+	var parent *domain.Comment
+	if req.ParentID != nil {
+		parent, err = s.commentDAO.FindByPk(ctx, *req.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("parent comment not found: %w", err)
+		}
+
+		if !parent.IsApproved() {
+			return nil, fmt.Errorf("parent comment not found: comment is not visible")
+		}
+
+		if parent.PostID != post.ID {
+			return nil, fmt.Errorf("parent comment does not belong to this post")
+		}
+	}
+
 	commentID := s.nextID()
 
 	var comment *domain.Comment
-	if req.ParentID != nil {
-		comment, err = domain.NewReplyComment(commentID, post.ID, req.UserID, *req.ParentID, req.Body)
+	if parent != nil {
+		comment, err = domain.NewReplyComment(commentID, post.ID, req.UserID, parent, req.Body)
 	} else {
 		comment, err = domain.NewComment(commentID, post.ID, req.UserID, req.Body)
 	}
@@ -64,17 +101,121 @@ func (s *CreateComment) Exec(ctx context.Context, req *CreateCommentReq) (*Creat
 		return nil, fmt.Errorf("failed to create comment: %w", err)
 	}
 
+	// Post authors don't need their own approval
+	if post.AuthorID != req.UserID {
+		verdict, err := s.moderator.Moderate(ctx, comment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to moderate comment: %w", err)
+		}
+
+		switch verdict.Status {
+		case domain.CommentStatusPending:
+			comment.Hold(verdict.Reason)
+		case domain.CommentStatusRejected:
+			comment.Reject(verdict.Reason)
+		}
+	}
+
 	err = s.commentDAO.Create(ctx, comment)
 	if err != nil {
 		return nil, fmt.Errorf("failed to save comment: %w", err)
 	}
 
+	if comment.IsApproved() {
+		publishComment(ctx, s.commentDAO, s.mentions, s.notifier, s.realtime, post, comment, author)
+	}
+
+	return newCreateCommentResp(post, comment, author), nil
+}
+
+func newCreateCommentResp(post *domain.Post, comment *domain.Comment, author *domain.User) *CreateCommentResp {
 	return &CreateCommentResp{
-		ID:        comment.ID,
-		PostSlug:  req.Slug,
-		Author:    AuthorInfo{ID: author.ID, Name: author.Username},
-		ParentID:  comment.ParentID,
-		Body:      comment.Body,
-		CreatedAt: comment.CreatedAt,
-	}, nil
+		ID:               comment.ID,
+		PostSlug:         post.Slug,
+		Author:           AuthorInfo{ID: author.ID, Name: author.Username},
+		ParentID:         comment.ParentID,
+		Body:             comment.Body,
+		Status:           comment.Status,
+		ModerationReason: comment.ModerationReason,
+		CreatedAt:        comment.CreatedAt,
+	}
+}
+
+// publishComment makes an approved comment count in its thread, tells the
+// post and parent authors and the mentioned users about it and pushes it to
+// live readers. The comment is already saved, so failures are only logged: a
+// retry would post it twice.
+func publishComment(ctx context.Context, commentDAO dao.CommentDAO, mentions *MentionTracker, notifier *Notifier, realtime domain.RealtimeHub, post *domain.Post, comment *domain.Comment, author *domain.User) {
+	var parent *domain.Comment
+	if comment.ParentID != nil {
+		var err error
+		parent, err = commentDAO.FindByPk(ctx, *comment.ParentID)
+		if err != nil {
+			log.Printf("failed to load parent of comment %s: %v", comment.ID, err)
+		}
+	}
+
+	if parent != nil {
+		// The count is recomputed by the next reply or delete if this fails
+		if _, err := refreshReplyCount(ctx, commentDAO, parent.ID); err != nil {
+			log.Printf("failed to refresh reply count of comment %s: %v", parent.ID, err)
+		}
+
+		err := notifier.Notify(ctx, &NotifyReq{
+			RecipientID: parent.AuthorID,
+			ActorID:     comment.AuthorID,
+			Type:        domain.NotificationTypeReply,
+			PostID:      &post.ID,
+			CommentID:   &parent.ID,
+		})
+		if err != nil {
+			log.Printf("failed to notify reply %s: %v", comment.ID, err)
+		}
+	}
+
+	// The post author was already told through the reply notification
+	if parent == nil || parent.AuthorID != post.AuthorID {
+		err := notifier.Notify(ctx, &NotifyReq{
+			RecipientID: post.AuthorID,
+			ActorID:     comment.AuthorID,
+			Type:        domain.NotificationTypeComment,
+			PostID:      &post.ID,
+			CommentID:   &comment.ID,
+		})
+		if err != nil {
+			log.Printf("failed to notify comment %s: %v", comment.ID, err)
+		}
+	}
+
+	if err := mentions.SyncComment(ctx, comment); err != nil {
+		log.Printf("failed to sync mentions of comment %s: %v", comment.ID, err)
+	}
+
+	err := realtime.Publish(domain.RealtimeEvent{
+		Topic: domain.PostTopic(post.ID),
+		Type:  domain.RealtimeCommentCreated,
+		Data:  newCreateCommentResp(post, comment, author),
+	})
+	if err != nil {
+		// The comment is saved, live readers will get it on their next load
+		log.Printf("failed to publish comment %s: %v", comment.ID, err)
+	}
+}
+
+// refreshReplyCount recomputes the stored reply count of a comment from its
+// approved replies, so concurrent writers converge on the right value.
+func refreshReplyCount(ctx context.Context, commentDAO dao.CommentDAO, commentID string) (int, error) {
+	replyCount, err := commentDAO.Count(ctx, "parent_id = $1 AND status = $2", commentID, domain.CommentStatusApproved)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count replies: %w", err)
+	}
+
+	err = commentDAO.PartialUpdate(ctx, commentID, map[string]interface{}{
+		"reply_count": replyCount,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to update reply count: %w", err)
+	}
+
+	return int(replyCount), nil
 }
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+const (
+	defaultTokenLifetimeDays = 30
+	maxTokenLifetimeDays     = 365
+)
+
+type CreatePersonalAccessToken struct {
+	tokenDAO dao.PersonalAccessTokenDAO
+	nextID   domain.NextID
+}
+
+type CreatePersonalAccessTokenReq struct {
+	UserID        string
+	Name          string
+	Scopes        []string
+	ExpiresInDays int
+}
+
+// CreatePersonalAccessTokenResp is the only time Token is shown.
+type CreatePersonalAccessTokenResp struct {
+	PersonalAccessTokenItem
+	Token string `json:"token"`
+}
+
+func NewCreatePersonalAccessToken(tokenDAO dao.PersonalAccessTokenDAO, nextID domain.NextID) *CreatePersonalAccessToken {
+	return &CreatePersonalAccessToken{
+		tokenDAO: tokenDAO,
+		nextID:   nextID,
+	}
+}
+
+func (s *CreatePersonalAccessToken) Exec(ctx context.Context, req *CreatePersonalAccessTokenReq) (*CreatePersonalAccessTokenResp, error) {
+	days := req.ExpiresInDays
+	if days == 0 {
+		days = defaultTokenLifetimeDays
+	}
+	if days < 0 || days > maxTokenLifetimeDays {
+		return nil, fmt.Errorf("failed to create token: expires_in_days must be between 1 and %d", maxTokenLifetimeDays)
+	}
+
+	secret, err := domain.NewOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	plain := domain.PersonalAccessTokenPrefix + secret
+
+	token, err := domain.NewPersonalAccessToken(s.nextID(), req.UserID, req.Name, plain, req.Scopes, time.Now().AddDate(0, 0, days))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+
+	if err := s.tokenDAO.Create(ctx, token); err != nil {
+		return nil, fmt.Errorf("failed to save token: %w", err)
+	}
+
+	return &CreatePersonalAccessTokenResp{
+		PersonalAccessTokenItem: newPersonalAccessTokenItem(token),
+		Token:                   plain,
+	}, nil
+}
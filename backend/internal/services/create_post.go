@@ -14,6 +14,7 @@ type CreatePost struct {
 	nextID               domain.NextID
 	postContentGenerator domain.PostContentGenerator
 	eventBus             domain.EventBus
+	mentions             *MentionTracker
 }
 
 type CreatePostReq struct {
@@ -37,12 +38,13 @@ type CreatePostResp struct {
 	UpdatedAt   time.Time  `json:"updated_at"`
 }
 
-func NewCreatePost(postDAO dao.PostDAO, nextID domain.NextID, postContentGenerator domain.PostContentGenerator, eventBus domain.EventBus) *CreatePost {
+func NewCreatePost(postDAO dao.PostDAO, nextID domain.NextID, postContentGenerator domain.PostContentGenerator, eventBus domain.EventBus, mentions *MentionTracker) *CreatePost {
 	return &CreatePost{
 		postDAO:              postDAO,
 		nextID:               nextID,
 		postContentGenerator: postContentGenerator,
 		eventBus:             eventBus,
+		mentions:             mentions,
 	}
 }
 
@@ -78,6 +80,10 @@ func (s *CreatePost) Exec(ctx context.Context, req *CreatePostReq) (*CreatePostR
 		return nil, fmt.Errorf("failed to save post: %w", err)
 	}
 
+	if err := s.mentions.SyncPost(ctx, post); err != nil {
+		return nil, err
+	}
+
 	err = s.eventBus.ProcessEvents([]any{
 		&domain.PostCreated{
 			PostID: post.ID,
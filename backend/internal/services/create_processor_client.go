@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type CreateProcessorClient struct {
+	clientDAO      dao.ProcessorClientDAO
+	userDAO        dao.UserDAO
+	adminActionDAO dao.AdminActionDAO
+	nextID         domain.NextID
+}
+
+type CreateProcessorClientReq struct {
+	AdminID          string   `json:"-"`
+	Name             string   `json:"name"`
+	AllowedAuthorIDs []string `json:"allowed_author_ids"`
+	AllowedOps       []string `json:"allowed_ops"`
+}
+
+// CreateProcessorClientResp is the only time Secret is shown. Clients send
+// their ID and secret as HTTP Basic credentials.
+type CreateProcessorClientResp struct {
+	ProcessorClientItem
+	Secret string `json:"secret"`
+}
+
+func NewCreateProcessorClient(clientDAO dao.ProcessorClientDAO, userDAO dao.UserDAO, adminActionDAO dao.AdminActionDAO, nextID domain.NextID) *CreateProcessorClient {
+	return &CreateProcessorClient{
+		clientDAO:      clientDAO,
+		userDAO:        userDAO,
+		adminActionDAO: adminActionDAO,
+		nextID:         nextID,
+	}
+}
+
+func (s *CreateProcessorClient) Exec(ctx context.Context, req *CreateProcessorClientReq) (*CreateProcessorClientResp, error) {
+	if len(req.AllowedAuthorIDs) > 0 {
+		placeholders := make([]string, 0, len(req.AllowedAuthorIDs))
+		authorIDs := make([]any, 0, len(req.AllowedAuthorIDs))
+		for i, authorID := range req.AllowedAuthorIDs {
+			placeholders = append(placeholders, fmt.Sprintf("$%d", i+1))
+			authorIDs = append(authorIDs, authorID)
+		}
+
+		found, err := s.userDAO.Count(ctx, "id::text IN ("+strings.Join(placeholders, ",")+")", authorIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load authors: %w", err)
+		}
+		if int(found) != len(req.AllowedAuthorIDs) {
+			return nil, fmt.Errorf("user not found: some allowed authors don't exist")
+		}
+	}
+
+	secret, err := domain.NewOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	adminID := req.AdminID
+	client, err := domain.NewProcessorClient(s.nextID(), req.Name, secret, req.AllowedAuthorIDs, req.AllowedOps, &adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create processor client: %w", err)
+	}
+
+	adminAction, err := domain.NewAdminAction(s.nextID(), req.AdminID, domain.AdminActionCreateProcessorClient, nil, "processor_client", client.ID, client.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin action: %w", err)
+	}
+
+	err = s.clientDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.clientDAO.Create(ctx, client); err != nil {
+			return fmt.Errorf("failed to save processor client: %w", err)
+		}
+
+		if err := s.adminActionDAO.Create(ctx, adminAction); err != nil {
+			return fmt.Errorf("failed to save admin action: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateProcessorClientResp{
+		ProcessorClientItem: newProcessorClientItem(client),
+		Secret:              secret,
+	}, nil
+}
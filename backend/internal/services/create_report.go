@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+type CreateReport struct {
+	postDAO    dao.PostDAO
+	commentDAO dao.CommentDAO
+	userDAO    dao.UserDAO
+	reportDAO  dao.ReportDAO
+	filingDAO  customdao.ReportDAO
+	nextID     domain.NextID
+}
+
+type CreateReportReq struct {
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	Reason     string `json:"reason"`
+	Details    string `json:"details"`
+	UserID     string `json:"-"`
+}
+
+type CreateReportResp struct {
+	ID         string    `json:"id"`
+	TargetType string    `json:"target_type"`
+	TargetID   string    `json:"target_id"`
+	Reason     string    `json:"reason"`
+	Details    string    `json:"details"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func NewCreateReport(postDAO dao.PostDAO, commentDAO dao.CommentDAO, userDAO dao.UserDAO, reportDAO dao.ReportDAO, filingDAO customdao.ReportDAO, nextID domain.NextID) *CreateReport {
+	return &CreateReport{
+		postDAO:    postDAO,
+		commentDAO: commentDAO,
+		userDAO:    userDAO,
+		reportDAO:  reportDAO,
+		filingDAO:  filingDAO,
+		nextID:     nextID,
+	}
+}
+
+func (s *CreateReport) Exec(ctx context.Context, req *CreateReportReq) (*CreateReportResp, error) {
+	// Only content readers can see can be reported
+	var postID, commentID *string
+	var reportedUserID string
+	switch req.TargetType {
+	case domain.ReportTargetPost:
+		post, err := s.postDAO.FindOne(ctx, "id = $1 AND published_at IS NOT NULL AND hidden_at IS NULL", "", req.TargetID)
+		if err != nil {
+			return nil, fmt.Errorf("post not found: %w", err)
+		}
+		postID = &post.ID
+		reportedUserID = post.AuthorID
+	case domain.ReportTargetComment:
+		comment, err := s.commentDAO.FindOne(ctx, "id = $1 AND status = $2 AND deleted_at IS NULL", "", req.TargetID, domain.CommentStatusApproved)
+		if err != nil {
+			return nil, fmt.Errorf("comment not found: %w", err)
+		}
+		postID = &comment.PostID
+		commentID = &comment.ID
+		reportedUserID = comment.AuthorID
+	case domain.ReportTargetUser:
+		user, err := s.userDAO.FindByPk(ctx, req.TargetID)
+		if err != nil {
+			return nil, fmt.Errorf("user not found: %w", err)
+		}
+		reportedUserID = user.ID
+	default:
+		return nil, fmt.Errorf("failed to create report: unknown report target %s", req.TargetType)
+	}
+
+	report, err := domain.NewReport(s.nextID(), req.UserID, req.TargetType, postID, commentID, reportedUserID, req.Reason, req.Details)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	// Reporting the same thing twice while the first report is open is a no-op
+	existing, err := s.findOpenReport(ctx, report)
+	if err == nil {
+		return newCreateReportResp(existing), nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to load reports: %w", err)
+	}
+
+	inserted, err := s.filingDAO.InsertOpen(ctx, report)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save report: %w", err)
+	}
+	if !inserted {
+		// A concurrent request filed it first
+		existing, err := s.findOpenReport(ctx, report)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load reports: %w", err)
+		}
+		return newCreateReportResp(existing), nil
+	}
+
+	return newCreateReportResp(report), nil
+}
+
+// findOpenReport returns the open report of the same reporter on the same
+// target as the given report.
+func (s *CreateReport) findOpenReport(ctx context.Context, report *domain.Report) (*domain.Report, error) {
+	where, args := sameTargetReportsFilter(report)
+	return s.reportDAO.FindOne(ctx, where+" AND reporter_id = $2", "", append(args, report.ReporterID)...)
+}
+
+func newCreateReportResp(report *domain.Report) *CreateReportResp {
+	return &CreateReportResp{
+		ID:         report.ID,
+		TargetType: report.TargetType,
+		TargetID:   report.TargetID(),
+		Reason:     report.Reason,
+		Details:    report.Details,
+		Status:     report.Status,
+		CreatedAt:  report.CreatedAt,
+	}
+}
+
+// sameTargetReportsFilter matches the open reports on the same post, comment
+// or user as the given report. The target ID is bound to $1.
+func sameTargetReportsFilter(report *domain.Report) (string, []any) {
+	switch report.TargetType {
+	case domain.ReportTargetPost:
+		return "status = 'open' AND target_type = 'post' AND post_id = $1", []any{*report.PostID}
+	case domain.ReportTargetComment:
+		return "status = 'open' AND target_type = 'comment' AND comment_id = $1", []any{*report.CommentID}
+	default:
+		return "status = 'open' AND target_type = 'user' AND reported_user_id = $1", []any{report.ReportedUserID}
+	}
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain/dao"
+)
+
+type DeleteBookmarkCollection struct {
+	collectionDAO dao.BookmarkCollectionDAO
+}
+
+type DeleteBookmarkCollectionReq struct {
+	ID     string `json:"-"`
+	UserID string `json:"-"`
+}
+
+type DeleteBookmarkCollectionResp struct {
+	Deleted bool `json:"deleted"`
+}
+
+func NewDeleteBookmarkCollection(collectionDAO dao.BookmarkCollectionDAO) *DeleteBookmarkCollection {
+	return &DeleteBookmarkCollection{
+		collectionDAO: collectionDAO,
+	}
+}
+
+// Exec deletes the collection, its bookmarks are kept outside of any
+// collection.
+func (s *DeleteBookmarkCollection) Exec(ctx context.Context, req *DeleteBookmarkCollectionReq) (*DeleteBookmarkCollectionResp, error) {
+	collection, err := s.collectionDAO.FindOne(ctx, "id::text = $1 AND user_id = $2", "", req.ID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("collection not found: %w", err)
+	}
+
+	err = s.collectionDAO.DeleteByPk(ctx, collection.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete collection: %w", err)
+	}
+
+	return &DeleteBookmarkCollectionResp{
+		Deleted: true,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type DeleteComment struct {
+	postDAO            dao.PostDAO
+	commentDAO         dao.CommentDAO
+	commentRevisionDAO dao.CommentRevisionDAO
+	realtime           domain.RealtimeHub
+}
+
+type DeleteCommentReq struct {
+	Slug      string `json:"-"`
+	CommentID string `json:"-"`
+	UserID    string `json:"-"`
+}
+
+type DeleteCommentResp struct {
+	Success    bool   `json:"success"`
+	Tombstoned bool   `json:"tombstoned"`
+	Message    string `json:"message"`
+}
+
+func NewDeleteComment(postDAO dao.PostDAO, commentDAO dao.CommentDAO, commentRevisionDAO dao.CommentRevisionDAO, realtime domain.RealtimeHub) *DeleteComment {
+	return &DeleteComment{
+		postDAO:            postDAO,
+		commentDAO:         commentDAO,
+		commentRevisionDAO: commentRevisionDAO,
+		realtime:           realtime,
+	}
+}
+
+func (s *DeleteComment) Exec(ctx context.Context, req *DeleteCommentReq) (*DeleteCommentResp, error) {
+	post, err := s.postDAO.FindOne(ctx, "slug = $1", "", req.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+
+	comment, err := s.commentDAO.FindOne(ctx, "id = $1 AND post_id = $2", "", req.CommentID, post.ID)
+	if err != nil {
+		return nil, fmt.Errorf("comment not found: %w", err)
+	}
+
+	// Post authors moderate the comments on their own posts
+	if comment.AuthorID != req.UserID && post.AuthorID != req.UserID {
+		return nil, fmt.Errorf("unauthorized: you can only delete your own comments or comments on your posts")
+	}
+
+	tombstoned := false
+	err = s.commentDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		// Keep a tombstone when the comment has replies so threads stay
+		// intact. Pending, hidden and rejected replies count too, deleting the
+		// comment would cascade to them.
+		replies, err := s.commentDAO.Count(ctx, "parent_id = $1", comment.ID)
+		if err != nil {
+			return fmt.Errorf("failed to count replies: %w", err)
+		}
+
+		if replies > 0 {
+			comment.Tombstone()
+			tombstoned = true
+			if err := s.commentDAO.Update(ctx, comment); err != nil {
+				return fmt.Errorf("failed to delete comment: %w", err)
+			}
+
+			// Earlier bodies must not outlive the deletion
+			revisions, err := s.commentRevisionDAO.FindAll(ctx, "comment_id = $1", "", comment.ID)
+			if err != nil {
+				return fmt.Errorf("failed to load comment history: %w", err)
+			}
+			revisionIDs := make([]string, 0, len(revisions))
+			for _, revision := range revisions {
+				revisionIDs = append(revisionIDs, revision.ID)
+			}
+			if err := s.commentRevisionDAO.DeleteManyByPks(ctx, revisionIDs); err != nil {
+				return fmt.Errorf("failed to delete comment history: %w", err)
+			}
+			return nil
+		}
+
+		return s.deleteLeaf(ctx, comment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.realtime.Publish(domain.RealtimeEvent{
+		Topic: domain.PostTopic(post.ID),
+		Type:  domain.RealtimeCommentDeleted,
+		Data: map[string]any{
+			"id":         comment.ID,
+			"post_slug":  post.Slug,
+			"tombstoned": tombstoned,
+		},
+	})
+	if err != nil {
+		log.Printf("failed to publish comment %s: %v", comment.ID, err)
+	}
+
+	return &DeleteCommentResp{
+		Success:    true,
+		Tombstoned: tombstoned,
+		Message:    "Comment deleted successfully",
+	}, nil
+}
+
+// deleteLeaf removes a comment without replies and walks up the thread,
+// removing tombstoned parents that no longer have any replies.
+func (s *DeleteComment) deleteLeaf(ctx context.Context, comment *domain.Comment) error {
+	for {
+		if err := s.commentDAO.DeleteByPk(ctx, comment.ID); err != nil {
+			return fmt.Errorf("failed to delete comment: %w", err)
+		}
+
+		if comment.ParentID == nil {
+			return nil
+		}
+
+		if _, err := refreshReplyCount(ctx, s.commentDAO, *comment.ParentID); err != nil {
+			return err
+		}
+
+		parent, err := s.commentDAO.FindByPk(ctx, *comment.ParentID)
+		if err != nil {
+			return fmt.Errorf("parent comment not found: %w", err)
+		}
+
+		if !parent.IsDeleted() {
+			return nil
+		}
+
+		// The reply count only has approved replies
+		replies, err := s.commentDAO.Count(ctx, "parent_id = $1", parent.ID)
+		if err != nil {
+			return fmt.Errorf("failed to count replies: %w", err)
+		}
+		if replies > 0 {
+			return nil
+		}
+		comment = parent
+	}
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type DeleteUser struct {
+	userDAO        dao.UserDAO
+	adminActionDAO dao.AdminActionDAO
+	nextID         domain.NextID
+}
+
+type DeleteUserReq struct {
+	UserID  string `json:"-"`
+	AdminID string `json:"-"`
+	Note    string `json:"note"`
+}
+
+type DeleteUserResp struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+func NewDeleteUser(userDAO dao.UserDAO, adminActionDAO dao.AdminActionDAO, nextID domain.NextID) *DeleteUser {
+	return &DeleteUser{
+		userDAO:        userDAO,
+		adminActionDAO: adminActionDAO,
+		nextID:         nextID,
+	}
+}
+
+// Exec deletes the user along with everything they wrote, the audit trail
+// keeps the username.
+func (s *DeleteUser) Exec(ctx context.Context, req *DeleteUserReq) (*DeleteUserResp, error) {
+	if req.UserID == req.AdminID {
+		return nil, fmt.Errorf("unauthorized: you cannot delete yourself")
+	}
+
+	user, err := s.userDAO.FindByPk(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	note := user.Username
+	if req.Note != "" {
+		note += ": " + req.Note
+	}
+
+	adminAction, err := domain.NewAdminAction(s.nextID(), req.AdminID, domain.AdminActionDeleteUser, nil, domain.ReportTargetUser, user.ID, note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin action: %w", err)
+	}
+
+	err = s.userDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userDAO.DeleteByPk(ctx, user.ID); err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+
+		if err := s.adminActionDAO.Create(ctx, adminAction); err != nil {
+			return fmt.Errorf("failed to save admin action: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteUserResp{
+		Success: true,
+		Message: "User deleted successfully",
+	}, nil
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type DisableProcessorClient struct {
+	clientDAO      dao.ProcessorClientDAO
+	adminActionDAO dao.AdminActionDAO
+	nextID         domain.NextID
+}
+
+type DisableProcessorClientReq struct {
+	ClientID string `json:"-"`
+	AdminID  string `json:"-"`
+	Note     string `json:"note"`
+}
+
+func NewDisableProcessorClient(clientDAO dao.ProcessorClientDAO, adminActionDAO dao.AdminActionDAO, nextID domain.NextID) *DisableProcessorClient {
+	return &DisableProcessorClient{
+		clientDAO:      clientDAO,
+		adminActionDAO: adminActionDAO,
+		nextID:         nextID,
+	}
+}
+
+func (s *DisableProcessorClient) Exec(ctx context.Context, req *DisableProcessorClientReq) (*ProcessorClientItem, error) {
+	client, err := s.clientDAO.FindOne(ctx, "id::text = $1", "", req.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("processor client not found: %w", err)
+	}
+
+	if !client.IsActive() {
+		item := newProcessorClientItem(client)
+		return &item, nil
+	}
+
+	client.Disable()
+
+	adminAction, err := domain.NewAdminAction(s.nextID(), req.AdminID, domain.AdminActionDisableProcessorClient, nil, "processor_client", client.ID, req.Note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin action: %w", err)
+	}
+
+	err = s.clientDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.clientDAO.Update(ctx, client); err != nil {
+			return fmt.Errorf("failed to save processor client: %w", err)
+		}
+
+		if err := s.adminActionDAO.Create(ctx, adminAction); err != nil {
+			return fmt.Errorf("failed to save admin action: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	item := newProcessorClientItem(client)
+	return &item, nil
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+type ExchangeOAuthCode struct {
+	userDAO      dao.UserDAO
+	oauthCodeDAO customdao.OAuthCodeDAO
+	tokens       *TokenIssuer
+}
+
+type ExchangeOAuthCodeReq struct {
+	Code   string     `json:"code"`
+	Client ClientInfo `json:"-"`
+}
+
+type SignedInUser struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+type ExchangeOAuthCodeResp struct {
+	TokenPair
+	Provider string       `json:"provider"`
+	User     SignedInUser `json:"user"`
+}
+
+func NewExchangeOAuthCode(userDAO dao.UserDAO, oauthCodeDAO customdao.OAuthCodeDAO, tokens *TokenIssuer) *ExchangeOAuthCode {
+	return &ExchangeOAuthCode{
+		userDAO:      userDAO,
+		oauthCodeDAO: oauthCodeDAO,
+		tokens:       tokens,
+	}
+}
+
+func (s *ExchangeOAuthCode) Exec(ctx context.Context, req *ExchangeOAuthCodeReq) (*ExchangeOAuthCodeResp, error) {
+	// Codes work once, expired or not: only the request deleting it gets it
+	code, err := s.oauthCodeDAO.Consume(ctx, domain.HashToken(req.Code))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("invalid code")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to consume code: %w", err)
+	}
+
+	if code.IsExpired(time.Now()) {
+		return nil, fmt.Errorf("invalid code")
+	}
+
+	user, err := s.userDAO.FindByPk(ctx, code.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid code")
+	}
+
+	if user.IsSuspended() {
+		return nil, fmt.Errorf("account suspended")
+	}
+
+	tokens, err := s.tokens.StartSession(ctx, user, req.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExchangeOAuthCodeResp{
+		TokenPair: *tokens,
+		Provider:  code.Provider,
+		User: SignedInUser{
+			ID:       user.ID,
+			Email:    user.Email,
+			Username: user.Username,
+			Role:     user.Role,
+		},
+	}, nil
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// AccountExportVersion changes when the layout of exports does.
+const AccountExportVersion = 1
+
+type ExportAccountData struct {
+	data AccountData
+}
+
+type ExportAccountDataReq struct {
+	UserID string
+}
+
+// AccountExport holds the account row and the rows of every table about the
+// user, keyed by table and column names. Hashed secrets are left out.
+type AccountExport struct {
+	FormatVersion int                         `json:"format_version"`
+	ExportedAt    time.Time                   `json:"exported_at"`
+	Account       map[string]any              `json:"account"`
+	Tables        map[string][]map[string]any `json:"tables"`
+}
+
+func NewExportAccountData(data AccountData) *ExportAccountData {
+	return &ExportAccountData{
+		data: data,
+	}
+}
+
+func (s *ExportAccountData) Exec(ctx context.Context, req *ExportAccountDataReq) (*AccountExport, error) {
+	d := s.data
+
+	user, err := d.Users.FindByPk(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	export := &AccountExport{
+		FormatVersion: AccountExportVersion,
+		ExportedAt:    time.Now(),
+		Account:       exportRow(user),
+		Tables:        make(map[string][]map[string]any),
+	}
+
+	tables := []struct {
+		name string
+		rows func() ([]map[string]any, error)
+	}{
+		{"posts", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Posts.FindAll, "author_id = $1", user.ID)
+		}},
+		{"comments", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Comments.FindAll, "author_id = $1", user.ID)
+		}},
+		{"comment_revisions", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.CommentRevisions.FindAll, "editor_id = $1", user.ID)
+		}},
+		{"reactions", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Reactions.FindAll, "user_id = $1", user.ID)
+		}},
+		{"bookmarks", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Bookmarks.FindAll, "user_id = $1", user.ID)
+		}},
+		{"bookmark_collections", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.BookmarkCollections.FindAll, "user_id = $1", user.ID)
+		}},
+		{"follows", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Follows.FindAll, "follower_id = $1 OR followee_id = $1", user.ID)
+		}},
+		{"notifications", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Notifications.FindAll, "recipient_id = $1", user.ID)
+		}},
+		{"mentions", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Mentions.FindAll, "mentioned_user_id = $1 OR author_id = $1", user.ID)
+		}},
+		{"user_blocks", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Blocks.FindAll, "blocker_id = $1", user.ID)
+		}},
+		{"user_mutes", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Mutes.FindAll, "muter_id = $1", user.ID)
+		}},
+		{"reports", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Reports.FindAll, "reporter_id = $1", user.ID)
+		}},
+		{"admin_actions", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.AdminActions.FindAll, "admin_id = $1", user.ID)
+		}},
+		{"sessions", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Sessions.FindAll, "user_id = $1", user.ID)
+		}},
+		{"identities", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Identities.FindAll, "user_id = $1", user.ID)
+		}},
+		{"personal_access_tokens", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.PersonalAccessTokens.FindAll, "user_id = $1", user.ID)
+		}},
+		{"avatars", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.Avatars.FindAll, "user_id = $1", user.ID)
+		}},
+		{"handle_aliases", func() ([]map[string]any, error) {
+			return exportWhere(ctx, d.HandleAliases.FindAll, "user_id = $1", user.ID)
+		}},
+	}
+
+	for _, table := range tables {
+		rows, err := table.rows()
+		if err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", table.name, err)
+		}
+		export.Tables[table.name] = rows
+	}
+
+	return export, nil
+}
+
+func exportWhere[T any](
+	ctx context.Context,
+	find func(ctx context.Context, where string, sort string, args ...interface{}) ([]*T, error),
+	where string,
+	args ...interface{},
+) ([]map[string]any, error) {
+	found, err := find(ctx, where, "", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]map[string]any, 0, len(found))
+	for _, m := range found {
+		rows = append(rows, exportRow(m))
+	}
+
+	return rows, nil
+}
+
+// exportRow maps the columns of a row to their values, by the sql tags of
+// the entity.
+func exportRow(m any) map[string]any {
+	row := make(map[string]any)
+
+	v := reflect.Indirect(reflect.ValueOf(m))
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		column, _, _ := strings.Cut(t.Field(i).Tag.Get("sql"), ",")
+		if column == "" || strings.HasSuffix(column, "_hash") {
+			continue
+		}
+		row[column] = v.Field(i).Interface()
+	}
+
+	return row
+}
@@ -5,9 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/golang-jwt/jwt"
 	"golang.org/x/oauth2"
 
 	"blog0/config"
@@ -15,8 +15,14 @@ import (
 	"blog0/internal/domain/dao"
 )
 
+// oauthCodeTTL is how long the web app has to exchange the one-time code.
+const oauthCodeTTL = time.Minute
+
 type FinishOAuthReq struct {
-	Code string `json:"code"`
+	Provider    string `json:"-"`
+	Code        string `json:"code"`
+	State       string `json:"state"`
+	SignedState string `json:"-"`
 }
 
 type FinishOAuthResp struct {
@@ -24,85 +30,216 @@ type FinishOAuthResp struct {
 }
 
 type FinishOAuth struct {
-	userDAO            dao.UserDAO
-	oauthCfg           *oauth2.Config
-	oauthInfoExtractor domain.InfoExtractor
-	nextID             domain.NextID
-	cfg                config.Config
+	userDAO      dao.UserDAO
+	identityDAO  dao.IdentityDAO
+	oauthCodeDAO dao.OAuthCodeDAO
+	handles      *Handles
+	providers    *OAuthProviders
+	nextID       domain.NextID
+	cfg          config.Config
 }
 
 func NewFinishOAuth(
 	userDAO dao.UserDAO,
-	oauthCfg *oauth2.Config,
-	oauthInfoExtractor domain.InfoExtractor,
+	identityDAO dao.IdentityDAO,
+	oauthCodeDAO dao.OAuthCodeDAO,
+	handles *Handles,
+	providers *OAuthProviders,
 	nextID domain.NextID,
 	cfg config.Config,
 ) *FinishOAuth {
 	return &FinishOAuth{
-		userDAO:            userDAO,
-		oauthCfg:           oauthCfg,
-		oauthInfoExtractor: oauthInfoExtractor,
-		nextID:             nextID,
-		cfg:                cfg,
+		userDAO:      userDAO,
+		identityDAO:  identityDAO,
+		oauthCodeDAO: oauthCodeDAO,
+		handles:      handles,
+		providers:    providers,
+		nextID:       nextID,
+		cfg:          cfg,
 	}
 }
 
 func (s *FinishOAuth) Exec(ctx context.Context, req FinishOAuthReq) (*FinishOAuthResp, error) {
-	token, err := s.oauthCfg.Exchange(ctx, req.Code)
+	provider, err := s.providers.Get(req.Provider)
 	if err != nil {
 		return nil, err
 	}
 
-	username, email, err := s.oauthInfoExtractor(token.AccessToken)
+	state, err := verifyOAuthState([]byte(s.cfg.JWTSecret), req.SignedState, provider.Name, req.State)
 	if err != nil {
 		return nil, err
 	}
 
-	user, err := s.userDAO.FindOne(ctx, "email = $1", "", email)
-	if err != nil && errors.Is(err, sql.ErrNoRows) {
-		newUser, err := domain.NewUser(s.nextID(), email, username)
-		if err != nil {
-			return nil, err
-		}
+	token, err := provider.Config.Exchange(ctx, req.Code, oauth2.VerifierOption(state.Verifier))
+	if err != nil {
+		return nil, err
+	}
 
-		err = s.userDAO.Create(ctx, newUser)
-		if err != nil {
+	info, err := provider.InfoExtractor(ctx, token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.LinkUserID != "" {
+		return s.link(ctx, provider, state.LinkUserID, info)
+	}
+
+	user, err := s.signIn(ctx, provider, info)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsSuspended() {
+		return nil, fmt.Errorf("account suspended")
+	}
+
+	// The first admins are bootstrapped from the configuration
+	if isAdminEmail(s.cfg.AdminEmails, user.Email) && user.Role != domain.RoleAdmin {
+		user.Role = domain.RoleAdmin
+		if err := s.userDAO.Update(ctx, user); err != nil {
 			return nil, err
 		}
+	}
 
-		user = newUser
-	} else if err != nil {
+	// Tokens stay out of the redirect, the web app exchanges this code for them
+	code, err := domain.NewOpaqueToken()
+	if err != nil {
 		return nil, err
 	}
 
-	tokenString, err := generateToken(user.ID, user.Email, []byte(s.cfg.JWTSecret))
+	oauthCode, err := domain.NewOAuthCode(s.nextID(), user.ID, provider.Name, code, time.Now().Add(oauthCodeTTL))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to create code: %w", err)
+	}
+
+	if err := s.oauthCodeDAO.Create(ctx, oauthCode); err != nil {
+		return nil, fmt.Errorf("failed to save code: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/auth/callback/google?token=%s&id=%s&email=%s&username=%s",
-		s.cfg.WebBaseURI,
-		tokenString,
-		user.ID,
-		user.Email,
-		user.Username,
-	)
+	url := fmt.Sprintf("%s/auth/callback/%s?code=%s", s.cfg.WebBaseURI, provider.Name, code)
 
 	return &FinishOAuthResp{
 		URL: url,
 	}, nil
 }
 
-func generateToken(userID string, userEmail string, jwtSecret []byte) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"user_id": userID,
-		"email":   userEmail,
-		"exp":     time.Now().Add(24 * time.Hour).Unix(),
+// signIn finds the user behind a provider account, by its identity first.
+// Users without any identity yet, from before identities existed, are
+// matched by the verified email and get the identity attached. Other emails
+// belonging to an existing user are refused: linking a provider to an
+// account is done from /me/identities.
+func (s *FinishOAuth) signIn(ctx context.Context, provider *OAuthProvider, info *domain.OAuthUserInfo) (*domain.User, error) {
+	identity, err := s.identityDAO.FindOne(ctx, "provider = $1 AND subject = $2", "", provider.Name, info.Subject)
+	if err == nil {
+		identity.Use(info.Email)
+		if err := s.identityDAO.Update(ctx, identity); err != nil {
+			return nil, fmt.Errorf("failed to save identity: %w", err)
+		}
+
+		user, err := s.userDAO.FindByPk(ctx, identity.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("user not found: %w", err)
+		}
+		return user, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to load identity: %w", err)
+	}
+
+	// New provider accounts must vouch for an email to start from
+	if info.Email == "" {
+		return nil, fmt.Errorf("%s did not share a verified email", provider.Name)
+	}
+
+	var user *domain.User
+	err = s.userDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		user, err = s.userDAO.FindOne(ctx, "email = $1", "", info.Email)
+		if errors.Is(err, sql.ErrNoRows) {
+			username := info.Username
+			if username == "" {
+				username, _, _ = strings.Cut(info.Email, "@")
+			}
+
+			handle, err := s.handles.Allocate(ctx, username, info.Email)
+			if err != nil {
+				return err
+			}
+
+			user, err = domain.NewUser(s.nextID(), info.Email, username, handle)
+			if err != nil {
+				return err
+			}
+
+			if err := s.userDAO.Create(ctx, user); err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		} else {
+			linked, err := s.identityDAO.Count(ctx, "user_id = $1", user.ID)
+			if err != nil {
+				return fmt.Errorf("failed to count identities: %w", err)
+			}
+			if linked > 0 {
+				return fmt.Errorf("unauthorized: %s is used by an account, sign in to it and link %s from your settings", info.Email, provider.Name)
+			}
+		}
+
+		identity, err := domain.NewIdentity(s.nextID(), user.ID, provider.Name, info.Subject, info.Email)
+		if err != nil {
+			return fmt.Errorf("failed to create identity: %w", err)
+		}
+
+		if err := s.identityDAO.Create(ctx, identity); err != nil {
+			return fmt.Errorf("failed to save identity: %w", err)
+		}
+
+		return nil
 	})
-	tokenString, err := token.SignedString(jwtSecret)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return tokenString, nil
+	return user, nil
+}
+
+// link attaches a provider account to a signed in user and sends them back
+// to their settings.
+func (s *FinishOAuth) link(ctx context.Context, provider *OAuthProvider, userID string, info *domain.OAuthUserInfo) (*FinishOAuthResp, error) {
+	identity, err := s.identityDAO.FindOne(ctx, "provider = $1 AND subject = $2", "", provider.Name, info.Subject)
+	if err == nil {
+		if identity.UserID != userID {
+			return nil, fmt.Errorf("unauthorized: this %s account is linked to another user", provider.Name)
+		}
+	} else if errors.Is(err, sql.ErrNoRows) {
+		if _, err := s.identityDAO.FindOne(ctx, "user_id = $1 AND provider = $2", "", userID, provider.Name); err == nil {
+			return nil, fmt.Errorf("unauthorized: another %s account is linked already, unlink it first", provider.Name)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to load identity: %w", err)
+		}
+
+		identity, err = domain.NewIdentity(s.nextID(), userID, provider.Name, info.Subject, info.Email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create identity: %w", err)
+		}
+
+		if err := s.identityDAO.Create(ctx, identity); err != nil {
+			return nil, fmt.Errorf("failed to save identity: %w", err)
+		}
+	} else {
+		return nil, fmt.Errorf("failed to load identity: %w", err)
+	}
+
+	return &FinishOAuthResp{
+		URL: fmt.Sprintf("%s/settings/identities?linked=%s", s.cfg.WebBaseURI, provider.Name),
+	}, nil
+}
+
+func isAdminEmail(adminEmails string, email string) bool {
+	for _, adminEmail := range strings.Split(adminEmails, ",") {
+		if strings.EqualFold(strings.TrimSpace(adminEmail), email) {
+			return true
+		}
+	}
+	return false
 }
@@ -3,15 +3,20 @@ package services
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
 	"blog0/internal/domain/dao"
 )
 
 type FollowUser struct {
-	userDAO   dao.UserDAO
-	followDAO dao.FollowDAO
-	nextID    domain.NextID
+	userDAO     dao.UserDAO
+	followDAO   dao.FollowDAO
+	relationDAO customdao.RelationDAO
+	blockDAO    dao.BlockDAO
+	notifier    *Notifier
+	nextID      domain.NextID
 }
 
 type FollowUserReq struct {
@@ -24,11 +29,14 @@ type FollowUserResp struct {
 	FollowersCount int  `json:"followers_count"`
 }
 
-func NewFollowUser(userDAO dao.UserDAO, followDAO dao.FollowDAO, nextID domain.NextID) *FollowUser {
+func NewFollowUser(userDAO dao.UserDAO, followDAO dao.FollowDAO, relationDAO customdao.RelationDAO, blockDAO dao.BlockDAO, notifier *Notifier, nextID domain.NextID) *FollowUser {
 	return &FollowUser{
-		userDAO:   userDAO,
-		followDAO: followDAO,
-		nextID:    nextID,
+		userDAO:     userDAO,
+		followDAO:   followDAO,
+		relationDAO: relationDAO,
+		blockDAO:    blockDAO,
+		notifier:    notifier,
+		nextID:      nextID,
 	}
 }
 
@@ -43,9 +51,20 @@ func (s *FollowUser) Exec(ctx context.Context, req *FollowUserReq) (*FollowUserR
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
-	existingFollow, err := s.followDAO.FindOne(ctx, "follower_id = $1 AND followee_id = $2", "", req.UserID, req.AuthorID)
-	if err == nil && existingFollow != nil {
-		return nil, fmt.Errorf("user is already following this author")
+	blocked, err := isBlocked(ctx, s.blockDAO, req.AuthorID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, fmt.Errorf("unauthorized: you cannot follow this user")
+	}
+
+	blocked, err = isBlocked(ctx, s.blockDAO, req.UserID, req.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, fmt.Errorf("unauthorized: unblock this user before following them")
 	}
 
 	newFollow, err := domain.NewFollow(s.nextID(), req.UserID, req.AuthorID)
@@ -53,11 +72,24 @@ func (s *FollowUser) Exec(ctx context.Context, req *FollowUserReq) (*FollowUserR
 		return nil, fmt.Errorf("failed to create follow: %w", err)
 	}
 
-	err = s.followDAO.Create(ctx, newFollow)
+	// Following twice is a no-op, only a new follow notifies the author
+	created, err := s.relationDAO.InsertFollow(ctx, newFollow)
 	if err != nil {
 		return nil, fmt.Errorf("failed to save follow: %w", err)
 	}
 
+	if created {
+		err = s.notifier.Notify(ctx, &NotifyReq{
+			RecipientID: req.AuthorID,
+			ActorID:     req.UserID,
+			Type:        domain.NotificationTypeFollow,
+		})
+		if err != nil {
+			// The follow is saved, the author misses the notification only
+			log.Printf("failed to notify follow of %s: %v", req.AuthorID, err)
+		}
+	}
+
 	followersCount, err := s.followDAO.Count(ctx, "followee_id = $1", req.AuthorID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to count followers: %w", err)
@@ -67,4 +99,4 @@ func (s *FollowUser) Exec(ctx context.Context, req *FollowUserReq) (*FollowUserR
 		Following:      true,
 		FollowersCount: int(followersCount),
 	}, nil
-}
\ No newline at end of file
+}
@@ -4,17 +4,21 @@ import (
 	"context"
 	"fmt"
 
+	"blog0/internal/domain/customdao"
 	"blog0/internal/domain/dao"
 )
 
 type GetAuthorInfo struct {
-	userDAO     dao.UserDAO
-	postDAO     dao.PostDAO
-	postLikeDAO dao.PostLikeDAO
+	users      *UserResolver
+	postDAO    dao.PostDAO
+	followDAO  dao.FollowDAO
+	rankingDAO customdao.RankingDAO
 }
 
+// GetAuthorInfoReq takes the author ID or their handle, like @jane.
 type GetAuthorInfoReq struct {
 	AuthorID string
+	ViewerID string
 }
 
 type TopPostInfo struct {
@@ -25,60 +29,79 @@ type TopPostInfo struct {
 }
 
 type GetAuthorInfoResp struct {
-	ID             string        `json:"id"`
-	Name           string        `json:"name"`
-	PostsCount     int           `json:"posts_count"`
-	FollowersCount int           `json:"followers_count"`
-	TopPosts       []TopPostInfo `json:"top_posts"`
+	UserProfile
+	Name           string `json:"name"`
+	PostsCount     int    `json:"posts_count"`
+	FollowersCount int    `json:"followers_count"`
+	FollowingCount int    `json:"following_count"`
+	// IsFollowing tells whether the viewer follows the author, null when not signed in.
+	IsFollowing *bool `json:"is_following"`
+	// TopPosts are the five most liked posts of the author.
+	TopPosts []TopPostInfo `json:"top_posts"`
 }
 
-func NewGetAuthorInfo(userDAO dao.UserDAO, postDAO dao.PostDAO, postLikeDAO dao.PostLikeDAO) *GetAuthorInfo {
+func NewGetAuthorInfo(users *UserResolver, postDAO dao.PostDAO, followDAO dao.FollowDAO, rankingDAO customdao.RankingDAO) *GetAuthorInfo {
 	return &GetAuthorInfo{
-		userDAO:     userDAO,
-		postDAO:     postDAO,
-		postLikeDAO: postLikeDAO,
+		users:      users,
+		postDAO:    postDAO,
+		followDAO:  followDAO,
+		rankingDAO: rankingDAO,
 	}
 }
 
 func (s *GetAuthorInfo) Exec(ctx context.Context, req *GetAuthorInfoReq) (*GetAuthorInfoResp, error) {
-	author, err := s.userDAO.FindByPk(ctx, req.AuthorID)
+	author, err := s.users.Resolve(ctx, req.AuthorID)
 	if err != nil {
 		return nil, fmt.Errorf("author not found: %w", err)
 	}
 
-	postsCount, err := s.postDAO.Count(ctx, "author_id = $1 AND published_at IS NOT NULL", req.AuthorID)
+	postsCount, err := s.postDAO.Count(ctx, "author_id = $1 AND published_at IS NOT NULL AND hidden_at IS NULL", author.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to count author posts: %w", err)
 	}
 
-	// TODO: Implement followers count when FollowDAO is available
-	followersCount := int64(0)
+	followersCount, err := s.followDAO.Count(ctx, "followee_id = $1", author.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count followers: %w", err)
+	}
 
-	topPosts, err := s.postDAO.FindPaginated(ctx, 5, 0, "author_id = $1 AND published_at IS NOT NULL", "published_at DESC", req.AuthorID)
+	followingCount, err := s.followDAO.Count(ctx, "follower_id = $1", author.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch top posts: %w", err)
+		return nil, fmt.Errorf("failed to count followed users: %w", err)
 	}
 
-	topPostInfos := make([]TopPostInfo, 0)
-	for _, post := range topPosts {
-		likesCount, err := s.postLikeDAO.Count(ctx, "post_id = $1", post.ID)
+	var isFollowing *bool
+	if req.ViewerID != "" {
+		follows, err := s.followDAO.Count(ctx, "follower_id = $1 AND followee_id = $2", req.ViewerID, author.ID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to count likes for post %s: %w", post.ID, err)
+			return nil, fmt.Errorf("failed to check follow: %w", err)
 		}
+		following := follows > 0
+		isFollowing = &following
+	}
 
+	topPosts, err := s.rankingDAO.FindMostLikedPosts(ctx, 5, "author_id = $1 AND published_at IS NOT NULL AND hidden_at IS NULL", author.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch top posts: %w", err)
+	}
+
+	topPostInfos := make([]TopPostInfo, 0, len(topPosts))
+	for _, post := range topPosts {
 		topPostInfos = append(topPostInfos, TopPostInfo{
 			ID:         post.ID,
 			Title:      post.Title,
 			Slug:       post.Slug,
-			LikesCount: int(likesCount),
+			LikesCount: post.LikesCount,
 		})
 	}
 
 	return &GetAuthorInfoResp{
-		ID:             author.ID,
-		Name:           author.Username,
+		UserProfile:    newUserProfile(author),
+		Name:           author.Name(),
 		PostsCount:     int(postsCount),
 		FollowersCount: int(followersCount),
+		FollowingCount: int(followingCount),
+		IsFollowing:    isFollowing,
 		TopPosts:       topPostInfos,
 	}, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type GetAvatar struct {
+	avatarDAO dao.AvatarDAO
+}
+
+type GetAvatarReq struct {
+	UserID string
+}
+
+func NewGetAvatar(avatarDAO dao.AvatarDAO) *GetAvatar {
+	return &GetAvatar{
+		avatarDAO: avatarDAO,
+	}
+}
+
+func (s *GetAvatar) Exec(ctx context.Context, req *GetAvatarReq) (*domain.Avatar, error) {
+	avatar, err := s.avatarDAO.FindOne(ctx, "user_id::text = $1", "", req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("avatar not found: %w", err)
+	}
+
+	return avatar, nil
+}
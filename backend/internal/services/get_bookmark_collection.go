@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+type GetBookmarkCollection struct {
+	collectionDAO dao.BookmarkCollectionDAO
+	bookmarkDAO   dao.BookmarkDAO
+	userDAO       dao.UserDAO
+	activityDAO   customdao.ActivityDAO
+}
+
+type GetBookmarkCollectionReq struct {
+	ID       string
+	ViewerID string
+	Page     int
+	PerPage  int
+}
+
+// CollectionItem is a bookmark as others see it, without its note and read
+// status.
+type CollectionItem struct {
+	Post    PostCard  `json:"post"`
+	AddedAt time.Time `json:"added_at"`
+}
+
+type GetBookmarkCollectionResp struct {
+	Collection BookmarkCollectionInfo `json:"collection"`
+	Owner      UserCard               `json:"owner"`
+	Page       int                    `json:"page"`
+	PerPage    int                    `json:"per_page"`
+	Items      []CollectionItem       `json:"items"`
+}
+
+func NewGetBookmarkCollection(collectionDAO dao.BookmarkCollectionDAO, bookmarkDAO dao.BookmarkDAO, userDAO dao.UserDAO, activityDAO customdao.ActivityDAO) *GetBookmarkCollection {
+	return &GetBookmarkCollection{
+		collectionDAO: collectionDAO,
+		bookmarkDAO:   bookmarkDAO,
+		userDAO:       userDAO,
+		activityDAO:   activityDAO,
+	}
+}
+
+// Exec returns a public collection, private ones are only found by their
+// owner.
+func (s *GetBookmarkCollection) Exec(ctx context.Context, req *GetBookmarkCollectionReq) (*GetBookmarkCollectionResp, error) {
+	collection, err := s.collectionDAO.FindOne(ctx, "id::text = $1 AND (is_public OR user_id::text = $2)", "", req.ID, req.ViewerID)
+	if err != nil {
+		return nil, fmt.Errorf("collection not found: %w", err)
+	}
+
+	owner, err := s.userDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", collection.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("collection not found: %w", err)
+	}
+
+	where := "collection_id = $1 AND " + visiblePostsFilter
+	total, err := s.bookmarkDAO.Count(ctx, where, collection.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count bookmarks: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	bookmarks, err := s.activityDAO.FindBookmarkedPosts(ctx, req.PerPage, offset, where, collection.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bookmarks: %w", err)
+	}
+
+	items := make([]CollectionItem, 0, len(bookmarks))
+	for _, bookmarked := range bookmarks {
+		items = append(items, CollectionItem{
+			Post:    newPostCard(&bookmarked.Post),
+			AddedAt: bookmarked.Bookmark.CreatedAt,
+		})
+	}
+
+	return &GetBookmarkCollectionResp{
+		Collection: newBookmarkCollectionInfo(collection, int(total)),
+		Owner:      newUserCard(owner),
+		Page:       req.Page,
+		PerPage:    req.PerPage,
+		Items:      items,
+	}, nil
+}
+
+func (s *GetBookmarkCollection) ParseRequest(c *gin.Context) (*GetBookmarkCollectionReq, error) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	return &GetBookmarkCollectionReq{
+		ID:       c.Param("id"),
+		ViewerID: c.GetString("user_id"),
+		Page:     page,
+		PerPage:  perPage,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type GetCommentHistory struct {
+	postDAO            dao.PostDAO
+	commentDAO         dao.CommentDAO
+	commentRevisionDAO dao.CommentRevisionDAO
+}
+
+type GetCommentHistoryReq struct {
+	Slug      string
+	CommentID string
+}
+
+type CommentRevisionInfo struct {
+	Body       string    `json:"body"`
+	ReplacedAt time.Time `json:"replaced_at"`
+}
+
+type GetCommentHistoryResp struct {
+	ID          string                `json:"id"`
+	CurrentBody string                `json:"current_body"`
+	Edited      bool                  `json:"edited"`
+	EditedAt    *time.Time            `json:"edited_at"`
+	Revisions   []CommentRevisionInfo `json:"revisions"`
+}
+
+func NewGetCommentHistory(postDAO dao.PostDAO, commentDAO dao.CommentDAO, commentRevisionDAO dao.CommentRevisionDAO) *GetCommentHistory {
+	return &GetCommentHistory{
+		postDAO:            postDAO,
+		commentDAO:         commentDAO,
+		commentRevisionDAO: commentRevisionDAO,
+	}
+}
+
+func (s *GetCommentHistory) Exec(ctx context.Context, req *GetCommentHistoryReq) (*GetCommentHistoryResp, error) {
+	post, err := s.postDAO.FindOne(ctx, "slug = $1 AND hidden_at IS NULL", "", req.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+
+	comment, err := s.commentDAO.FindOne(ctx, "id = $1 AND post_id = $2 AND status = $3", "", req.CommentID, post.ID, domain.CommentStatusApproved)
+	if err != nil {
+		return nil, fmt.Errorf("comment not found: %w", err)
+	}
+
+	// The history of a deleted comment is gone with it
+	if comment.IsDeleted() {
+		return &GetCommentHistoryResp{
+			ID:        comment.ID,
+			Revisions: make([]CommentRevisionInfo, 0),
+		}, nil
+	}
+
+	revisions, err := s.commentRevisionDAO.FindAll(ctx, "comment_id = $1", "created_at DESC", comment.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load comment history: %w", err)
+	}
+
+	revisionInfos := make([]CommentRevisionInfo, 0, len(revisions))
+	for _, revision := range revisions {
+		revisionInfos = append(revisionInfos, CommentRevisionInfo{
+			Body:       revision.Body,
+			ReplacedAt: revision.CreatedAt,
+		})
+	}
+
+	return &GetCommentHistoryResp{
+		ID:          comment.ID,
+		CurrentBody: comment.Body,
+		Edited:      comment.IsEdited(),
+		EditedAt:    comment.EditedAt,
+		Revisions:   revisionInfos,
+	}, nil
+}
@@ -3,21 +3,29 @@ package services
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
 	"blog0/internal/domain/dao"
 )
 
 type GetPostBySlug struct {
-	postDAO     dao.PostDAO
-	userDAO     dao.UserDAO
-	commentDAO  dao.CommentDAO
-	postLikeDAO dao.PostLikeDAO
+	postDAO    dao.PostDAO
+	userDAO    dao.UserDAO
+	commentDAO dao.CommentDAO
+	reactions  *ReactionCounter
+	mentions   *MentionTracker
+	rankingDAO customdao.RankingDAO
 }
 
 type GetPostBySlugReq struct {
-	Slug string
+	Slug     string
+	ViewerID string
+	// VisitorKey counts the view for the rankings, see domain.VisitorKey.
+	VisitorKey string
 }
 
 type AuthorInfo struct {
@@ -26,39 +34,47 @@ type AuthorInfo struct {
 }
 
 type CommentInfo struct {
-	ID        string     `json:"id"`
-	Author    AuthorInfo `json:"author"`
-	ParentID  *string    `json:"parent_id"`
-	Body      string     `json:"body"`
-	CreatedAt time.Time  `json:"created_at"`
+	ID        string          `json:"id"`
+	Author    AuthorInfo      `json:"author"`
+	ParentID  *string         `json:"parent_id"`
+	Body      string          `json:"body"`
+	Edited    bool            `json:"edited"`
+	Deleted   bool            `json:"deleted"`
+	Reactions []ReactionCount `json:"reactions"`
+	Mentions  []MentionedUser `json:"mentions"`
+	CreatedAt time.Time       `json:"created_at"`
 }
 
 type GetPostBySlugResp struct {
-	ID                  string        `json:"id"`
-	Title               string        `json:"title"`
-	Summary             string        `json:"summary"`
-	Tags                []string      `json:"tags"`
-	Slug                string        `json:"slug"`
-	RawMarkdown         string        `json:"raw_markdown"`
-	Author              AuthorInfo    `json:"author"`
-	PublishedAt         time.Time     `json:"published_at"`
-	LikesCount          int           `json:"likes_count"`
-	Comments            []CommentInfo `json:"comments"`
-	RawMarkdownAudioURL *string       `json:"raw_markdown_audio_url"`
-	SummaryAudioURL     *string       `json:"summary_audio_url"`
+	ID                  string          `json:"id"`
+	Title               string          `json:"title"`
+	Summary             string          `json:"summary"`
+	Tags                []string        `json:"tags"`
+	Slug                string          `json:"slug"`
+	RawMarkdown         string          `json:"raw_markdown"`
+	Author              AuthorInfo      `json:"author"`
+	PublishedAt         time.Time       `json:"published_at"`
+	LikesCount          int             `json:"likes_count"`
+	Reactions           []ReactionCount `json:"reactions"`
+	Mentions            []MentionedUser `json:"mentions"`
+	Comments            []CommentInfo   `json:"comments"`
+	RawMarkdownAudioURL *string         `json:"raw_markdown_audio_url"`
+	SummaryAudioURL     *string         `json:"summary_audio_url"`
 }
 
-func NewGetPostBySlug(postDAO dao.PostDAO, userDAO dao.UserDAO, commentDAO dao.CommentDAO, postLikeDAO dao.PostLikeDAO) *GetPostBySlug {
+func NewGetPostBySlug(postDAO dao.PostDAO, userDAO dao.UserDAO, commentDAO dao.CommentDAO, reactions *ReactionCounter, mentions *MentionTracker, rankingDAO customdao.RankingDAO) *GetPostBySlug {
 	return &GetPostBySlug{
-		postDAO:     postDAO,
-		userDAO:     userDAO,
-		commentDAO:  commentDAO,
-		postLikeDAO: postLikeDAO,
+		postDAO:    postDAO,
+		userDAO:    userDAO,
+		commentDAO: commentDAO,
+		reactions:  reactions,
+		mentions:   mentions,
+		rankingDAO: rankingDAO,
 	}
 }
 
 func (s *GetPostBySlug) Exec(ctx context.Context, req *GetPostBySlugReq) (*GetPostBySlugResp, error) {
-	post, err := s.postDAO.FindOne(ctx, "slug = $1", "", req.Slug)
+	post, err := s.postDAO.FindOne(ctx, "slug = $1 AND hidden_at IS NULL", "", req.Slug)
 	if err != nil {
 		return nil, fmt.Errorf("post not found: %w", err)
 	}
@@ -68,7 +84,21 @@ func (s *GetPostBySlug) Exec(ctx context.Context, req *GetPostBySlugReq) (*GetPo
 		return nil, fmt.Errorf("author not found: %w", err)
 	}
 
-	comments, err := s.commentDAO.FindAll(ctx, "post_id = $1", "created_at ASC", post.ID)
+	// A view that can't be counted doesn't keep the post from being read
+	if req.VisitorKey != "" && post.PublishedAt != nil && req.ViewerID != post.AuthorID {
+		if _, err := s.rankingDAO.InsertView(ctx, post.ID, req.VisitorKey, time.Now()); err != nil {
+			log.Printf("failed to record view of post %s: %v", post.ID, err)
+		}
+	}
+
+	where := "post_id = $1 AND status = $2"
+	args := []any{post.ID, domain.CommentStatusApproved}
+	if req.ViewerID != "" {
+		where += " AND " + hiddenAuthorsFilter("author_id", "$3")
+		args = append(args, req.ViewerID)
+	}
+
+	comments, err := s.commentDAO.FindAll(ctx, where, "created_at ASC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load comments: %w", err)
 	}
@@ -93,9 +123,28 @@ func (s *GetPostBySlug) Exec(ctx context.Context, req *GetPostBySlugReq) (*GetPo
 		commentAuthorsMap[author.ID] = author
 	}
 
-	likesCount, err := s.postLikeDAO.Count(ctx, "post_id = $1", post.ID)
+	postReactions, err := s.reactions.ForPosts(ctx, []string{post.ID}, req.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	commentIDs := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		commentIDs = append(commentIDs, comment.ID)
+	}
+	commentReactions, err := s.reactions.ForComments(ctx, commentIDs, req.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	postMentions, err := s.mentions.ForPost(ctx, post.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	commentMentions, err := s.mentions.ForComments(ctx, commentIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count likes: %w", err)
+		return nil, err
 	}
 
 	commentInfos := make([]CommentInfo, 0)
@@ -105,13 +154,7 @@ func (s *GetPostBySlug) Exec(ctx context.Context, req *GetPostBySlugReq) (*GetPo
 			return nil, fmt.Errorf("comment author %s not found", comment.AuthorID)
 		}
 
-		commentInfos = append(commentInfos, CommentInfo{
-			ID:        comment.ID,
-			Author:    AuthorInfo{ID: commentAuthor.ID, Name: commentAuthor.Username},
-			ParentID:  comment.ParentID,
-			Body:      comment.Body,
-			CreatedAt: comment.CreatedAt,
-		})
+		commentInfos = append(commentInfos, newCommentInfo(comment, commentAuthor, commentReactions[comment.ID], commentMentions[comment.ID]))
 	}
 
 	return &GetPostBySlugResp{
@@ -123,9 +166,40 @@ func (s *GetPostBySlug) Exec(ctx context.Context, req *GetPostBySlugReq) (*GetPo
 		RawMarkdown:         post.RawMarkdown,
 		Author:              AuthorInfo{ID: author.ID, Name: author.Username},
 		PublishedAt:         *post.PublishedAt,
-		LikesCount:          int(likesCount),
+		LikesCount:          reactionCountOf(postReactions[post.ID], domain.ReactionLike),
+		Reactions:           postReactions[post.ID],
+		Mentions:            postMentions,
 		Comments:            commentInfos,
 		RawMarkdownAudioURL: post.RawMarkdownAudioURL,
 		SummaryAudioURL:     post.SummaryAudioURL,
 	}, nil
 }
+
+// newCommentInfo hides the author, body, reactions and mentions of deleted
+// comments, which are only kept as tombstones so their replies stay in place.
+func newCommentInfo(comment *dao.Comment, author *dao.User, reactions []ReactionCount, mentions []MentionedUser) CommentInfo {
+	if mentions == nil {
+		mentions = make([]MentionedUser, 0)
+	}
+
+	info := CommentInfo{
+		ID:        comment.ID,
+		Author:    AuthorInfo{ID: author.ID, Name: author.Username},
+		ParentID:  comment.ParentID,
+		Body:      comment.Body,
+		Edited:    comment.IsEdited(),
+		Deleted:   comment.IsDeleted(),
+		Reactions: reactions,
+		Mentions:  mentions,
+		CreatedAt: comment.CreatedAt,
+	}
+
+	if comment.IsDeleted() {
+		info.Author = AuthorInfo{}
+		info.Body = ""
+		info.Reactions = make([]ReactionCount, 0)
+		info.Mentions = make([]MentionedUser, 0)
+	}
+
+	return info
+}
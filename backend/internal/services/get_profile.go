@@ -3,7 +3,9 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"blog0/internal/domain"
 	"blog0/internal/domain/dao"
 )
 
@@ -11,8 +13,7 @@ type GetProfile struct {
 	userDAO     dao.UserDAO
 	followDAO   dao.FollowDAO
 	bookmarkDAO dao.BookmarkDAO
-	postLikeDAO dao.PostLikeDAO
-	postDAO     dao.PostDAO
+	reactionDAO dao.ReactionDAO
 }
 
 type GetProfileReq struct {
@@ -24,85 +25,58 @@ type ProfileUser struct {
 	Username string `json:"username"`
 }
 
-type ProfilePost struct {
-	ID    string `json:"id"`
-	Title string `json:"title"`
-}
-
+// GetProfileResp only counts what I follow, bookmarked and liked, the lists
+// are paginated at /me/following, /me/bookmarks and /me/likes.
 type GetProfileResp struct {
-	Following  []ProfileUser `json:"following"`
-	Bookmarks  []ProfilePost `json:"bookmarks"`
-	LikedPosts []ProfilePost `json:"liked_posts"`
+	Profile             UserProfile `json:"profile"`
+	DeletionScheduledAt *time.Time  `json:"deletion_scheduled_at"`
+	FollowingCount      int         `json:"following_count"`
+	FollowersCount      int         `json:"followers_count"`
+	BookmarksCount      int         `json:"bookmarks_count"`
+	LikesCount          int         `json:"likes_count"`
 }
 
-func NewGetProfile(userDAO dao.UserDAO, followDAO dao.FollowDAO, bookmarkDAO dao.BookmarkDAO, postLikeDAO dao.PostLikeDAO, postDAO dao.PostDAO) *GetProfile {
+func NewGetProfile(userDAO dao.UserDAO, followDAO dao.FollowDAO, bookmarkDAO dao.BookmarkDAO, reactionDAO dao.ReactionDAO) *GetProfile {
 	return &GetProfile{
 		userDAO:     userDAO,
 		followDAO:   followDAO,
 		bookmarkDAO: bookmarkDAO,
-		postLikeDAO: postLikeDAO,
-		postDAO:     postDAO,
+		reactionDAO: reactionDAO,
 	}
 }
 
 func (s *GetProfile) Exec(ctx context.Context, req *GetProfileReq) (*GetProfileResp, error) {
-	// Get following users
-	follows, err := s.followDAO.FindAll(ctx, "follower_id = $1", "created_at DESC", req.UserID)
+	user, err := s.userDAO.FindByPk(ctx, req.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get follows: %w", err)
-	}
-
-	following := make([]ProfileUser, 0, len(follows))
-	for _, follow := range follows {
-		user, err := s.userDAO.FindByPk(ctx, follow.FolloweeID)
-		if err != nil {
-			continue // Skip if user not found
-		}
-		following = append(following, ProfileUser{
-			ID:       user.ID,
-			Username: user.Username,
-		})
+		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
-	// Get bookmarked posts
-	bookmarks, err := s.bookmarkDAO.FindAll(ctx, "user_id = $1", "created_at DESC", req.UserID)
+	followingCount, err := s.followDAO.Count(ctx, "follower_id = $1", req.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get bookmarks: %w", err)
+		return nil, fmt.Errorf("failed to count follows: %w", err)
 	}
 
-	bookmarkedPosts := make([]ProfilePost, 0, len(bookmarks))
-	for _, bookmark := range bookmarks {
-		post, err := s.postDAO.FindByPk(ctx, bookmark.PostID)
-		if err != nil {
-			continue // Skip if post not found
-		}
-		bookmarkedPosts = append(bookmarkedPosts, ProfilePost{
-			ID:    post.ID,
-			Title: post.Title,
-		})
+	followersCount, err := s.followDAO.Count(ctx, "followee_id = $1", req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count followers: %w", err)
 	}
 
-	// Get liked posts
-	likes, err := s.postLikeDAO.FindAll(ctx, "user_id = $1", "created_at DESC", req.UserID)
+	bookmarksCount, err := s.bookmarkDAO.Count(ctx, "user_id = $1 AND "+visiblePostsFilter, req.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get likes: %w", err)
+		return nil, fmt.Errorf("failed to count bookmarks: %w", err)
 	}
 
-	likedPosts := make([]ProfilePost, 0, len(likes))
-	for _, like := range likes {
-		post, err := s.postDAO.FindByPk(ctx, like.PostID)
-		if err != nil {
-			continue // Skip if post not found
-		}
-		likedPosts = append(likedPosts, ProfilePost{
-			ID:    post.ID,
-			Title: post.Title,
-		})
+	likesCount, err := s.reactionDAO.Count(ctx, "user_id = $1 AND emoji = $2 AND "+visiblePostsFilter, req.UserID, domain.ReactionLike)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count likes: %w", err)
 	}
 
 	return &GetProfileResp{
-		Following:  following,
-		Bookmarks:  bookmarkedPosts,
-		LikedPosts: likedPosts,
+		Profile:             newUserProfile(user),
+		DeletionScheduledAt: user.DeletionScheduledAt,
+		FollowingCount:      int(followingCount),
+		FollowersCount:      int(followersCount),
+		BookmarksCount:      int(bookmarksCount),
+		LikesCount:          int(likesCount),
 	}, nil
 }
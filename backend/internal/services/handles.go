@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+// Handles knows which handles are free: not used by another user, nor kept
+// as an unexpired alias of a previous handle.
+type Handles struct {
+	userDAO  dao.UserDAO
+	aliasDAO dao.HandleAliasDAO
+}
+
+func NewHandles(userDAO dao.UserDAO, aliasDAO dao.HandleAliasDAO) *Handles {
+	return &Handles{
+		userDAO:  userDAO,
+		aliasDAO: aliasDAO,
+	}
+}
+
+// IsTaken tells whether the handle belongs to someone other than the user.
+func (h *Handles) IsTaken(ctx context.Context, handle string, userID string) (bool, error) {
+	users, err := h.userDAO.Count(ctx, "handle = $1 AND id::text <> $2", handle, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check handle: %w", err)
+	}
+	if users > 0 {
+		return true, nil
+	}
+
+	aliases, err := h.aliasDAO.Count(ctx, "handle = $1 AND user_id::text <> $2 AND expires_at > NOW()", handle, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check handle: %w", err)
+	}
+
+	return aliases > 0, nil
+}
+
+// Allocate finds a free handle for a new user, derived from their name or
+// else their email, with a number appended when it is taken.
+func (h *Handles) Allocate(ctx context.Context, name string, email string) (string, error) {
+	base := domain.DeriveHandle(name)
+	if len(base) < domain.MinHandleLength {
+		local, _, _ := strings.Cut(email, "@")
+		base = domain.DeriveHandle(local)
+	}
+	if len(base) < domain.MinHandleLength {
+		base = "user"
+	}
+	if len(base) > domain.MaxHandleLength-5 {
+		base = base[:domain.MaxHandleLength-5]
+	}
+
+	candidates := []string{base}
+	for i := 2; i < 10; i++ {
+		candidates = append(candidates, fmt.Sprintf("%s_%d", base, i))
+	}
+	for i := 0; i < 10; i++ {
+		candidates = append(candidates, fmt.Sprintf("%s_%04d", base, rand.IntN(10000)))
+	}
+
+	for _, candidate := range candidates {
+		if _, err := domain.ParseHandle(candidate); err != nil {
+			continue
+		}
+
+		taken, err := h.IsTaken(ctx, candidate, "")
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("no free handle for %s", base)
+}
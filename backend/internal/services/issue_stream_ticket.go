@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"blog0/internal/domain"
+)
+
+type IssueStreamTicket struct {
+	jwtSecret []byte
+}
+
+type IssueStreamTicketReq struct {
+	UserID    string
+	SessionID string
+}
+
+type IssueStreamTicketResp struct {
+	Ticket    string    `json:"ticket"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func NewIssueStreamTicket(jwtSecret string) *IssueStreamTicket {
+	return &IssueStreamTicket{
+		jwtSecret: []byte(jwtSecret),
+	}
+}
+
+// Exec signs a ticket that opens the notification stream of the session for
+// a minute. It only opens the stream, and stops working with the session.
+func (s *IssueStreamTicket) Exec(ctx context.Context, req *IssueStreamTicketReq) (*IssueStreamTicketResp, error) {
+	if req.SessionID == "" {
+		return nil, fmt.Errorf("unauthorized: stream tickets are issued to signed in sessions")
+	}
+
+	expiresAt := time.Now().Add(domain.StreamTicketTTL)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"typ": domain.StreamTicketType,
+		"uid": req.UserID,
+		"sid": req.SessionID,
+		"exp": expiresAt.Unix(),
+	})
+
+	ticket, err := token.SignedString(s.jwtSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign stream ticket: %w", err)
+	}
+
+	return &IssueStreamTicketResp{
+		Ticket:    ticket,
+		ExpiresAt: expiresAt,
+	}, nil
+}
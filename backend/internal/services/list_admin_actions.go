@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain/dao"
+)
+
+type ListAdminActions struct {
+	adminActionDAO dao.AdminActionDAO
+	userDAO        dao.UserDAO
+}
+
+type ListAdminActionsReq struct {
+	Page    int
+	PerPage int
+}
+
+type AdminActionItem struct {
+	ID         string     `json:"id"`
+	Admin      AuthorInfo `json:"admin"`
+	Action     string     `json:"action"`
+	ReportID   *string    `json:"report_id"`
+	TargetType string     `json:"target_type"`
+	TargetID   string     `json:"target_id"`
+	Note       string     `json:"note"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type ListAdminActionsResp struct {
+	Page    int               `json:"page"`
+	PerPage int               `json:"per_page"`
+	Total   int               `json:"total"`
+	Items   []AdminActionItem `json:"items"`
+}
+
+func NewListAdminActions(adminActionDAO dao.AdminActionDAO, userDAO dao.UserDAO) *ListAdminActions {
+	return &ListAdminActions{
+		adminActionDAO: adminActionDAO,
+		userDAO:        userDAO,
+	}
+}
+
+func (s *ListAdminActions) Exec(ctx context.Context, req *ListAdminActionsReq) (*ListAdminActionsResp, error) {
+	total, err := s.adminActionDAO.Count(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to count admin actions: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	actions, err := s.adminActionDAO.FindPaginated(ctx, req.PerPage, offset, "", "created_at DESC, id DESC")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load admin actions: %w", err)
+	}
+
+	adminIDs := make([]any, 0)
+	placeholders := make([]string, 0)
+	seenAdmins := make(map[string]bool)
+	for _, action := range actions {
+		if !seenAdmins[action.AdminID] {
+			seenAdmins[action.AdminID] = true
+			adminIDs = append(adminIDs, action.AdminID)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(adminIDs)))
+		}
+	}
+
+	adminsMap := make(map[string]*dao.User)
+	if len(adminIDs) > 0 {
+		admins, err := s.userDAO.FindAll(ctx, "id IN ("+strings.Join(placeholders, ",")+")", "", adminIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load admins: %w", err)
+		}
+		for _, admin := range admins {
+			adminsMap[admin.ID] = admin
+		}
+	}
+
+	items := make([]AdminActionItem, 0, len(actions))
+	for _, action := range actions {
+		admin := AuthorInfo{ID: action.AdminID}
+		if user, ok := adminsMap[action.AdminID]; ok {
+			admin.Name = user.Username
+		}
+
+		items = append(items, AdminActionItem{
+			ID:         action.ID,
+			Admin:      admin,
+			Action:     action.Action,
+			ReportID:   action.ReportID,
+			TargetType: action.TargetType,
+			TargetID:   action.TargetID,
+			Note:       action.Note,
+			CreatedAt:  action.CreatedAt,
+		})
+	}
+
+	return &ListAdminActionsResp{
+		Page:    req.Page,
+		PerPage: req.PerPage,
+		Total:   int(total),
+		Items:   items,
+	}, nil
+}
+
+func (s *ListAdminActions) ParseRequest(c *gin.Context) (*ListAdminActionsReq, error) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	return &ListAdminActionsReq{
+		Page:    page,
+		PerPage: perPage,
+	}, nil
+}
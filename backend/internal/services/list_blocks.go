@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"blog0/internal/domain/dao"
+)
+
+type ListBlocks struct {
+	userDAO  dao.UserDAO
+	blockDAO dao.BlockDAO
+}
+
+type ListBlocksReq struct {
+	UserID string `json:"-"`
+}
+
+type BlockItem struct {
+	User      ProfileUser `json:"user"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type ListBlocksResp struct {
+	Items []BlockItem `json:"items"`
+}
+
+func NewListBlocks(userDAO dao.UserDAO, blockDAO dao.BlockDAO) *ListBlocks {
+	return &ListBlocks{
+		userDAO:  userDAO,
+		blockDAO: blockDAO,
+	}
+}
+
+func (s *ListBlocks) Exec(ctx context.Context, req *ListBlocksReq) (*ListBlocksResp, error) {
+	blocks, err := s.blockDAO.FindAll(ctx, "blocker_id = $1", "created_at DESC", req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blocks: %w", err)
+	}
+
+	userIDs := make([]any, 0, len(blocks))
+	placeholders := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		userIDs = append(userIDs, block.BlockedID)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(userIDs)))
+	}
+
+	usersMap := make(map[string]*dao.User)
+	if len(userIDs) > 0 {
+		users, err := s.userDAO.FindAll(ctx, "id IN ("+strings.Join(placeholders, ",")+")", "", userIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load blocked users: %w", err)
+		}
+		for _, user := range users {
+			usersMap[user.ID] = user
+		}
+	}
+
+	items := make([]BlockItem, 0, len(blocks))
+	for _, block := range blocks {
+		user, ok := usersMap[block.BlockedID]
+		if !ok {
+			continue // Skip if user not found
+		}
+		items = append(items, BlockItem{
+			User:      ProfileUser{ID: user.ID, Username: user.Username},
+			CreatedAt: block.CreatedAt,
+		})
+	}
+
+	return &ListBlocksResp{
+		Items: items,
+	}, nil
+}
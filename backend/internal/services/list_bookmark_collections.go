@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"blog0/internal/domain/dao"
+)
+
+type ListBookmarkCollections struct {
+	collectionDAO dao.BookmarkCollectionDAO
+	bookmarkDAO   dao.BookmarkDAO
+}
+
+type ListBookmarkCollectionsReq struct {
+	UserID string `json:"-"`
+}
+
+type ListBookmarkCollectionsResp struct {
+	Items []BookmarkCollectionInfo `json:"items"`
+}
+
+func NewListBookmarkCollections(collectionDAO dao.BookmarkCollectionDAO, bookmarkDAO dao.BookmarkDAO) *ListBookmarkCollections {
+	return &ListBookmarkCollections{
+		collectionDAO: collectionDAO,
+		bookmarkDAO:   bookmarkDAO,
+	}
+}
+
+func (s *ListBookmarkCollections) Exec(ctx context.Context, req *ListBookmarkCollectionsReq) (*ListBookmarkCollectionsResp, error) {
+	collections, err := s.collectionDAO.FindAll(ctx, "user_id = $1", "LOWER(name) ASC", req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collections: %w", err)
+	}
+
+	collectionIDs := make([]any, 0, len(collections))
+	placeholders := make([]string, 0, len(collections))
+	for _, collection := range collections {
+		collectionIDs = append(collectionIDs, collection.ID)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(collectionIDs)))
+	}
+
+	counts := make(map[string]int)
+	if len(collectionIDs) > 0 {
+		bookmarks, err := s.bookmarkDAO.FindAll(ctx, "collection_id IN ("+strings.Join(placeholders, ",")+")", "", collectionIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count bookmarks: %w", err)
+		}
+		for _, bookmark := range bookmarks {
+			counts[*bookmark.CollectionID]++
+		}
+	}
+
+	items := make([]BookmarkCollectionInfo, 0, len(collections))
+	for _, collection := range collections {
+		items = append(items, newBookmarkCollectionInfo(collection, counts[collection.ID]))
+	}
+
+	return &ListBookmarkCollectionsResp{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+const (
+	BookmarkStatusRead   = "read"
+	BookmarkStatusUnread = "unread"
+
+	// BookmarkCollectionNone filters the bookmarks that are in no collection
+	BookmarkCollectionNone = "none"
+)
+
+// visiblePostsFilter keeps the bookmarks or reactions of posts that can
+// still be read.
+const visiblePostsFilter = "post_id IN (SELECT id FROM posts WHERE published_at IS NOT NULL AND hidden_at IS NULL)"
+
+type ListBookmarks struct {
+	bookmarkDAO   dao.BookmarkDAO
+	collectionDAO dao.BookmarkCollectionDAO
+	activityDAO   customdao.ActivityDAO
+}
+
+type ListBookmarksReq struct {
+	UserID       string
+	CollectionID string
+	Status       string
+	Page         int
+	PerPage      int
+}
+
+type BookmarkItem struct {
+	ID           string     `json:"id"`
+	Post         PostCard   `json:"post"`
+	CollectionID *string    `json:"collection_id"`
+	Note         string     `json:"note"`
+	Read         bool       `json:"read"`
+	ReadAt       *time.Time `json:"read_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+type ListBookmarksResp struct {
+	Page    int            `json:"page"`
+	PerPage int            `json:"per_page"`
+	Total   int            `json:"total"`
+	Items   []BookmarkItem `json:"items"`
+}
+
+func NewListBookmarks(bookmarkDAO dao.BookmarkDAO, collectionDAO dao.BookmarkCollectionDAO, activityDAO customdao.ActivityDAO) *ListBookmarks {
+	return &ListBookmarks{
+		bookmarkDAO:   bookmarkDAO,
+		collectionDAO: collectionDAO,
+		activityDAO:   activityDAO,
+	}
+}
+
+func (s *ListBookmarks) Exec(ctx context.Context, req *ListBookmarksReq) (*ListBookmarksResp, error) {
+	where := "user_id = $1 AND " + visiblePostsFilter
+	args := []any{req.UserID}
+
+	switch req.CollectionID {
+	case "":
+	case BookmarkCollectionNone:
+		where += " AND collection_id IS NULL"
+	default:
+		collection, err := s.collectionDAO.FindOne(ctx, "id::text = $1 AND user_id = $2", "", req.CollectionID, req.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("collection not found: %w", err)
+		}
+		args = append(args, collection.ID)
+		where += " AND collection_id = $" + strconv.Itoa(len(args))
+	}
+
+	switch req.Status {
+	case BookmarkStatusRead:
+		where += " AND read_at IS NOT NULL"
+	case BookmarkStatusUnread:
+		where += " AND read_at IS NULL"
+	}
+
+	total, err := s.bookmarkDAO.Count(ctx, where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count bookmarks: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	bookmarks, err := s.activityDAO.FindBookmarkedPosts(ctx, req.PerPage, offset, where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bookmarks: %w", err)
+	}
+
+	items := make([]BookmarkItem, 0, len(bookmarks))
+	for _, bookmarked := range bookmarks {
+		bookmark := &bookmarked.Bookmark
+		items = append(items, BookmarkItem{
+			ID:           bookmark.ID,
+			Post:         newPostCard(&bookmarked.Post),
+			CollectionID: bookmark.CollectionID,
+			Note:         bookmark.Note,
+			Read:         bookmark.IsRead(),
+			ReadAt:       bookmark.ReadAt,
+			CreatedAt:    bookmark.CreatedAt,
+		})
+	}
+
+	return &ListBookmarksResp{
+		Page:    req.Page,
+		PerPage: req.PerPage,
+		Total:   int(total),
+		Items:   items,
+	}, nil
+}
+
+func (s *ListBookmarks) ParseRequest(c *gin.Context, userID string) (*ListBookmarksReq, error) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	status := c.Query("status")
+	if status != "" && status != BookmarkStatusRead && status != BookmarkStatusUnread {
+		return nil, fmt.Errorf("status must be %s or %s", BookmarkStatusRead, BookmarkStatusUnread)
+	}
+
+	return &ListBookmarksReq{
+		UserID:       userID,
+		CollectionID: c.Query("collection_id"),
+		Status:       status,
+		Page:         page,
+		PerPage:      perPage,
+	}, nil
+}
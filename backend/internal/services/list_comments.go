@@ -0,0 +1,338 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+const (
+	CommentSortOldest = "oldest"
+	CommentSortNewest = "newest"
+	CommentSortTop    = "top"
+)
+
+var commentSortExprs = map[string]string{
+	CommentSortOldest: "created_at ASC, id ASC",
+	CommentSortNewest: "created_at DESC, id DESC",
+	CommentSortTop:    "reply_count DESC, created_at ASC, id ASC",
+}
+
+type ListComments struct {
+	postDAO    dao.PostDAO
+	userDAO    dao.UserDAO
+	commentDAO dao.CommentDAO
+	reactions  *ReactionCounter
+	mentions   *MentionTracker
+}
+
+type ListCommentsReq struct {
+	Slug     string
+	ViewerID string
+	ParentID *string
+	Sort     string
+	Depth    int
+	PerPage  int
+	Replies  int
+	Offset   int
+}
+
+type CommentNode struct {
+	CommentInfo
+	Depth             int           `json:"depth"`
+	ReplyCount        int           `json:"reply_count"`
+	Replies           []CommentNode `json:"replies"`
+	NextRepliesCursor *string       `json:"next_replies_cursor"`
+}
+
+type ListCommentsResp struct {
+	Sort       string        `json:"sort"`
+	Total      int           `json:"total"`
+	NextCursor *string       `json:"next_cursor"`
+	Items      []CommentNode `json:"items"`
+}
+
+func NewListComments(postDAO dao.PostDAO, userDAO dao.UserDAO, commentDAO dao.CommentDAO, reactions *ReactionCounter, mentions *MentionTracker) *ListComments {
+	return &ListComments{
+		postDAO:    postDAO,
+		userDAO:    userDAO,
+		commentDAO: commentDAO,
+		reactions:  reactions,
+		mentions:   mentions,
+	}
+}
+
+func (s *ListComments) Exec(ctx context.Context, req *ListCommentsReq) (*ListCommentsResp, error) {
+	post, err := s.postDAO.FindOne(ctx, "slug = $1 AND hidden_at IS NULL", "", req.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+
+	sortExpr := commentSortExprs[req.Sort]
+
+	where := "post_id = $1 AND status = $2 AND parent_id IS NULL"
+	args := []any{post.ID, domain.CommentStatusApproved}
+	baseDepth := 0
+	if req.ParentID != nil {
+		parent, err := s.commentDAO.FindOne(ctx, "id = $1 AND post_id = $2 AND status = $3", "", *req.ParentID, post.ID, domain.CommentStatusApproved)
+		if err != nil {
+			return nil, fmt.Errorf("parent comment not found: %w", err)
+		}
+
+		where = "post_id = $1 AND status = $2 AND parent_id = $3"
+		args = append(args, parent.ID)
+		baseDepth = parent.Depth + 1
+	}
+
+	// Signed in readers don't see the comments of users they muted or blocked
+	if req.ViewerID != "" {
+		args = append(args, req.ViewerID)
+		where += " AND " + hiddenAuthorsFilter("author_id", "$"+strconv.Itoa(len(args)))
+	}
+
+	total, err := s.commentDAO.Count(ctx, where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count comments: %w", err)
+	}
+
+	roots, err := s.commentDAO.FindPaginated(ctx, req.PerPage, req.Offset, where, sortExpr, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load comments: %w", err)
+	}
+
+	// Load the replies level by level, one query per level, keeping only the
+	// first req.Replies children of every parent. One more is fetched to tell
+	// whether there is a next page, hidden authors aside. Below the last level
+	// a single reply is fetched for that alone.
+	levels := [][]*dao.Comment{roots}
+	hasMoreReplies := make(map[string]bool)
+	for level := 1; level <= req.Depth; level++ {
+		replyArgs := make([]any, 0)
+		placeholders := make([]string, 0)
+		for _, c := range levels[level-1] {
+			if c.ReplyCount > 0 {
+				replyArgs = append(replyArgs, c.ID)
+				placeholders = append(placeholders, fmt.Sprintf("$%d", len(replyArgs)))
+			}
+		}
+		if len(replyArgs) == 0 {
+			break
+		}
+
+		limit := req.Replies
+		if level == req.Depth {
+			limit = 0
+		}
+
+		replyArgs = append(replyArgs, domain.CommentStatusApproved)
+		filter := " AND status = $" + strconv.Itoa(len(replyArgs))
+		if req.ViewerID != "" {
+			replyArgs = append(replyArgs, req.ViewerID)
+			filter += " AND " + hiddenAuthorsFilter("author_id", "$"+strconv.Itoa(len(replyArgs)))
+		}
+
+		replies, err := s.commentDAO.FindAll(ctx,
+			"id IN (SELECT id FROM ("+
+				"SELECT id, ROW_NUMBER() OVER (PARTITION BY parent_id ORDER BY "+sortExpr+") AS rn "+
+				"FROM comments WHERE parent_id IN ("+strings.Join(placeholders, ",")+")"+filter+
+				") ranked WHERE rn <= "+strconv.Itoa(limit+1)+")",
+			sortExpr,
+			replyArgs...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load replies: %w", err)
+		}
+
+		kept := make([]*dao.Comment, 0, len(replies))
+		seen := make(map[string]int)
+		for _, reply := range replies {
+			if reply.ParentID == nil {
+				continue
+			}
+			seen[*reply.ParentID]++
+			if seen[*reply.ParentID] > limit {
+				hasMoreReplies[*reply.ParentID] = true
+				continue
+			}
+			kept = append(kept, reply)
+		}
+		if len(kept) == 0 {
+			break
+		}
+		levels = append(levels, kept)
+	}
+
+	authorIDs := make([]any, 0)
+	authorPlaceholders := make([]string, 0)
+	seenAuthors := make(map[string]bool)
+	childrenOf := make(map[string][]*dao.Comment)
+	commentIDs := make([]string, 0)
+	for i, level := range levels {
+		for _, c := range level {
+			commentIDs = append(commentIDs, c.ID)
+			if !seenAuthors[c.AuthorID] {
+				seenAuthors[c.AuthorID] = true
+				authorIDs = append(authorIDs, c.AuthorID)
+				authorPlaceholders = append(authorPlaceholders, fmt.Sprintf("$%d", len(authorIDs)))
+			}
+			if i > 0 && c.ParentID != nil {
+				childrenOf[*c.ParentID] = append(childrenOf[*c.ParentID], c)
+			}
+		}
+	}
+
+	authorsMap := make(map[string]*dao.User)
+	if len(authorIDs) > 0 {
+		authors, err := s.userDAO.FindAll(ctx, "id IN ("+strings.Join(authorPlaceholders, ",")+")", "", authorIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load comment authors: %w", err)
+		}
+		for _, author := range authors {
+			authorsMap[author.ID] = author
+		}
+	}
+
+	commentReactions, err := s.reactions.ForComments(ctx, commentIDs, req.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	commentMentions, err := s.mentions.ForComments(ctx, commentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var build func(c *dao.Comment, depth int) (CommentNode, error)
+	build = func(c *dao.Comment, depth int) (CommentNode, error) {
+		author, ok := authorsMap[c.AuthorID]
+		if !ok {
+			return CommentNode{}, fmt.Errorf("comment author %s not found", c.AuthorID)
+		}
+
+		replies := make([]CommentNode, 0)
+		for _, child := range childrenOf[c.ID] {
+			node, err := build(child, depth+1)
+			if err != nil {
+				return CommentNode{}, err
+			}
+			replies = append(replies, node)
+		}
+
+		var nextRepliesCursor *string
+		if hasMoreReplies[c.ID] {
+			cursor := encodeOffsetCursor(len(replies))
+			nextRepliesCursor = &cursor
+		}
+
+		return CommentNode{
+			CommentInfo:       newCommentInfo(c, author, commentReactions[c.ID], commentMentions[c.ID]),
+			Depth:             depth,
+			ReplyCount:        c.ReplyCount,
+			Replies:           replies,
+			NextRepliesCursor: nextRepliesCursor,
+		}, nil
+	}
+
+	items := make([]CommentNode, 0, len(roots))
+	for _, root := range roots {
+		node, err := build(root, baseDepth)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, node)
+	}
+
+	var nextCursor *string
+	if req.Offset+len(roots) < int(total) {
+		cursor := encodeOffsetCursor(req.Offset + len(roots))
+		nextCursor = &cursor
+	}
+
+	return &ListCommentsResp{
+		Sort:       req.Sort,
+		Total:      int(total),
+		NextCursor: nextCursor,
+		Items:      items,
+	}, nil
+}
+
+func (s *ListComments) ParseRequest(c *gin.Context) (*ListCommentsReq, error) {
+	sort := CommentSortOldest
+	if o := c.Query("sort"); o != "" {
+		if _, ok := commentSortExprs[o]; !ok {
+			return nil, fmt.Errorf("sort must be one of oldest, newest, top")
+		}
+		sort = o
+	}
+
+	depth := 3
+	if d := c.Query("depth"); d != "" {
+		if parsed, err := strconv.Atoi(d); err == nil && parsed > 0 && parsed <= 5 {
+			depth = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	replies := 3
+	if r := c.Query("replies"); r != "" {
+		if parsed, err := strconv.Atoi(r); err == nil && parsed > 0 && parsed <= 20 {
+			replies = parsed
+		}
+	}
+
+	offset := 0
+	if cursor := c.Query("cursor"); cursor != "" {
+		decoded, err := decodeOffsetCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		offset = decoded
+	}
+
+	var parentID *string
+	if p := c.Query("parent_id"); p != "" {
+		parentID = &p
+	}
+
+	return &ListCommentsReq{
+		Slug:     c.Param("slug"),
+		ViewerID: c.GetString("user_id"),
+		ParentID: parentID,
+		Sort:     sort,
+		Depth:    depth,
+		PerPage:  perPage,
+		Replies:  replies,
+		Offset:   offset,
+	}, nil
+}
+
+func encodeOffsetCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(offset)))
+}
+
+func decodeOffsetCursor(value string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cursor")
+	}
+
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(raw), "offset:"))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid cursor")
+	}
+
+	return offset, nil
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain/dao"
+)
+
+type ListFeed struct {
+	postDAO    dao.PostDAO
+	userDAO    dao.UserDAO
+	commentDAO dao.CommentDAO
+	reactions  *ReactionCounter
+}
+
+type FeedCursor struct {
+	PublishedAt time.Time
+	PostID      string
+}
+
+type ListFeedReq struct {
+	UserID       string
+	PerPage      int
+	Cursor       *FeedCursor
+	IncludeLiked bool
+}
+
+type ListFeedResp struct {
+	PerPage    int        `json:"per_page"`
+	NextCursor *string    `json:"next_cursor"`
+	Items      []PostItem `json:"items"`
+}
+
+func NewListFeed(postDAO dao.PostDAO, userDAO dao.UserDAO, commentDAO dao.CommentDAO, reactions *ReactionCounter) *ListFeed {
+	return &ListFeed{
+		postDAO:    postDAO,
+		userDAO:    userDAO,
+		commentDAO: commentDAO,
+		reactions:  reactions,
+	}
+}
+
+func (s *ListFeed) Exec(ctx context.Context, req *ListFeedReq) (*ListFeedResp, error) {
+	followed := "author_id IN (SELECT followee_id FROM follows WHERE follower_id = $1)"
+	if req.IncludeLiked {
+		followed = "(" + followed + " OR id IN (" +
+			"SELECT r.post_id FROM reactions r JOIN follows f ON f.followee_id = r.user_id " +
+			"WHERE f.follower_id = $1 AND r.post_id IS NOT NULL AND r.emoji = 'like'" +
+			"))"
+	}
+
+	where := "published_at IS NOT NULL AND hidden_at IS NULL AND author_id <> $1 AND " + followed + " AND " + hiddenAuthorsFilter("author_id", "$1")
+	args := []any{req.UserID}
+	if req.Cursor != nil {
+		where += " AND (published_at, id) < ($2, $3)"
+		args = append(args, req.Cursor.PublishedAt, req.Cursor.PostID)
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	posts, err := s.postDAO.FindPaginated(ctx, req.PerPage+1, 0, where, "published_at DESC, id DESC", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load feed: %w", err)
+	}
+
+	var nextCursor *string
+	if len(posts) > req.PerPage {
+		posts = posts[:req.PerPage]
+		last := posts[len(posts)-1]
+		cursor := encodeFeedCursor(FeedCursor{PublishedAt: *last.PublishedAt, PostID: last.ID})
+		nextCursor = &cursor
+	}
+
+	items, err := buildPostItems(ctx, posts, req.UserID, s.userDAO, s.commentDAO, s.reactions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListFeedResp{
+		PerPage:    req.PerPage,
+		NextCursor: nextCursor,
+		Items:      items,
+	}, nil
+}
+
+func (s *ListFeed) ParseRequest(c *gin.Context, userID string) (*ListFeedReq, error) {
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	var cursor *FeedCursor
+	if raw := c.Query("cursor"); raw != "" {
+		decoded, err := decodeFeedCursor(raw)
+		if err != nil {
+			return nil, err
+		}
+		cursor = decoded
+	}
+
+	includeLiked, _ := strconv.ParseBool(c.Query("include_liked"))
+
+	return &ListFeedReq{
+		UserID:       userID,
+		PerPage:      perPage,
+		Cursor:       cursor,
+		IncludeLiked: includeLiked,
+	}, nil
+}
+
+func encodeFeedCursor(cursor FeedCursor) string {
+	raw := cursor.PublishedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.PostID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeFeedCursor(value string) (*FeedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	publishedAt, postID, found := strings.Cut(string(raw), "|")
+	if !found || postID == "" {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, publishedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	return &FeedCursor{PublishedAt: t, PostID: postID}, nil
+}
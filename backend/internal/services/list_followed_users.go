@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+type ListFollowedUsers struct {
+	followDAO   dao.FollowDAO
+	activityDAO customdao.ActivityDAO
+}
+
+type ListFollowedUsersReq struct {
+	UserID  string
+	Page    int
+	PerPage int
+}
+
+type FollowedUserItem struct {
+	User           UserCard  `json:"user"`
+	Bio            string    `json:"bio"`
+	PostsCount     int       `json:"posts_count"`
+	FollowersCount int       `json:"followers_count"`
+	FollowedAt     time.Time `json:"followed_at"`
+}
+
+type ListFollowedUsersResp struct {
+	Page    int                `json:"page"`
+	PerPage int                `json:"per_page"`
+	Total   int                `json:"total"`
+	Items   []FollowedUserItem `json:"items"`
+}
+
+func NewListFollowedUsers(followDAO dao.FollowDAO, activityDAO customdao.ActivityDAO) *ListFollowedUsers {
+	return &ListFollowedUsers{
+		followDAO:   followDAO,
+		activityDAO: activityDAO,
+	}
+}
+
+func (s *ListFollowedUsers) Exec(ctx context.Context, req *ListFollowedUsersReq) (*ListFollowedUsersResp, error) {
+	total, err := s.followDAO.Count(ctx, "follower_id = $1", req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count follows: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	followed, err := s.activityDAO.FindFollowedUsers(ctx, req.PerPage, offset, "follower_id = $1", req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load follows: %w", err)
+	}
+
+	items := make([]FollowedUserItem, 0, len(followed))
+	for _, user := range followed {
+		items = append(items, FollowedUserItem{
+			User: UserCard{
+				ID:        user.ID,
+				Handle:    user.Handle,
+				Name:      user.Name,
+				AvatarURL: user.AvatarURL,
+			},
+			Bio:            user.Bio,
+			PostsCount:     user.PostsCount,
+			FollowersCount: user.FollowersCount,
+			FollowedAt:     user.FollowedAt,
+		})
+	}
+
+	return &ListFollowedUsersResp{
+		Page:    req.Page,
+		PerPage: req.PerPage,
+		Total:   int(total),
+		Items:   items,
+	}, nil
+}
+
+func (s *ListFollowedUsers) ParseRequest(c *gin.Context, userID string) (*ListFollowedUsersReq, error) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	return &ListFollowedUsersReq{
+		UserID:  userID,
+		Page:    page,
+		PerPage: perPage,
+	}, nil
+}
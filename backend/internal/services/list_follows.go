@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain/dao"
+)
+
+const (
+	FollowListFollowers = "followers"
+	FollowListFollowing = "following"
+)
+
+type ListFollows struct {
+	users     *UserResolver
+	userDAO   dao.UserDAO
+	followDAO dao.FollowDAO
+}
+
+// ListFollowsReq lists the followers of an author, or the users they follow.
+type ListFollowsReq struct {
+	AuthorID string
+	ViewerID string
+	List     string
+	Page     int
+	PerPage  int
+}
+
+type FollowItem struct {
+	User       UserCard  `json:"user"`
+	FollowedAt time.Time `json:"followed_at"`
+	// IsFollowing tells whether the viewer follows this user, null when not signed in.
+	IsFollowing *bool `json:"is_following"`
+}
+
+type ListFollowsResp struct {
+	Page    int          `json:"page"`
+	PerPage int          `json:"per_page"`
+	Total   int          `json:"total"`
+	Items   []FollowItem `json:"items"`
+}
+
+func NewListFollows(users *UserResolver, userDAO dao.UserDAO, followDAO dao.FollowDAO) *ListFollows {
+	return &ListFollows{
+		users:     users,
+		userDAO:   userDAO,
+		followDAO: followDAO,
+	}
+}
+
+func (s *ListFollows) Exec(ctx context.Context, req *ListFollowsReq) (*ListFollowsResp, error) {
+	author, err := s.users.Resolve(ctx, req.AuthorID)
+	if err != nil {
+		return nil, fmt.Errorf("author not found: %w", err)
+	}
+
+	// Followers are listed by their follower_id, followed users by followee_id
+	where, otherID := "followee_id = $1", func(f *dao.Follow) string { return f.FollowerID }
+	if req.List == FollowListFollowing {
+		where, otherID = "follower_id = $1", func(f *dao.Follow) string { return f.FolloweeID }
+	}
+
+	total, err := s.followDAO.Count(ctx, where, author.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count %s: %w", req.List, err)
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	follows, err := s.followDAO.FindPaginated(ctx, req.PerPage, offset, where, "created_at DESC, id DESC", author.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", req.List, err)
+	}
+
+	userIDs := make([]any, 0, len(follows))
+	placeholders := make([]string, 0, len(follows))
+	for _, follow := range follows {
+		userIDs = append(userIDs, otherID(follow))
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(userIDs)))
+	}
+
+	usersMap := make(map[string]*dao.User)
+	followedByViewer := make(map[string]bool)
+	if len(userIDs) > 0 {
+		users, err := s.userDAO.FindAll(ctx, "id IN ("+strings.Join(placeholders, ",")+")", "", userIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load users: %w", err)
+		}
+		for _, user := range users {
+			usersMap[user.ID] = user
+		}
+
+		if req.ViewerID != "" {
+			args := append(append([]any{}, userIDs...), req.ViewerID)
+			where := "followee_id IN (" + strings.Join(placeholders, ",") + ") AND follower_id = $" + strconv.Itoa(len(args))
+			viewerFollows, err := s.followDAO.FindAll(ctx, where, "", args...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load viewer follows: %w", err)
+			}
+			for _, follow := range viewerFollows {
+				followedByViewer[follow.FolloweeID] = true
+			}
+		}
+	}
+
+	items := make([]FollowItem, 0, len(follows))
+	for _, follow := range follows {
+		user, ok := usersMap[otherID(follow)]
+		if !ok {
+			continue // Skip if user not found
+		}
+
+		item := FollowItem{
+			User:       newUserCard(user),
+			FollowedAt: follow.CreatedAt,
+		}
+		if req.ViewerID != "" {
+			isFollowing := followedByViewer[user.ID]
+			item.IsFollowing = &isFollowing
+		}
+		items = append(items, item)
+	}
+
+	return &ListFollowsResp{
+		Page:    req.Page,
+		PerPage: req.PerPage,
+		Total:   int(total),
+		Items:   items,
+	}, nil
+}
+
+func (s *ListFollows) ParseRequest(c *gin.Context, list string) (*ListFollowsReq, error) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	return &ListFollowsReq{
+		AuthorID: c.Param("author_id"),
+		ViewerID: c.GetString("user_id"),
+		List:     list,
+		Page:     page,
+		PerPage:  perPage,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain/dao"
+)
+
+type ListIdentities struct {
+	identityDAO dao.IdentityDAO
+	providers   *OAuthProviders
+}
+
+type ListIdentitiesReq struct {
+	UserID string
+}
+
+type IdentityItem struct {
+	Provider   string    `json:"provider"`
+	Email      string    `json:"email"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+// ListIdentitiesResp lists the linked providers, and in Available the
+// configured ones that can still be linked.
+type ListIdentitiesResp struct {
+	Items     []IdentityItem `json:"items"`
+	Available []string       `json:"available"`
+}
+
+func NewListIdentities(identityDAO dao.IdentityDAO, providers *OAuthProviders) *ListIdentities {
+	return &ListIdentities{
+		identityDAO: identityDAO,
+		providers:   providers,
+	}
+}
+
+func (s *ListIdentities) Exec(ctx context.Context, req *ListIdentitiesReq) (*ListIdentitiesResp, error) {
+	identities, err := s.identityDAO.FindAll(ctx, "user_id = $1", "created_at ASC", req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load identities: %w", err)
+	}
+
+	linked := make(map[string]bool)
+	items := make([]IdentityItem, 0, len(identities))
+	for _, identity := range identities {
+		linked[identity.Provider] = true
+		items = append(items, IdentityItem{
+			Provider:   identity.Provider,
+			Email:      identity.Email,
+			CreatedAt:  identity.CreatedAt,
+			LastUsedAt: identity.LastUsedAt,
+		})
+	}
+
+	available := make([]string, 0)
+	for _, name := range s.providers.Names() {
+		if !linked[name] {
+			available = append(available, name)
+		}
+	}
+
+	return &ListIdentitiesResp{
+		Items:     items,
+		Available: available,
+	}, nil
+}
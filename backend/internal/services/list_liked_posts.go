@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+type ListLikedPosts struct {
+	reactionDAO dao.ReactionDAO
+	activityDAO customdao.ActivityDAO
+}
+
+type ListLikedPostsReq struct {
+	UserID  string
+	Page    int
+	PerPage int
+}
+
+type LikedPostItem struct {
+	Post    PostCard  `json:"post"`
+	LikedAt time.Time `json:"liked_at"`
+}
+
+type ListLikedPostsResp struct {
+	Page    int             `json:"page"`
+	PerPage int             `json:"per_page"`
+	Total   int             `json:"total"`
+	Items   []LikedPostItem `json:"items"`
+}
+
+func NewListLikedPosts(reactionDAO dao.ReactionDAO, activityDAO customdao.ActivityDAO) *ListLikedPosts {
+	return &ListLikedPosts{
+		reactionDAO: reactionDAO,
+		activityDAO: activityDAO,
+	}
+}
+
+func (s *ListLikedPosts) Exec(ctx context.Context, req *ListLikedPostsReq) (*ListLikedPostsResp, error) {
+	where := "user_id = $1 AND emoji = $2 AND " + visiblePostsFilter
+
+	total, err := s.reactionDAO.Count(ctx, where, req.UserID, domain.ReactionLike)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count likes: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	likes, err := s.activityDAO.FindLikedPosts(ctx, req.PerPage, offset, where, req.UserID, domain.ReactionLike)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load likes: %w", err)
+	}
+
+	items := make([]LikedPostItem, 0, len(likes))
+	for _, like := range likes {
+		items = append(items, LikedPostItem{
+			Post:    newPostCard(&like.Post),
+			LikedAt: like.LikedAt,
+		})
+	}
+
+	return &ListLikedPostsResp{
+		Page:    req.Page,
+		PerPage: req.PerPage,
+		Total:   int(total),
+		Items:   items,
+	}, nil
+}
+
+func (s *ListLikedPosts) ParseRequest(c *gin.Context, userID string) (*ListLikedPostsReq, error) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	return &ListLikedPostsReq{
+		UserID:  userID,
+		Page:    page,
+		PerPage: perPage,
+	}, nil
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+// mentionExcerptLength caps the comment text shown next to a mention.
+const mentionExcerptLength = 200
+
+type ListMentions struct {
+	mentionDAO dao.MentionDAO
+	userDAO    dao.UserDAO
+	postDAO    dao.PostDAO
+	commentDAO dao.CommentDAO
+}
+
+type ListMentionsReq struct {
+	UserID  string
+	Page    int
+	PerPage int
+}
+
+type MentionPost struct {
+	ID    string `json:"id"`
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+}
+
+type MentionItem struct {
+	ID        string      `json:"id"`
+	Author    AuthorInfo  `json:"author"`
+	Post      MentionPost `json:"post"`
+	CommentID *string     `json:"comment_id"`
+	Excerpt   string      `json:"excerpt"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type ListMentionsResp struct {
+	Page    int           `json:"page"`
+	PerPage int           `json:"per_page"`
+	Total   int           `json:"total"`
+	Items   []MentionItem `json:"items"`
+}
+
+func NewListMentions(mentionDAO dao.MentionDAO, userDAO dao.UserDAO, postDAO dao.PostDAO, commentDAO dao.CommentDAO) *ListMentions {
+	return &ListMentions{
+		mentionDAO: mentionDAO,
+		userDAO:    userDAO,
+		postDAO:    postDAO,
+		commentDAO: commentDAO,
+	}
+}
+
+func (s *ListMentions) Exec(ctx context.Context, req *ListMentionsReq) (*ListMentionsResp, error) {
+	// Mentions in unpublished posts or hidden comments are not shown
+	where := "mentioned_user_id = $1 AND post_id IN (SELECT id FROM posts WHERE published_at IS NOT NULL AND hidden_at IS NULL) AND " +
+		"(comment_id IS NULL OR comment_id IN (SELECT id FROM comments WHERE status = $2 AND deleted_at IS NULL)) AND " +
+		hiddenAuthorsFilter("author_id", "$1")
+	args := []any{req.UserID, domain.CommentStatusApproved}
+
+	total, err := s.mentionDAO.Count(ctx, where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count mentions: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	mentions, err := s.mentionDAO.FindPaginated(ctx, req.PerPage, offset, where, "created_at DESC, id DESC", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load mentions: %w", err)
+	}
+
+	authorIDs := make([]any, 0)
+	authorPlaceholders := make([]string, 0)
+	postIDs := make([]any, 0)
+	postPlaceholders := make([]string, 0)
+	commentIDs := make([]any, 0)
+	commentPlaceholders := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, mention := range mentions {
+		if !seen[mention.AuthorID] {
+			seen[mention.AuthorID] = true
+			authorIDs = append(authorIDs, mention.AuthorID)
+			authorPlaceholders = append(authorPlaceholders, fmt.Sprintf("$%d", len(authorIDs)))
+		}
+		if !seen[mention.PostID] {
+			seen[mention.PostID] = true
+			postIDs = append(postIDs, mention.PostID)
+			postPlaceholders = append(postPlaceholders, fmt.Sprintf("$%d", len(postIDs)))
+		}
+		if mention.CommentID != nil {
+			commentIDs = append(commentIDs, *mention.CommentID)
+			commentPlaceholders = append(commentPlaceholders, fmt.Sprintf("$%d", len(commentIDs)))
+		}
+	}
+
+	authorsMap := make(map[string]*dao.User)
+	if len(authorIDs) > 0 {
+		authors, err := s.userDAO.FindAll(ctx, "id IN ("+strings.Join(authorPlaceholders, ",")+")", "", authorIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load mention authors: %w", err)
+		}
+		for _, author := range authors {
+			authorsMap[author.ID] = author
+		}
+	}
+
+	postsMap := make(map[string]*dao.Post)
+	if len(postIDs) > 0 {
+		posts, err := s.postDAO.FindAll(ctx, "id IN ("+strings.Join(postPlaceholders, ",")+")", "", postIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load posts: %w", err)
+		}
+		for _, post := range posts {
+			postsMap[post.ID] = post
+		}
+	}
+
+	commentsMap := make(map[string]*dao.Comment)
+	if len(commentIDs) > 0 {
+		comments, err := s.commentDAO.FindAll(ctx, "id IN ("+strings.Join(commentPlaceholders, ",")+")", "", commentIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load comments: %w", err)
+		}
+		for _, comment := range comments {
+			commentsMap[comment.ID] = comment
+		}
+	}
+
+	items := make([]MentionItem, 0, len(mentions))
+	for _, mention := range mentions {
+		author, ok := authorsMap[mention.AuthorID]
+		if !ok {
+			return nil, fmt.Errorf("mention author %s not found", mention.AuthorID)
+		}
+
+		post, ok := postsMap[mention.PostID]
+		if !ok {
+			return nil, fmt.Errorf("post %s not found", mention.PostID)
+		}
+
+		excerpt := post.Summary
+		if mention.CommentID != nil {
+			if comment, ok := commentsMap[*mention.CommentID]; ok {
+				excerpt = mentionExcerpt(comment.Body)
+			}
+		}
+
+		items = append(items, MentionItem{
+			ID:        mention.ID,
+			Author:    AuthorInfo{ID: author.ID, Name: author.Username},
+			Post:      MentionPost{ID: post.ID, Slug: post.Slug, Title: post.Title},
+			CommentID: mention.CommentID,
+			Excerpt:   excerpt,
+			CreatedAt: mention.CreatedAt,
+		})
+	}
+
+	return &ListMentionsResp{
+		Page:    req.Page,
+		PerPage: req.PerPage,
+		Total:   int(total),
+		Items:   items,
+	}, nil
+}
+
+func (s *ListMentions) ParseRequest(c *gin.Context, userID string) (*ListMentionsReq, error) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	return &ListMentionsReq{
+		UserID:  userID,
+		Page:    page,
+		PerPage: perPage,
+	}, nil
+}
+
+func mentionExcerpt(body string) string {
+	runes := []rune(body)
+	if len(runes) <= mentionExcerptLength {
+		return body
+	}
+	return string(runes[:mentionExcerptLength]) + "…"
+}
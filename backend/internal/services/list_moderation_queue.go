@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type ListModerationQueue struct {
+	postDAO    dao.PostDAO
+	userDAO    dao.UserDAO
+	commentDAO dao.CommentDAO
+}
+
+type ListModerationQueueReq struct {
+	UserID  string
+	Status  string
+	Page    int
+	PerPage int
+}
+
+type ModerationPost struct {
+	ID    string `json:"id"`
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+}
+
+type ModerationItem struct {
+	ID               string         `json:"id"`
+	Post             ModerationPost `json:"post"`
+	Author           AuthorInfo     `json:"author"`
+	ParentID         *string        `json:"parent_id"`
+	Body             string         `json:"body"`
+	Status           string         `json:"status"`
+	ModerationReason *string        `json:"moderation_reason"`
+	CreatedAt        time.Time      `json:"created_at"`
+}
+
+type ListModerationQueueResp struct {
+	Page    int              `json:"page"`
+	PerPage int              `json:"per_page"`
+	Total   int              `json:"total"`
+	Items   []ModerationItem `json:"items"`
+}
+
+func NewListModerationQueue(postDAO dao.PostDAO, userDAO dao.UserDAO, commentDAO dao.CommentDAO) *ListModerationQueue {
+	return &ListModerationQueue{
+		postDAO:    postDAO,
+		userDAO:    userDAO,
+		commentDAO: commentDAO,
+	}
+}
+
+func (s *ListModerationQueue) Exec(ctx context.Context, req *ListModerationQueueReq) (*ListModerationQueueResp, error) {
+	where := "status = $1 AND deleted_at IS NULL AND post_id IN (SELECT id FROM posts WHERE author_id = $2)"
+	args := []any{req.Status, req.UserID}
+
+	total, err := s.commentDAO.Count(ctx, where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count comments: %w", err)
+	}
+
+	// Oldest first, so nobody waits forever
+	offset := (req.Page - 1) * req.PerPage
+	comments, err := s.commentDAO.FindPaginated(ctx, req.PerPage, offset, where, "created_at ASC, id ASC", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load comments: %w", err)
+	}
+
+	postIDs := make([]any, 0)
+	postPlaceholders := make([]string, 0)
+	authorIDs := make([]any, 0)
+	authorPlaceholders := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, comment := range comments {
+		if !seen[comment.PostID] {
+			seen[comment.PostID] = true
+			postIDs = append(postIDs, comment.PostID)
+			postPlaceholders = append(postPlaceholders, fmt.Sprintf("$%d", len(postIDs)))
+		}
+		if !seen[comment.AuthorID] {
+			seen[comment.AuthorID] = true
+			authorIDs = append(authorIDs, comment.AuthorID)
+			authorPlaceholders = append(authorPlaceholders, fmt.Sprintf("$%d", len(authorIDs)))
+		}
+	}
+
+	postsMap := make(map[string]*dao.Post)
+	if len(postIDs) > 0 {
+		posts, err := s.postDAO.FindAll(ctx, "id IN ("+strings.Join(postPlaceholders, ",")+")", "", postIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load posts: %w", err)
+		}
+		for _, post := range posts {
+			postsMap[post.ID] = post
+		}
+	}
+
+	authorsMap := make(map[string]*dao.User)
+	if len(authorIDs) > 0 {
+		authors, err := s.userDAO.FindAll(ctx, "id IN ("+strings.Join(authorPlaceholders, ",")+")", "", authorIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load comment authors: %w", err)
+		}
+		for _, author := range authors {
+			authorsMap[author.ID] = author
+		}
+	}
+
+	items := make([]ModerationItem, 0, len(comments))
+	for _, comment := range comments {
+		post, ok := postsMap[comment.PostID]
+		if !ok {
+			return nil, fmt.Errorf("post %s not found", comment.PostID)
+		}
+
+		author, ok := authorsMap[comment.AuthorID]
+		if !ok {
+			return nil, fmt.Errorf("comment author %s not found", comment.AuthorID)
+		}
+
+		items = append(items, ModerationItem{
+			ID:               comment.ID,
+			Post:             ModerationPost{ID: post.ID, Slug: post.Slug, Title: post.Title},
+			Author:           AuthorInfo{ID: author.ID, Name: author.Username},
+			ParentID:         comment.ParentID,
+			Body:             comment.Body,
+			Status:           comment.Status,
+			ModerationReason: comment.ModerationReason,
+			CreatedAt:        comment.CreatedAt,
+		})
+	}
+
+	return &ListModerationQueueResp{
+		Page:    req.Page,
+		PerPage: req.PerPage,
+		Total:   int(total),
+		Items:   items,
+	}, nil
+}
+
+func (s *ListModerationQueue) ParseRequest(c *gin.Context, userID string) (*ListModerationQueueReq, error) {
+	status := domain.CommentStatusPending
+	if st := c.Query("status"); st != "" {
+		if st != domain.CommentStatusPending && st != domain.CommentStatusRejected {
+			return nil, fmt.Errorf("status must be one of pending, rejected")
+		}
+		status = st
+	}
+
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	return &ListModerationQueueReq{
+		UserID:  userID,
+		Status:  status,
+		Page:    page,
+		PerPage: perPage,
+	}, nil
+}
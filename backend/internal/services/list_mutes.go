@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"blog0/internal/domain/dao"
+)
+
+type ListMutes struct {
+	userDAO dao.UserDAO
+	muteDAO dao.MuteDAO
+}
+
+type ListMutesReq struct {
+	UserID string `json:"-"`
+}
+
+type MuteItem struct {
+	User      ProfileUser `json:"user"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type ListMutesResp struct {
+	Items []MuteItem `json:"items"`
+}
+
+func NewListMutes(userDAO dao.UserDAO, muteDAO dao.MuteDAO) *ListMutes {
+	return &ListMutes{
+		userDAO: userDAO,
+		muteDAO: muteDAO,
+	}
+}
+
+func (s *ListMutes) Exec(ctx context.Context, req *ListMutesReq) (*ListMutesResp, error) {
+	mutes, err := s.muteDAO.FindAll(ctx, "muter_id = $1", "created_at DESC", req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mutes: %w", err)
+	}
+
+	userIDs := make([]any, 0, len(mutes))
+	placeholders := make([]string, 0, len(mutes))
+	for _, mute := range mutes {
+		userIDs = append(userIDs, mute.MutedID)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(userIDs)))
+	}
+
+	usersMap := make(map[string]*dao.User)
+	if len(userIDs) > 0 {
+		users, err := s.userDAO.FindAll(ctx, "id IN ("+strings.Join(placeholders, ",")+")", "", userIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load muted users: %w", err)
+		}
+		for _, user := range users {
+			usersMap[user.ID] = user
+		}
+	}
+
+	items := make([]MuteItem, 0, len(mutes))
+	for _, mute := range mutes {
+		user, ok := usersMap[mute.MutedID]
+		if !ok {
+			continue // Skip if user not found
+		}
+		items = append(items, MuteItem{
+			User:      ProfileUser{ID: user.ID, Username: user.Username},
+			CreatedAt: mute.CreatedAt,
+		})
+	}
+
+	return &ListMutesResp{
+		Items: items,
+	}, nil
+}
@@ -77,6 +77,9 @@ func (s *ListMyPosts) Exec(ctx context.Context, req *ListMyPostsReq) (*ListMyPos
 		if post.PublishedAt != nil {
 			status = "published"
 		}
+		if post.IsHidden() {
+			status = "hidden"
+		}
 
 		items = append(items, MyPostItem{
 			ID:          post.ID,
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+// maxNotificationActors is how many actors are returned per aggregated
+// notification; the rest are only reflected in ActorsCount.
+const maxNotificationActors = 3
+
+type ListNotifications struct {
+	notificationDAO dao.NotificationDAO
+	userDAO         dao.UserDAO
+	postDAO         dao.PostDAO
+	reportDAO       dao.ReportDAO
+}
+
+type ListNotificationsReq struct {
+	UserID     string
+	Page       int
+	PerPage    int
+	UnreadOnly bool
+}
+
+type NotificationPost struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
+}
+
+type NotificationReport struct {
+	ID         string  `json:"id"`
+	TargetType string  `json:"target_type"`
+	Reason     string  `json:"reason"`
+	Status     string  `json:"status"`
+	Resolution *string `json:"resolution"`
+}
+
+type NotificationItem struct {
+	ID          string              `json:"id"`
+	Type        string              `json:"type"`
+	Message     string              `json:"message"`
+	Actors      []AuthorInfo        `json:"actors"`
+	ActorsCount int                 `json:"actors_count"`
+	Post        *NotificationPost   `json:"post"`
+	CommentID   *string             `json:"comment_id"`
+	Report      *NotificationReport `json:"report"`
+	Read        bool                `json:"read"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+type ListNotificationsResp struct {
+	Page        int                `json:"page"`
+	PerPage     int                `json:"per_page"`
+	Total       int                `json:"total"`
+	UnreadCount int                `json:"unread_count"`
+	Items       []NotificationItem `json:"items"`
+}
+
+func NewListNotifications(notificationDAO dao.NotificationDAO, userDAO dao.UserDAO, postDAO dao.PostDAO, reportDAO dao.ReportDAO) *ListNotifications {
+	return &ListNotifications{
+		notificationDAO: notificationDAO,
+		userDAO:         userDAO,
+		postDAO:         postDAO,
+		reportDAO:       reportDAO,
+	}
+}
+
+func (s *ListNotifications) Exec(ctx context.Context, req *ListNotificationsReq) (*ListNotificationsResp, error) {
+	where := "recipient_id = $1"
+	if req.UnreadOnly {
+		where += " AND read_at IS NULL"
+	}
+
+	limit := req.PerPage
+	offset := (req.Page - 1) * req.PerPage
+
+	notifications, err := s.notificationDAO.FindPaginated(ctx, limit, offset, where, "updated_at DESC", req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load notifications: %w", err)
+	}
+
+	total, err := s.notificationDAO.Count(ctx, where, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	unreadCount, err := s.notificationDAO.Count(ctx, "recipient_id = $1 AND read_at IS NULL", req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+
+	// Collect the actors, posts and reports referenced by this page
+	actorIDs := make([]any, 0)
+	actorPlaceholders := make([]string, 0)
+	seenActors := make(map[string]bool)
+	postIDs := make([]any, 0)
+	postPlaceholders := make([]string, 0)
+	seenPosts := make(map[string]bool)
+	reportIDs := make([]any, 0)
+	reportPlaceholders := make([]string, 0)
+	for _, notification := range notifications {
+		if notification.ReportID != nil {
+			reportIDs = append(reportIDs, *notification.ReportID)
+			reportPlaceholders = append(reportPlaceholders, fmt.Sprintf("$%d", len(reportIDs)))
+			continue
+		}
+		for _, actorID := range latestActors(notification.ItsActorIDs()) {
+			if !seenActors[actorID] {
+				seenActors[actorID] = true
+				actorIDs = append(actorIDs, actorID)
+				actorPlaceholders = append(actorPlaceholders, fmt.Sprintf("$%d", len(actorIDs)))
+			}
+		}
+		if notification.PostID != nil && !seenPosts[*notification.PostID] {
+			seenPosts[*notification.PostID] = true
+			postIDs = append(postIDs, *notification.PostID)
+			postPlaceholders = append(postPlaceholders, fmt.Sprintf("$%d", len(postIDs)))
+		}
+	}
+
+	actorsMap := make(map[string]*dao.User)
+	if len(actorIDs) > 0 {
+		actors, err := s.userDAO.FindAll(ctx, "id IN ("+strings.Join(actorPlaceholders, ",")+")", "", actorIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load notification actors: %w", err)
+		}
+		for _, actor := range actors {
+			actorsMap[actor.ID] = actor
+		}
+	}
+
+	postsMap := make(map[string]*dao.Post)
+	if len(postIDs) > 0 {
+		posts, err := s.postDAO.FindAll(ctx, "id IN ("+strings.Join(postPlaceholders, ",")+")", "", postIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load notification posts: %w", err)
+		}
+		for _, post := range posts {
+			postsMap[post.ID] = post
+		}
+	}
+
+	reportsMap := make(map[string]*dao.Report)
+	if len(reportIDs) > 0 {
+		reports, err := s.reportDAO.FindAll(ctx, "id IN ("+strings.Join(reportPlaceholders, ",")+")", "", reportIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load notification reports: %w", err)
+		}
+		for _, report := range reports {
+			reportsMap[report.ID] = report
+		}
+	}
+
+	items := make([]NotificationItem, 0, len(notifications))
+	for _, notification := range notifications {
+		allActorIDs := notification.ItsActorIDs()
+
+		var report *NotificationReport
+		if notification.ReportID != nil {
+			if r, ok := reportsMap[*notification.ReportID]; ok {
+				report = &NotificationReport{
+					ID:         r.ID,
+					TargetType: r.TargetType,
+					Reason:     r.Reason,
+					Status:     r.Status,
+					Resolution: r.Resolution,
+				}
+			}
+		}
+
+		actors := make([]AuthorInfo, 0)
+		var message string
+		actorsCount := len(allActorIDs)
+		if notification.Type == domain.NotificationTypeReport {
+			// Admins act anonymously
+			message = reportOutcomeMessage(report)
+			actorsCount = 0
+		} else {
+			for _, actorID := range latestActors(allActorIDs) {
+				if actor, ok := actorsMap[actorID]; ok {
+					actors = append(actors, AuthorInfo{ID: actor.ID, Name: actor.Username})
+				}
+			}
+			message = notificationMessage(notification.Type, actors, actorsCount)
+		}
+
+		var post *NotificationPost
+		if notification.PostID != nil {
+			if p, ok := postsMap[*notification.PostID]; ok {
+				post = &NotificationPost{ID: p.ID, Title: p.Title, Slug: p.Slug}
+			}
+		}
+
+		items = append(items, NotificationItem{
+			ID:          notification.ID,
+			Type:        notification.Type,
+			Message:     message,
+			Actors:      actors,
+			ActorsCount: actorsCount,
+			Post:        post,
+			CommentID:   notification.CommentID,
+			Report:      report,
+			Read:        notification.IsRead(),
+			CreatedAt:   notification.CreatedAt,
+			UpdatedAt:   notification.UpdatedAt,
+		})
+	}
+
+	return &ListNotificationsResp{
+		Page:        req.Page,
+		PerPage:     req.PerPage,
+		Total:       int(total),
+		UnreadCount: int(unreadCount),
+		Items:       items,
+	}, nil
+}
+
+func (s *ListNotifications) ParseRequest(c *gin.Context, userID string) (*ListNotificationsReq, error) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	unreadOnly, _ := strconv.ParseBool(c.Query("unread_only"))
+
+	return &ListNotificationsReq{
+		UserID:     userID,
+		Page:       page,
+		PerPage:    perPage,
+		UnreadOnly: unreadOnly,
+	}, nil
+}
+
+// latestActors returns the most recent actors first, capped to maxNotificationActors.
+func latestActors(actorIDs []string) []string {
+	latest := make([]string, 0, maxNotificationActors)
+	for i := len(actorIDs) - 1; i >= 0 && len(latest) < maxNotificationActors; i-- {
+		latest = append(latest, actorIDs[i])
+	}
+	return latest
+}
+
+func notificationMessage(kind string, actors []AuthorInfo, actorsCount int) string {
+	who := "Someone"
+	switch {
+	case len(actors) == 0:
+		if actorsCount > 1 {
+			who = fmt.Sprintf("%d people", actorsCount)
+		}
+	case actorsCount == 1:
+		who = actors[0].Name
+	case actorsCount == 2 && len(actors) == 2:
+		who = actors[0].Name + " and " + actors[1].Name
+	default:
+		who = fmt.Sprintf("%s and %d others", actors[0].Name, actorsCount-1)
+	}
+
+	switch kind {
+	case domain.NotificationTypeComment:
+		return who + " commented on your post"
+	case domain.NotificationTypeReply:
+		return who + " replied to your comment"
+	case domain.NotificationTypeLike:
+		return who + " liked your post"
+	case domain.NotificationTypeFollow:
+		return who + " followed you"
+	case domain.NotificationTypeMention:
+		return who + " mentioned you"
+	default:
+		return who + " interacted with you"
+	}
+}
+
+func reportOutcomeMessage(report *NotificationReport) string {
+	if report == nil || report.Resolution == nil {
+		return "Your report was reviewed"
+	}
+
+	switch *report.Resolution {
+	case domain.ReportActionDismiss:
+		return "Your report was reviewed, no action was taken"
+	case domain.ReportActionHideContent:
+		return "Your report was reviewed and the content was removed"
+	case domain.ReportActionSuspendUser:
+		return "Your report was reviewed and the user was suspended"
+	default:
+		return "Your report was reviewed"
+	}
+}
@@ -0,0 +1,21 @@
+package services
+
+import (
+	"context"
+)
+
+type ListOAuthProviders struct {
+	providers *OAuthProviders
+}
+
+type ListOAuthProvidersResp struct {
+	Providers []string `json:"providers"`
+}
+
+func NewListOAuthProviders(providers *OAuthProviders) *ListOAuthProviders {
+	return &ListOAuthProviders{providers: providers}
+}
+
+func (s *ListOAuthProviders) Exec(ctx context.Context) (*ListOAuthProvidersResp, error) {
+	return &ListOAuthProvidersResp{Providers: s.providers.Names()}, nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type ListPersonalAccessTokens struct {
+	tokenDAO dao.PersonalAccessTokenDAO
+}
+
+type ListPersonalAccessTokensReq struct {
+	UserID string
+}
+
+type PersonalAccessTokenItem struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Expired    bool       `json:"expired"`
+}
+
+type ListPersonalAccessTokensResp struct {
+	Items []PersonalAccessTokenItem `json:"items"`
+}
+
+func NewListPersonalAccessTokens(tokenDAO dao.PersonalAccessTokenDAO) *ListPersonalAccessTokens {
+	return &ListPersonalAccessTokens{
+		tokenDAO: tokenDAO,
+	}
+}
+
+// Exec lists the tokens that were not revoked, expired ones included so
+// scripts that stopped working can be traced back to them.
+func (s *ListPersonalAccessTokens) Exec(ctx context.Context, req *ListPersonalAccessTokensReq) (*ListPersonalAccessTokensResp, error) {
+	tokens, err := s.tokenDAO.FindAll(ctx, "user_id = $1 AND revoked_at IS NULL", "created_at DESC", req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tokens: %w", err)
+	}
+
+	items := make([]PersonalAccessTokenItem, 0, len(tokens))
+	for _, token := range tokens {
+		items = append(items, newPersonalAccessTokenItem(token))
+	}
+
+	return &ListPersonalAccessTokensResp{
+		Items: items,
+	}, nil
+}
+
+func newPersonalAccessTokenItem(token *domain.PersonalAccessToken) PersonalAccessTokenItem {
+	return PersonalAccessTokenItem{
+		ID:         token.ID,
+		Name:       token.Name,
+		Prefix:     token.TokenPrefix,
+		Scopes:     token.ItsScopes(),
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		Expired:    !token.IsActive(time.Now()),
+	}
+}
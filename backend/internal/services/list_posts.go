@@ -9,33 +9,36 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"blog0/internal/domain"
 	"blog0/internal/domain/dao"
 )
 
 type ListPosts struct {
-	postDAO     dao.PostDAO
-	userDAO     dao.UserDAO
-	postLikeDAO dao.PostLikeDAO
-	commentDAO  dao.CommentDAO
+	postDAO    dao.PostDAO
+	userDAO    dao.UserDAO
+	commentDAO dao.CommentDAO
+	reactions  *ReactionCounter
 }
 
 type ListPostsReq struct {
-	Page    int
-	PerPage int
-	Order   string
+	Page     int
+	PerPage  int
+	Order    string
+	ViewerID string
 }
 
 type PostItem struct {
-	Title           string    `json:"title"`
-	Summary         string    `json:"summary"`
-	Tags            []string  `json:"tags"`
-	Author          string    `json:"author"`
-	AuthorID        string    `json:"author_id"`
-	PublishedAt     time.Time `json:"published_at"`
-	Slug            string    `json:"slug"`
-	LikeCount       int       `json:"like_count"`
-	CommentCount    int       `json:"comment_count"`
-	SummaryAudioURL *string   `json:"summary_audio_url"`
+	Title           string          `json:"title"`
+	Summary         string          `json:"summary"`
+	Tags            []string        `json:"tags"`
+	Author          string          `json:"author"`
+	AuthorID        string          `json:"author_id"`
+	PublishedAt     time.Time       `json:"published_at"`
+	Slug            string          `json:"slug"`
+	LikeCount       int             `json:"like_count"`
+	CommentCount    int             `json:"comment_count"`
+	Reactions       []ReactionCount `json:"reactions"`
+	SummaryAudioURL *string         `json:"summary_audio_url"`
 }
 
 type ListPostsResp struct {
@@ -45,12 +48,12 @@ type ListPostsResp struct {
 	Items   []PostItem `json:"items"`
 }
 
-func NewListPosts(postDAO dao.PostDAO, userDAO dao.UserDAO, postLikeDAO dao.PostLikeDAO, commentDAO dao.CommentDAO) *ListPosts {
+func NewListPosts(postDAO dao.PostDAO, userDAO dao.UserDAO, commentDAO dao.CommentDAO, reactions *ReactionCounter) *ListPosts {
 	return &ListPosts{
-		postDAO:     postDAO,
-		userDAO:     userDAO,
-		postLikeDAO: postLikeDAO,
-		commentDAO:  commentDAO,
+		postDAO:    postDAO,
+		userDAO:    userDAO,
+		commentDAO: commentDAO,
+		reactions:  reactions,
 	}
 }
 
@@ -58,12 +61,21 @@ func (s *ListPosts) Exec(ctx context.Context, req *ListPostsReq) (*ListPostsResp
 	limit := req.PerPage
 	offset := (req.Page - 1) * req.PerPage
 
-	posts, err := s.postDAO.FindPaginated(ctx, limit, offset, "", "published_at "+req.Order)
+	// Posts hidden by an admin are out, and signed in readers don't see the
+	// posts of users they muted or blocked
+	where := "hidden_at IS NULL"
+	args := make([]any, 0)
+	if req.ViewerID != "" {
+		where += " AND " + hiddenAuthorsFilter("author_id", "$1")
+		args = append(args, req.ViewerID)
+	}
+
+	posts, err := s.postDAO.FindPaginated(ctx, limit, offset, where, "published_at "+req.Order, args...)
 	if err != nil {
 		return nil, err
 	}
 
-	totalPosts, err := s.postDAO.Count(ctx, "")
+	totalPosts, err := s.postDAO.Count(ctx, where, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +89,26 @@ func (s *ListPosts) Exec(ctx context.Context, req *ListPostsReq) (*ListPostsResp
 		}, nil
 	}
 
+	items, err := buildPostItems(ctx, posts, req.ViewerID, s.userDAO, s.commentDAO, s.reactions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListPostsResp{
+		Page:    req.Page,
+		PerPage: req.PerPage,
+		Total:   int(totalPosts),
+		Items:   items,
+	}, nil
+}
+
+// buildPostItems turns posts into cards. ViewerID may be empty for anonymous
+// readers, it only drives the reacted by me flags.
+func buildPostItems(ctx context.Context, posts []*dao.Post, viewerID string, userDAO dao.UserDAO, commentDAO dao.CommentDAO, reactions *ReactionCounter) ([]PostItem, error) {
+	if len(posts) == 0 {
+		return make([]PostItem, 0), nil
+	}
+
 	authorsIDs := make([]any, 0)
 	placeholders := make([]string, 0)
 	for i, post := range posts {
@@ -84,7 +116,7 @@ func (s *ListPosts) Exec(ctx context.Context, req *ListPostsReq) (*ListPostsResp
 		placeholders = append(placeholders, fmt.Sprintf("$%d", i+1))
 	}
 
-	authors, err := s.userDAO.FindAll(ctx, "id IN ("+strings.Join(placeholders, ",")+")", "", authorsIDs...)
+	authors, err := userDAO.FindAll(ctx, "id IN ("+strings.Join(placeholders, ",")+")", "", authorsIDs...)
 	if err != nil {
 		return nil, err
 	}
@@ -94,20 +126,20 @@ func (s *ListPosts) Exec(ctx context.Context, req *ListPostsReq) (*ListPostsResp
 		authorsMap[author.ID] = author
 	}
 
-	// Get like counts for all posts
-	likeCounts := make(map[string]int)
+	// Get reactions for all posts
+	postIDs := make([]string, 0, len(posts))
 	for _, post := range posts {
-		count, err := s.postLikeDAO.Count(ctx, "post_id = $1", post.ID)
-		if err != nil {
-			return nil, err
-		}
-		likeCounts[post.ID] = int(count)
+		postIDs = append(postIDs, post.ID)
+	}
+	postReactions, err := reactions.ForPosts(ctx, postIDs, viewerID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get comment counts for all posts
 	commentCounts := make(map[string]int)
 	for _, post := range posts {
-		count, err := s.commentDAO.Count(ctx, "post_id = $1", post.ID)
+		count, err := commentDAO.Count(ctx, "post_id = $1 AND status = $2", post.ID, domain.CommentStatusApproved)
 		if err != nil {
 			return nil, err
 		}
@@ -129,18 +161,14 @@ func (s *ListPosts) Exec(ctx context.Context, req *ListPostsReq) (*ListPostsResp
 			AuthorID:        author.ID,
 			PublishedAt:     *post.PublishedAt,
 			Slug:            post.Slug,
-			LikeCount:       likeCounts[post.ID],
+			LikeCount:       reactionCountOf(postReactions[post.ID], domain.ReactionLike),
 			CommentCount:    commentCounts[post.ID],
+			Reactions:       postReactions[post.ID],
 			SummaryAudioURL: post.SummaryAudioURL,
 		})
 	}
 
-	return &ListPostsResp{
-		Page:    req.Page,
-		PerPage: req.PerPage,
-		Total:   int(totalPosts),
-		Items:   items,
-	}, nil
+	return items, nil
 }
 
 func (s *ListPosts) ParseRequest(c *gin.Context) (*ListPostsReq, error) {
@@ -164,8 +192,9 @@ func (s *ListPosts) ParseRequest(c *gin.Context) (*ListPostsReq, error) {
 	}
 
 	return &ListPostsReq{
-		Page:    page,
-		PerPage: perPage,
-		Order:   order,
+		Page:     page,
+		PerPage:  perPage,
+		Order:    order,
+		ViewerID: c.GetString("user_id"),
 	}, nil
 }
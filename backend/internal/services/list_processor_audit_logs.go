@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain/dao"
+)
+
+type ListProcessorAuditLogs struct {
+	auditDAO dao.ProcessorAuditLogDAO
+}
+
+type ListProcessorAuditLogsReq struct {
+	ClientID string
+	Page     int
+	PerPage  int
+}
+
+type ProcessorAuditLogItem struct {
+	ID        string    `json:"id"`
+	ClientID  string    `json:"client_id"`
+	Op        string    `json:"op"`
+	AuthorID  string    `json:"author_id"`
+	PostID    string    `json:"post_id"`
+	Succeeded bool      `json:"succeeded"`
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListProcessorAuditLogsResp struct {
+	Page    int                     `json:"page"`
+	PerPage int                     `json:"per_page"`
+	Total   int                     `json:"total"`
+	Items   []ProcessorAuditLogItem `json:"items"`
+}
+
+func NewListProcessorAuditLogs(auditDAO dao.ProcessorAuditLogDAO) *ListProcessorAuditLogs {
+	return &ListProcessorAuditLogs{
+		auditDAO: auditDAO,
+	}
+}
+
+func (s *ListProcessorAuditLogs) Exec(ctx context.Context, req *ListProcessorAuditLogsReq) (*ListProcessorAuditLogsResp, error) {
+	total, err := s.auditDAO.Count(ctx, "client_id = $1", req.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count audit logs: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	logs, err := s.auditDAO.FindPaginated(ctx, req.PerPage, offset, "client_id = $1", "created_at DESC, id DESC", req.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load audit logs: %w", err)
+	}
+
+	items := make([]ProcessorAuditLogItem, 0, len(logs))
+	for _, log := range logs {
+		items = append(items, ProcessorAuditLogItem{
+			ID:        log.ID,
+			ClientID:  log.ClientID,
+			Op:        log.Op,
+			AuthorID:  log.AuthorID,
+			PostID:    log.PostID,
+			Succeeded: log.Succeeded,
+			Error:     log.Error,
+			CreatedAt: log.CreatedAt,
+		})
+	}
+
+	return &ListProcessorAuditLogsResp{
+		Page:    req.Page,
+		PerPage: req.PerPage,
+		Total:   int(total),
+		Items:   items,
+	}, nil
+}
+
+func (s *ListProcessorAuditLogs) ParseRequest(c *gin.Context) (*ListProcessorAuditLogsReq, error) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	return &ListProcessorAuditLogsReq{
+		ClientID: c.Param("id"),
+		Page:     page,
+		PerPage:  perPage,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type ListProcessorClients struct {
+	clientDAO dao.ProcessorClientDAO
+}
+
+type ProcessorClientItem struct {
+	ID               string     `json:"id"`
+	Name             string     `json:"name"`
+	AllowedAuthorIDs []string   `json:"allowed_author_ids"`
+	AllowedOps       []string   `json:"allowed_ops"`
+	CreatedAt        time.Time  `json:"created_at"`
+	LastUsedAt       *time.Time `json:"last_used_at"`
+	DisabledAt       *time.Time `json:"disabled_at"`
+}
+
+type ListProcessorClientsResp struct {
+	Items []ProcessorClientItem `json:"items"`
+}
+
+func NewListProcessorClients(clientDAO dao.ProcessorClientDAO) *ListProcessorClients {
+	return &ListProcessorClients{
+		clientDAO: clientDAO,
+	}
+}
+
+func (s *ListProcessorClients) Exec(ctx context.Context) (*ListProcessorClientsResp, error) {
+	clients, err := s.clientDAO.FindAll(ctx, "", "created_at DESC")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load processor clients: %w", err)
+	}
+
+	items := make([]ProcessorClientItem, 0, len(clients))
+	for _, client := range clients {
+		items = append(items, newProcessorClientItem(client))
+	}
+
+	return &ListProcessorClientsResp{
+		Items: items,
+	}, nil
+}
+
+func newProcessorClientItem(client *domain.ProcessorClient) ProcessorClientItem {
+	return ProcessorClientItem{
+		ID:               client.ID,
+		Name:             client.Name,
+		AllowedAuthorIDs: client.ItsAllowedAuthorIDs(),
+		AllowedOps:       client.ItsAllowedOps(),
+		CreatedAt:        client.CreatedAt,
+		LastUsedAt:       client.LastUsedAt,
+		DisabledAt:       client.DisabledAt,
+	}
+}
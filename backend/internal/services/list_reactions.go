@@ -0,0 +1,23 @@
+package services
+
+import "context"
+
+type ListReactions struct {
+	reactions *ReactionCounter
+}
+
+type ListReactionsResp struct {
+	Reactions []string `json:"reactions"`
+}
+
+func NewListReactions(reactions *ReactionCounter) *ListReactions {
+	return &ListReactions{
+		reactions: reactions,
+	}
+}
+
+func (s *ListReactions) Exec(ctx context.Context) (*ListReactionsResp, error) {
+	return &ListReactionsResp{
+		Reactions: s.reactions.Set(),
+	}, nil
+}
@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type ListReports struct {
+	reportDAO  dao.ReportDAO
+	postDAO    dao.PostDAO
+	commentDAO dao.CommentDAO
+	userDAO    dao.UserDAO
+}
+
+type ListReportsReq struct {
+	Status     string
+	TargetType string
+	Page       int
+	PerPage    int
+}
+
+type ReportedComment struct {
+	ID     string `json:"id"`
+	Body   string `json:"body"`
+	Status string `json:"status"`
+}
+
+type ReportItem struct {
+	ID           string           `json:"id"`
+	TargetType   string           `json:"target_type"`
+	Post         *ModerationPost  `json:"post"`
+	Comment      *ReportedComment `json:"comment"`
+	ReportedUser AuthorInfo       `json:"reported_user"`
+	Reporter     AuthorInfo       `json:"reporter"`
+	Reason       string           `json:"reason"`
+	Details      string           `json:"details"`
+	Status       string           `json:"status"`
+	Resolution   *string          `json:"resolution"`
+	ResolvedAt   *time.Time       `json:"resolved_at"`
+	CreatedAt    time.Time        `json:"created_at"`
+}
+
+type ListReportsResp struct {
+	Page    int          `json:"page"`
+	PerPage int          `json:"per_page"`
+	Total   int          `json:"total"`
+	Items   []ReportItem `json:"items"`
+}
+
+func NewListReports(reportDAO dao.ReportDAO, postDAO dao.PostDAO, commentDAO dao.CommentDAO, userDAO dao.UserDAO) *ListReports {
+	return &ListReports{
+		reportDAO:  reportDAO,
+		postDAO:    postDAO,
+		commentDAO: commentDAO,
+		userDAO:    userDAO,
+	}
+}
+
+func (s *ListReports) Exec(ctx context.Context, req *ListReportsReq) (*ListReportsResp, error) {
+	where := "status = $1"
+	args := []any{req.Status}
+	if req.TargetType != "" {
+		where += " AND target_type = $2"
+		args = append(args, req.TargetType)
+	}
+
+	total, err := s.reportDAO.Count(ctx, where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count reports: %w", err)
+	}
+
+	// The open queue is worked oldest first, resolved reports are history
+	sort := "created_at ASC, id ASC"
+	if req.Status != domain.ReportStatusOpen {
+		sort = "resolved_at DESC, id DESC"
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	reports, err := s.reportDAO.FindPaginated(ctx, req.PerPage, offset, where, sort, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load reports: %w", err)
+	}
+
+	postIDs := make([]any, 0)
+	postPlaceholders := make([]string, 0)
+	commentIDs := make([]any, 0)
+	commentPlaceholders := make([]string, 0)
+	userIDs := make([]any, 0)
+	userPlaceholders := make([]string, 0)
+	seenUsers := make(map[string]bool)
+	for _, report := range reports {
+		if report.PostID != nil {
+			postIDs = append(postIDs, *report.PostID)
+			postPlaceholders = append(postPlaceholders, fmt.Sprintf("$%d", len(postIDs)))
+		}
+		if report.CommentID != nil {
+			commentIDs = append(commentIDs, *report.CommentID)
+			commentPlaceholders = append(commentPlaceholders, fmt.Sprintf("$%d", len(commentIDs)))
+		}
+		for _, userID := range []string{report.ReporterID, report.ReportedUserID} {
+			if !seenUsers[userID] {
+				seenUsers[userID] = true
+				userIDs = append(userIDs, userID)
+				userPlaceholders = append(userPlaceholders, fmt.Sprintf("$%d", len(userIDs)))
+			}
+		}
+	}
+
+	postsMap := make(map[string]*dao.Post)
+	if len(postIDs) > 0 {
+		posts, err := s.postDAO.FindAll(ctx, "id IN ("+strings.Join(postPlaceholders, ",")+")", "", postIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load reported posts: %w", err)
+		}
+		for _, post := range posts {
+			postsMap[post.ID] = post
+		}
+	}
+
+	commentsMap := make(map[string]*dao.Comment)
+	if len(commentIDs) > 0 {
+		comments, err := s.commentDAO.FindAll(ctx, "id IN ("+strings.Join(commentPlaceholders, ",")+")", "", commentIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load reported comments: %w", err)
+		}
+		for _, comment := range comments {
+			commentsMap[comment.ID] = comment
+		}
+	}
+
+	usersMap := make(map[string]*dao.User)
+	if len(userIDs) > 0 {
+		users, err := s.userDAO.FindAll(ctx, "id IN ("+strings.Join(userPlaceholders, ",")+")", "", userIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load reported users: %w", err)
+		}
+		for _, user := range users {
+			usersMap[user.ID] = user
+		}
+	}
+
+	items := make([]ReportItem, 0, len(reports))
+	for _, report := range reports {
+		reporter, ok := usersMap[report.ReporterID]
+		if !ok {
+			return nil, fmt.Errorf("reporter %s not found", report.ReporterID)
+		}
+
+		reportedUser, ok := usersMap[report.ReportedUserID]
+		if !ok {
+			return nil, fmt.Errorf("reported user %s not found", report.ReportedUserID)
+		}
+
+		var post *ModerationPost
+		if report.PostID != nil {
+			if p, ok := postsMap[*report.PostID]; ok {
+				post = &ModerationPost{ID: p.ID, Slug: p.Slug, Title: p.Title}
+			}
+		}
+
+		var comment *ReportedComment
+		if report.CommentID != nil {
+			if c, ok := commentsMap[*report.CommentID]; ok {
+				comment = &ReportedComment{ID: c.ID, Body: c.Body, Status: c.Status}
+			}
+		}
+
+		items = append(items, ReportItem{
+			ID:           report.ID,
+			TargetType:   report.TargetType,
+			Post:         post,
+			Comment:      comment,
+			ReportedUser: AuthorInfo{ID: reportedUser.ID, Name: reportedUser.Username},
+			Reporter:     AuthorInfo{ID: reporter.ID, Name: reporter.Username},
+			Reason:       report.Reason,
+			Details:      report.Details,
+			Status:       report.Status,
+			Resolution:   report.Resolution,
+			ResolvedAt:   report.ResolvedAt,
+			CreatedAt:    report.CreatedAt,
+		})
+	}
+
+	return &ListReportsResp{
+		Page:    req.Page,
+		PerPage: req.PerPage,
+		Total:   int(total),
+		Items:   items,
+	}, nil
+}
+
+func (s *ListReports) ParseRequest(c *gin.Context) (*ListReportsReq, error) {
+	status := domain.ReportStatusOpen
+	if st := c.Query("status"); st != "" {
+		if st != domain.ReportStatusOpen && st != domain.ReportStatusDismissed && st != domain.ReportStatusActioned {
+			return nil, fmt.Errorf("status must be one of open, dismissed, actioned")
+		}
+		status = st
+	}
+
+	targetType := c.Query("target_type")
+	if targetType != "" && targetType != domain.ReportTargetPost && targetType != domain.ReportTargetComment && targetType != domain.ReportTargetUser {
+		return nil, fmt.Errorf("target_type must be one of post, comment, user")
+	}
+
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	return &ListReportsReq{
+		Status:     status,
+		TargetType: targetType,
+		Page:       page,
+		PerPage:    perPage,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain/dao"
+)
+
+type ListSessions struct {
+	sessionDAO dao.SessionDAO
+}
+
+type ListSessionsReq struct {
+	UserID    string
+	SessionID string
+}
+
+type SessionItem struct {
+	ID         string    `json:"id"`
+	Device     string    `json:"device"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+type ListSessionsResp struct {
+	Items []SessionItem `json:"items"`
+}
+
+func NewListSessions(sessionDAO dao.SessionDAO) *ListSessions {
+	return &ListSessions{
+		sessionDAO: sessionDAO,
+	}
+}
+
+func (s *ListSessions) Exec(ctx context.Context, req *ListSessionsReq) (*ListSessionsResp, error) {
+	sessions, err := s.sessionDAO.FindAll(ctx, "user_id = $1 AND revoked_at IS NULL AND expires_at > $2", "last_seen_at DESC", req.UserID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sessions: %w", err)
+	}
+
+	items := make([]SessionItem, 0, len(sessions))
+	for _, session := range sessions {
+		items = append(items, SessionItem{
+			ID:         session.ID,
+			Device:     session.UserAgent,
+			IP:         session.IP,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    session.ID == req.SessionID,
+		})
+	}
+
+	return &ListSessionsResp{
+		Items: items,
+	}, nil
+}
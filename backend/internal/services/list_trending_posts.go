@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+)
+
+type ListTrendingPosts struct {
+	rankingDAO customdao.RankingDAO
+}
+
+type ListTrendingPostsReq struct {
+	Window   string
+	ViewerID string
+	Page     int
+	PerPage  int
+}
+
+// TrendingActivity counts what happened to a post within the window.
+type TrendingActivity struct {
+	Likes     int `json:"likes"`
+	Comments  int `json:"comments"`
+	Bookmarks int `json:"bookmarks"`
+	Views     int `json:"views"`
+}
+
+type TrendingPostItem struct {
+	Post     PostCard         `json:"post"`
+	Score    float64          `json:"score"`
+	Activity TrendingActivity `json:"activity"`
+}
+
+type ListTrendingPostsResp struct {
+	Window string `json:"window"`
+	// RefreshedAt is when the rankings were computed, null when nothing ranks.
+	RefreshedAt *time.Time         `json:"refreshed_at"`
+	Page        int                `json:"page"`
+	PerPage     int                `json:"per_page"`
+	Total       int                `json:"total"`
+	Items       []TrendingPostItem `json:"items"`
+}
+
+func NewListTrendingPosts(rankingDAO customdao.RankingDAO) *ListTrendingPosts {
+	return &ListTrendingPosts{
+		rankingDAO: rankingDAO,
+	}
+}
+
+func (s *ListTrendingPosts) Exec(ctx context.Context, req *ListTrendingPostsReq) (*ListTrendingPostsResp, error) {
+	// Rankings outlive a post being hidden or unpublished until the next
+	// refresh, and signed in readers don't see the posts of users they muted
+	// or blocked
+	where := "period = $1 AND " + visiblePostsFilter
+	args := []any{req.Window}
+	if req.ViewerID != "" {
+		where += " AND post_id IN (SELECT id FROM posts WHERE " + hiddenAuthorsFilter("author_id", "$2") + ")"
+		args = append(args, req.ViewerID)
+	}
+
+	total, err := s.rankingDAO.CountRankedPosts(ctx, where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count trending posts: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	ranked, err := s.rankingDAO.FindRankedPosts(ctx, req.PerPage, offset, where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load trending posts: %w", err)
+	}
+
+	var refreshedAt *time.Time
+	items := make([]TrendingPostItem, 0, len(ranked))
+	for _, ranking := range ranked {
+		if refreshedAt == nil || ranking.RefreshedAt.Before(*refreshedAt) {
+			refreshedAt = &ranking.RefreshedAt
+		}
+		items = append(items, TrendingPostItem{
+			Post:  newPostCard(&ranking.Post),
+			Score: ranking.Score,
+			Activity: TrendingActivity{
+				Likes:     ranking.LikesCount,
+				Comments:  ranking.CommentsCount,
+				Bookmarks: ranking.BookmarksCount,
+				Views:     ranking.ViewsCount,
+			},
+		})
+	}
+
+	return &ListTrendingPostsResp{
+		Window:      req.Window,
+		RefreshedAt: refreshedAt,
+		Page:        req.Page,
+		PerPage:     req.PerPage,
+		Total:       int(total),
+		Items:       items,
+	}, nil
+}
+
+func (s *ListTrendingPosts) ParseRequest(c *gin.Context) (*ListTrendingPostsReq, error) {
+	window := domain.RankingDay
+	if w := c.Query("window"); w != "" {
+		if _, err := domain.FindRankingWindow(w); err != nil {
+			return nil, err
+		}
+		window = w
+	}
+
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	return &ListTrendingPostsReq{
+		Window:   window,
+		ViewerID: c.GetString("user_id"),
+		Page:     page,
+		PerPage:  perPage,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type ListUsers struct {
+	userDAO dao.UserDAO
+}
+
+type ListUsersReq struct {
+	Query     string
+	Role      string
+	Suspended *bool
+	Page      int
+	PerPage   int
+}
+
+type AdminUserItem struct {
+	ID          string     `json:"id"`
+	Email       string     `json:"email"`
+	Username    string     `json:"username"`
+	Role        string     `json:"role"`
+	SuspendedAt *time.Time `json:"suspended_at"`
+}
+
+type ListUsersResp struct {
+	Page    int             `json:"page"`
+	PerPage int             `json:"per_page"`
+	Total   int             `json:"total"`
+	Items   []AdminUserItem `json:"items"`
+}
+
+func NewListUsers(userDAO dao.UserDAO) *ListUsers {
+	return &ListUsers{
+		userDAO: userDAO,
+	}
+}
+
+func (s *ListUsers) Exec(ctx context.Context, req *ListUsersReq) (*ListUsersResp, error) {
+	conditions := make([]string, 0)
+	args := make([]any, 0)
+	if req.Query != "" {
+		args = append(args, "%"+req.Query+"%")
+		conditions = append(conditions, fmt.Sprintf("(username ILIKE $%d OR email ILIKE $%d)", len(args), len(args)))
+	}
+	if req.Role != "" {
+		args = append(args, req.Role)
+		conditions = append(conditions, fmt.Sprintf("role = $%d", len(args)))
+	}
+	if req.Suspended != nil {
+		if *req.Suspended {
+			conditions = append(conditions, "suspended_at IS NOT NULL")
+		} else {
+			conditions = append(conditions, "suspended_at IS NULL")
+		}
+	}
+	where := strings.Join(conditions, " AND ")
+
+	total, err := s.userDAO.Count(ctx, where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	users, err := s.userDAO.FindPaginated(ctx, req.PerPage, offset, where, "username ASC, id ASC", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load users: %w", err)
+	}
+
+	items := make([]AdminUserItem, 0, len(users))
+	for _, user := range users {
+		items = append(items, newAdminUserItem(user))
+	}
+
+	return &ListUsersResp{
+		Page:    req.Page,
+		PerPage: req.PerPage,
+		Total:   int(total),
+		Items:   items,
+	}, nil
+}
+
+func (s *ListUsers) ParseRequest(c *gin.Context) (*ListUsersReq, error) {
+	role := c.Query("role")
+	if role != "" {
+		if _, err := domain.ParseRole(role); err != nil {
+			return nil, fmt.Errorf("role must be one of reader, author, editor, admin")
+		}
+	}
+
+	var suspended *bool
+	if sp := c.Query("suspended"); sp != "" {
+		parsed, err := strconv.ParseBool(sp)
+		if err != nil {
+			return nil, fmt.Errorf("suspended must be a boolean")
+		}
+		suspended = &parsed
+	}
+
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	perPage := 20
+	if pp := c.Query("per_page"); pp != "" {
+		if parsed, err := strconv.Atoi(pp); err == nil && parsed > 0 && parsed <= 100 {
+			perPage = parsed
+		}
+	}
+
+	return &ListUsersReq{
+		Query:     strings.TrimSpace(c.Query("q")),
+		Role:      role,
+		Suspended: suspended,
+		Page:      page,
+		PerPage:   perPage,
+	}, nil
+}
+
+func newAdminUserItem(user *domain.User) AdminUserItem {
+	return AdminUserItem{
+		ID:          user.ID,
+		Email:       user.Email,
+		Username:    user.Username,
+		Role:        user.Role,
+		SuspendedAt: user.SuspendedAt,
+	}
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain/dao"
+)
+
+type Logout struct {
+	sessionDAO dao.SessionDAO
+}
+
+type LogoutReq struct {
+	UserID    string
+	SessionID string
+}
+
+type LogoutResp struct {
+	Success bool `json:"success"`
+}
+
+func NewLogout(sessionDAO dao.SessionDAO) *Logout {
+	return &Logout{
+		sessionDAO: sessionDAO,
+	}
+}
+
+// Exec revokes the session of the access token the request was made with.
+func (s *Logout) Exec(ctx context.Context, req *LogoutReq) (*LogoutResp, error) {
+	session, err := s.sessionDAO.FindOne(ctx, "id = $1 AND user_id = $2", "", req.SessionID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("session not found: %w", err)
+	}
+
+	session.Revoke()
+	if err := s.sessionDAO.Update(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	return &LogoutResp{Success: true}, nil
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain/dao"
+)
+
+type MarkAllNotificationsRead struct {
+	notificationDAO dao.NotificationDAO
+}
+
+type MarkAllNotificationsReadReq struct {
+	UserID string `json:"-"`
+}
+
+type MarkAllNotificationsReadResp struct {
+	Marked      int `json:"marked"`
+	UnreadCount int `json:"unread_count"`
+}
+
+func NewMarkAllNotificationsRead(notificationDAO dao.NotificationDAO) *MarkAllNotificationsRead {
+	return &MarkAllNotificationsRead{
+		notificationDAO: notificationDAO,
+	}
+}
+
+func (s *MarkAllNotificationsRead) Exec(ctx context.Context, req *MarkAllNotificationsReadReq) (*MarkAllNotificationsReadResp, error) {
+	var marked int
+	err := s.notificationDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		unread, err := s.notificationDAO.FindAll(ctx, "recipient_id = $1 AND read_at IS NULL", "", req.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to load unread notifications: %w", err)
+		}
+
+		now := time.Now()
+		for _, notification := range unread {
+			notification.MarkRead(now)
+		}
+
+		if err := s.notificationDAO.UpdateMany(ctx, unread); err != nil {
+			return fmt.Errorf("failed to mark notifications as read: %w", err)
+		}
+
+		marked = len(unread)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MarkAllNotificationsReadResp{
+		Marked:      marked,
+		UnreadCount: 0,
+	}, nil
+}
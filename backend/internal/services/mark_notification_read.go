@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain/dao"
+)
+
+type MarkNotificationRead struct {
+	notificationDAO dao.NotificationDAO
+}
+
+type MarkNotificationReadReq struct {
+	NotificationID string `json:"-"`
+	UserID         string `json:"-"`
+}
+
+type MarkNotificationReadResp struct {
+	ID          string `json:"id"`
+	Read        bool   `json:"read"`
+	UnreadCount int    `json:"unread_count"`
+}
+
+func NewMarkNotificationRead(notificationDAO dao.NotificationDAO) *MarkNotificationRead {
+	return &MarkNotificationRead{
+		notificationDAO: notificationDAO,
+	}
+}
+
+func (s *MarkNotificationRead) Exec(ctx context.Context, req *MarkNotificationReadReq) (*MarkNotificationReadResp, error) {
+	notification, err := s.notificationDAO.FindOne(ctx, "id = $1 AND recipient_id = $2", "", req.NotificationID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("notification not found: %w", err)
+	}
+
+	if !notification.IsRead() {
+		notification.MarkRead(time.Now())
+		err = s.notificationDAO.PartialUpdate(ctx, notification.ID, map[string]interface{}{
+			"read_at": notification.ReadAt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to mark notification as read: %w", err)
+		}
+	}
+
+	unreadCount, err := s.notificationDAO.Count(ctx, "recipient_id = $1 AND read_at IS NULL", req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+
+	return &MarkNotificationReadResp{
+		ID:          notification.ID,
+		Read:        true,
+		UnreadCount: int(unreadCount),
+	}, nil
+}
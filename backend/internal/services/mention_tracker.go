@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+type MentionedUser struct {
+	ID       string `json:"id"`
+	Handle   string `json:"handle"`
+	Username string `json:"username"`
+}
+
+// MentionTracker keeps the mentions table in line with the bodies of posts
+// and comments, and notifies users the first time they are mentioned in one.
+type MentionTracker struct {
+	userDAO     dao.UserDAO
+	mentionDAO  dao.MentionDAO
+	relationDAO customdao.RelationDAO
+	blockDAO    dao.BlockDAO
+	notifier    *Notifier
+	nextID      domain.NextID
+}
+
+func NewMentionTracker(userDAO dao.UserDAO, mentionDAO dao.MentionDAO, relationDAO customdao.RelationDAO, blockDAO dao.BlockDAO, notifier *Notifier, nextID domain.NextID) *MentionTracker {
+	return &MentionTracker{
+		userDAO:     userDAO,
+		mentionDAO:  mentionDAO,
+		relationDAO: relationDAO,
+		blockDAO:    blockDAO,
+		notifier:    notifier,
+		nextID:      nextID,
+	}
+}
+
+// SyncPost records the mentions of a published post. Drafts mention nobody
+// until they are published.
+func (t *MentionTracker) SyncPost(ctx context.Context, post *domain.Post) error {
+	handles := make([]string, 0)
+	if post.PublishedAt != nil {
+		handles = domain.ParseMentions(post.RawMarkdown)
+	}
+
+	return t.sync(ctx, post.AuthorID, post.ID, nil, handles)
+}
+
+// SyncComment records the mentions of an approved comment.
+func (t *MentionTracker) SyncComment(ctx context.Context, comment *domain.Comment) error {
+	handles := make([]string, 0)
+	if comment.IsApproved() && !comment.IsDeleted() {
+		handles = domain.ParseMentions(comment.Body)
+	}
+
+	return t.sync(ctx, comment.AuthorID, comment.PostID, &comment.ID, handles)
+}
+
+func (t *MentionTracker) sync(ctx context.Context, authorID string, postID string, commentID *string, handles []string) error {
+	wanted := make(map[string]bool)
+	if len(handles) > 0 {
+		args := make([]any, 0, len(handles))
+		placeholders := make([]string, 0, len(handles))
+		for _, handle := range handles {
+			args = append(args, handle)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		}
+
+		// Only handles are unique, a username could name several users
+		users, err := t.userDAO.FindAll(ctx, "handle IN ("+strings.Join(placeholders, ",")+")", "", args...)
+		if err != nil {
+			return fmt.Errorf("failed to resolve mentions: %w", err)
+		}
+		for _, user := range users {
+			// Writing your own name is not a mention
+			if user.ID == authorID {
+				continue
+			}
+
+			// Nor is writing the name of someone who blocked you
+			blocked, err := isBlocked(ctx, t.blockDAO, user.ID, authorID)
+			if err != nil {
+				return err
+			}
+			if !blocked {
+				wanted[user.ID] = true
+			}
+		}
+	}
+
+	var existing []*dao.Mention
+	var err error
+	if commentID != nil {
+		existing, err = t.mentionDAO.FindAll(ctx, "comment_id = $1", "", *commentID)
+	} else {
+		existing, err = t.mentionDAO.FindAll(ctx, "post_id = $1 AND comment_id IS NULL", "", postID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load mentions: %w", err)
+	}
+
+	stale := make([]string, 0)
+	for _, mention := range existing {
+		if wanted[mention.MentionedUserID] {
+			delete(wanted, mention.MentionedUserID)
+		} else {
+			stale = append(stale, mention.ID)
+		}
+	}
+
+	if len(stale) > 0 {
+		if err := t.mentionDAO.DeleteManyByPks(ctx, stale); err != nil {
+			return fmt.Errorf("failed to delete mentions: %w", err)
+		}
+	}
+
+	for userID := range wanted {
+		mention, err := domain.NewMention(t.nextID(), userID, authorID, postID, commentID)
+		if err != nil {
+			return fmt.Errorf("failed to create mention: %w", err)
+		}
+
+		// A concurrent edit may have recorded it first, and notified already
+		inserted, err := t.relationDAO.InsertMention(ctx, mention)
+		if err != nil {
+			return fmt.Errorf("failed to save mention: %w", err)
+		}
+		if !inserted {
+			continue
+		}
+
+		err = t.notifier.Notify(ctx, &NotifyReq{
+			RecipientID: userID,
+			ActorID:     authorID,
+			Type:        domain.NotificationTypeMention,
+			PostID:      &postID,
+			CommentID:   commentID,
+		})
+		if err != nil {
+			log.Printf("failed to notify mention of %s: %v", userID, err)
+		}
+	}
+
+	return nil
+}
+
+// ForPost returns the users mentioned in the body of a post.
+func (t *MentionTracker) ForPost(ctx context.Context, postID string) ([]MentionedUser, error) {
+	mentions, err := t.mentionDAO.FindAll(ctx, "post_id = $1 AND comment_id IS NULL", "created_at ASC", postID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load mentions: %w", err)
+	}
+
+	users, err := t.mentionedUsers(ctx, mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	if users[""] == nil {
+		return make([]MentionedUser, 0), nil
+	}
+
+	return users[""], nil
+}
+
+// ForComments returns the users mentioned in every comment, keyed by comment ID.
+func (t *MentionTracker) ForComments(ctx context.Context, commentIDs []string) (map[string][]MentionedUser, error) {
+	if len(commentIDs) == 0 {
+		return make(map[string][]MentionedUser), nil
+	}
+
+	args := make([]any, 0, len(commentIDs))
+	placeholders := make([]string, 0, len(commentIDs))
+	for _, id := range commentIDs {
+		args = append(args, id)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+	}
+
+	mentions, err := t.mentionDAO.FindAll(ctx, "comment_id IN ("+strings.Join(placeholders, ",")+")", "created_at ASC", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load mentions: %w", err)
+	}
+
+	return t.mentionedUsers(ctx, mentions)
+}
+
+// mentionedUsers groups the mentioned users by comment ID, using an empty key
+// for mentions in post bodies.
+func (t *MentionTracker) mentionedUsers(ctx context.Context, mentions []*dao.Mention) (map[string][]MentionedUser, error) {
+	result := make(map[string][]MentionedUser)
+	if len(mentions) == 0 {
+		return result, nil
+	}
+
+	args := make([]any, 0)
+	placeholders := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, mention := range mentions {
+		if !seen[mention.MentionedUserID] {
+			seen[mention.MentionedUserID] = true
+			args = append(args, mention.MentionedUserID)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		}
+	}
+
+	users, err := t.userDAO.FindAll(ctx, "id IN ("+strings.Join(placeholders, ",")+")", "", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load mentioned users: %w", err)
+	}
+
+	usersMap := make(map[string]*dao.User)
+	for _, user := range users {
+		usersMap[user.ID] = user
+	}
+
+	for _, mention := range mentions {
+		user, ok := usersMap[mention.MentionedUserID]
+		if !ok {
+			continue
+		}
+
+		key := ""
+		if mention.CommentID != nil {
+			key = *mention.CommentID
+		}
+		result[key] = append(result[key], MentionedUser{ID: user.ID, Handle: user.Handle, Username: user.Username})
+	}
+
+	return result, nil
+}
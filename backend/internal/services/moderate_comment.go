@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+const (
+	ModerationActionApprove = "approve"
+	ModerationActionReject  = "reject"
+)
+
+type ModerateComment struct {
+	postDAO    dao.PostDAO
+	userDAO    dao.UserDAO
+	commentDAO dao.CommentDAO
+	mentions   *MentionTracker
+	notifier   *Notifier
+	realtime   domain.RealtimeHub
+}
+
+type ModerateCommentReq struct {
+	CommentID string `json:"-"`
+	UserID    string `json:"-"`
+	Action    string `json:"-"`
+	Reason    string `json:"reason"`
+}
+
+type ModerateCommentResp struct {
+	ID               string  `json:"id"`
+	Status           string  `json:"status"`
+	ModerationReason *string `json:"moderation_reason"`
+}
+
+func NewModerateComment(postDAO dao.PostDAO, userDAO dao.UserDAO, commentDAO dao.CommentDAO, mentions *MentionTracker, notifier *Notifier, realtime domain.RealtimeHub) *ModerateComment {
+	return &ModerateComment{
+		postDAO:    postDAO,
+		userDAO:    userDAO,
+		commentDAO: commentDAO,
+		mentions:   mentions,
+		notifier:   notifier,
+		realtime:   realtime,
+	}
+}
+
+func (s *ModerateComment) Exec(ctx context.Context, req *ModerateCommentReq) (*ModerateCommentResp, error) {
+	comment, err := s.commentDAO.FindByPk(ctx, req.CommentID)
+	if err != nil {
+		return nil, fmt.Errorf("comment not found: %w", err)
+	}
+
+	if comment.IsDeleted() {
+		return nil, fmt.Errorf("comment not found: comment was deleted")
+	}
+
+	// Admins have the last word on reported comments
+	if comment.IsHidden() {
+		return nil, fmt.Errorf("comment not found: comment was hidden by an admin")
+	}
+
+	post, err := s.postDAO.FindByPk(ctx, comment.PostID)
+	if err != nil {
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+
+	if post.AuthorID != req.UserID {
+		return nil, fmt.Errorf("unauthorized: you can only moderate comments on your posts")
+	}
+
+	switch req.Action {
+	case ModerationActionApprove:
+		err = s.approve(ctx, post, comment)
+	case ModerationActionReject:
+		err = s.reject(ctx, post, comment, req.Reason)
+	default:
+		err = fmt.Errorf("unknown moderation action %s", req.Action)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ModerateCommentResp{
+		ID:               comment.ID,
+		Status:           comment.Status,
+		ModerationReason: comment.ModerationReason,
+	}, nil
+}
+
+func (s *ModerateComment) approve(ctx context.Context, post *domain.Post, comment *domain.Comment) error {
+	if comment.IsApproved() {
+		return nil
+	}
+
+	if comment.ParentID != nil {
+		parent, err := s.commentDAO.FindByPk(ctx, *comment.ParentID)
+		if err != nil {
+			return fmt.Errorf("parent comment not found: %w", err)
+		}
+		if !parent.IsApproved() {
+			return fmt.Errorf("parent comment must be approved first")
+		}
+	}
+
+	author, err := s.userDAO.FindByPk(ctx, comment.AuthorID)
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	comment.Approve()
+	if err := s.commentDAO.Update(ctx, comment); err != nil {
+		return fmt.Errorf("failed to save comment: %w", err)
+	}
+
+	publishComment(ctx, s.commentDAO, s.mentions, s.notifier, s.realtime, post, comment, author)
+	return nil
+}
+
+func (s *ModerateComment) reject(ctx context.Context, post *domain.Post, comment *domain.Comment, reason string) error {
+	if reason == "" {
+		reason = "rejected by the post author"
+	}
+
+	wasApproved := comment.IsApproved()
+	comment.Reject(reason)
+	if err := s.commentDAO.Update(ctx, comment); err != nil {
+		return fmt.Errorf("failed to save comment: %w", err)
+	}
+
+	if !wasApproved {
+		return nil
+	}
+
+	// The comment was live, take it out of its thread. It is already saved
+	// as rejected, so failures are only logged
+	if err := s.mentions.SyncComment(ctx, comment); err != nil {
+		log.Printf("failed to sync mentions of comment %s: %v", comment.ID, err)
+	}
+
+	if comment.ParentID != nil {
+		if _, err := refreshReplyCount(ctx, s.commentDAO, *comment.ParentID); err != nil {
+			log.Printf("failed to refresh reply count of comment %s: %v", *comment.ParentID, err)
+		}
+	}
+
+	err := s.realtime.Publish(domain.RealtimeEvent{
+		Topic: domain.PostTopic(post.ID),
+		Type:  domain.RealtimeCommentDeleted,
+		Data: map[string]any{
+			"id":         comment.ID,
+			"post_slug":  post.Slug,
+			"tombstoned": false,
+		},
+	})
+	if err != nil {
+		log.Printf("failed to publish comment %s: %v", comment.ID, err)
+	}
+
+	return nil
+}
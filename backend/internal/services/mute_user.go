@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+type MuteUser struct {
+	userDAO     dao.UserDAO
+	relationDAO customdao.RelationDAO
+	nextID      domain.NextID
+}
+
+type MuteUserReq struct {
+	MutedID string `json:"-"`
+	UserID  string `json:"-"`
+}
+
+type MuteUserResp struct {
+	Muted bool `json:"muted"`
+}
+
+func NewMuteUser(userDAO dao.UserDAO, relationDAO customdao.RelationDAO, nextID domain.NextID) *MuteUser {
+	return &MuteUser{
+		userDAO:     userDAO,
+		relationDAO: relationDAO,
+		nextID:      nextID,
+	}
+}
+
+func (s *MuteUser) Exec(ctx context.Context, req *MuteUserReq) (*MuteUserResp, error) {
+	_, err := s.userDAO.FindByPk(ctx, req.MutedID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	mute, err := domain.NewMute(s.nextID(), req.UserID, req.MutedID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mute: %w", err)
+	}
+
+	// Muting twice is a no op
+	_, err = s.relationDAO.InsertMute(ctx, mute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save mute: %w", err)
+	}
+
+	return &MuteUserResp{Muted: true}, nil
+}
+
+// hiddenAuthorsFilter returns a where condition keeping out the rows whose
+// column holds a user the viewer muted or blocked. The viewer ID is read from
+// the given placeholder, e.g. "$1".
+func hiddenAuthorsFilter(column string, viewerPlaceholder string) string {
+	return column + " NOT IN (" +
+		"SELECT muted_id FROM user_mutes WHERE muter_id = " + viewerPlaceholder +
+		" UNION SELECT blocked_id FROM user_blocks WHERE blocker_id = " + viewerPlaceholder +
+		")"
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+// Notifier records in-app notifications for the services that produce them
+// (comments, replies, likes, follows, mentions and report outcomes). Unread
+// notifications of the same group are merged instead of creating a new row
+// per event.
+//
+// Notifications come after what they are about is saved: callers log their
+// failures instead of failing a write that already happened.
+type Notifier struct {
+	notificationDAO dao.NotificationDAO
+	groupDAO        customdao.NotificationGroupDAO
+	realtime        domain.RealtimeHub
+	nextID          domain.NextID
+}
+
+type NotifyReq struct {
+	RecipientID string
+	ActorID     string
+	Type        string
+	PostID      *string
+	CommentID   *string
+	ReportID    *string
+}
+
+func NewNotifier(notificationDAO dao.NotificationDAO, groupDAO customdao.NotificationGroupDAO, realtime domain.RealtimeHub, nextID domain.NextID) *Notifier {
+	return &Notifier{
+		notificationDAO: notificationDAO,
+		groupDAO:        groupDAO,
+		realtime:        realtime,
+		nextID:          nextID,
+	}
+}
+
+func (s *Notifier) Notify(ctx context.Context, req *NotifyReq) error {
+	// Nobody needs to be told about their own activity
+	if req.RecipientID == req.ActorID {
+		return nil
+	}
+
+	notification, err := domain.NewNotification(s.nextID(), req.RecipientID, req.ActorID, req.Type, req.PostID, req.CommentID, req.ReportID)
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	// Merges into the unread notification of the group when there is one
+	notification, err = s.groupDAO.MergeUnread(ctx, notification)
+	if err != nil {
+		return fmt.Errorf("failed to save notification: %w", err)
+	}
+
+	s.publish(ctx, notification)
+	return nil
+}
+
+// publish pushes the notification to the recipient's live stream. Failures are
+// only logged: the notification is stored and will show up on the next fetch.
+func (s *Notifier) publish(ctx context.Context, notification *domain.Notification) {
+	unreadCount, err := s.notificationDAO.Count(ctx, "recipient_id = $1 AND read_at IS NULL", notification.RecipientID)
+	if err != nil {
+		log.Printf("failed to count unread notifications of %s: %v", notification.RecipientID, err)
+		return
+	}
+
+	err = s.realtime.Publish(domain.RealtimeEvent{
+		Topic: domain.UserTopic(notification.RecipientID),
+		Type:  domain.RealtimeNotificationCreated,
+		Data: map[string]any{
+			"id":           notification.ID,
+			"type":         notification.Type,
+			"post_id":      notification.PostID,
+			"comment_id":   notification.CommentID,
+			"report_id":    notification.ReportID,
+			"unread_count": unreadCount,
+		},
+	})
+	if err != nil {
+		log.Printf("failed to publish notification %s: %v", notification.ID, err)
+	}
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/oauth2"
+
+	"blog0/internal/domain"
+)
+
+// OAuthProvider is a sign in provider: the OAuth2 client to talk to it and
+// the extractor that reads the user behind an access token.
+type OAuthProvider struct {
+	Name          string
+	Config        *oauth2.Config
+	InfoExtractor domain.InfoExtractor
+}
+
+// OAuthProviders is the registry of the configured sign in providers, keyed
+// by the name used in /auth/:provider.
+type OAuthProviders struct {
+	providers map[string]*OAuthProvider
+}
+
+func NewOAuthProviders(providers ...*OAuthProvider) *OAuthProviders {
+	registry := &OAuthProviders{providers: make(map[string]*OAuthProvider)}
+	for _, provider := range providers {
+		registry.providers[provider.Name] = provider
+	}
+	return registry
+}
+
+func (r *OAuthProviders) Get(name string) (*OAuthProvider, error) {
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("provider not found: %s", name)
+	}
+	return provider, nil
+}
+
+// Names returns the names of the configured providers, sorted.
+func (r *OAuthProviders) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// oauthStateTTL is how long users have to sign in at the provider.
+const oauthStateTTL = 10 * time.Minute
+
+// oauthLinkTTL is how long a link URL from StartLinkIdentity can be opened.
+const oauthLinkTTL = 5 * time.Minute
+
+// oauthStateType and oauthLinkType tell state and link tokens apart from
+// access tokens, which are signed with the same secret.
+const (
+	oauthStateType = "oauth_state"
+	oauthLinkType  = "oauth_link"
+)
+
+// OAuthState is what StartOAuth remembers for the callback, in a cookie on
+// the browser: the state sent to the provider, which forged callbacks can't
+// echo, and the PKCE verifier of the code challenge. LinkUserID is set when a
+// signed in user links the provider rather than signing in with it.
+type OAuthState struct {
+	Provider   string
+	State      string
+	Verifier   string
+	LinkUserID string
+}
+
+func signOAuthState(jwtSecret []byte, state *OAuthState, expiresAt time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"typ":      oauthStateType,
+		"provider": state.Provider,
+		"state":    state.State,
+		"verifier": state.Verifier,
+		"link":     state.LinkUserID,
+		"exp":      expiresAt.Unix(),
+	})
+
+	signed, err := token.SignedString(jwtSecret)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign state: %w", err)
+	}
+	return signed, nil
+}
+
+// signOAuthLink signs the token that lets StartOAuth link a provider to the
+// user who asked for it, since the redirect to StartOAuth carries no bearer
+// token.
+func signOAuthLink(jwtSecret []byte, userID string, provider string, expiresAt time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"typ":      oauthLinkType,
+		"user_id":  userID,
+		"provider": provider,
+		"exp":      expiresAt.Unix(),
+	})
+
+	signed, err := token.SignedString(jwtSecret)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign link token: %w", err)
+	}
+	return signed, nil
+}
+
+// verifyOAuthLink returns the user a link token was signed for.
+func verifyOAuthLink(jwtSecret []byte, signed string, provider string) (string, error) {
+	claims, ok := parseSigned(jwtSecret, signed)
+	if !ok || claims["typ"] != oauthLinkType || claims["provider"] != provider {
+		return "", fmt.Errorf("invalid link token")
+	}
+
+	userID, _ := claims["user_id"].(string)
+	if userID == "" {
+		return "", fmt.Errorf("invalid link token")
+	}
+	return userID, nil
+}
+
+// verifyOAuthState checks the signed state cookie against the provider and
+// state of the callback, and returns what StartOAuth stored.
+func verifyOAuthState(jwtSecret []byte, signed string, provider string, state string) (*OAuthState, error) {
+	claims, ok := parseSigned(jwtSecret, signed)
+	if !ok {
+		return nil, fmt.Errorf("invalid state")
+	}
+
+	stored := &OAuthState{}
+	stored.Provider, _ = claims["provider"].(string)
+	stored.State, _ = claims["state"].(string)
+	stored.Verifier, _ = claims["verifier"].(string)
+	stored.LinkUserID, _ = claims["link"].(string)
+
+	if claims["typ"] != oauthStateType || stored.Provider != provider || stored.State == "" ||
+		subtle.ConstantTimeCompare([]byte(stored.State), []byte(state)) != 1 {
+		return nil, fmt.Errorf("invalid state")
+	}
+
+	return stored, nil
+}
+
+// parseSigned returns the claims of a valid, unexpired HMAC signed token.
+func parseSigned(jwtSecret []byte, signed string) (jwt.MapClaims, bool) {
+	tk, err := jwt.Parse(signed, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method")
+		}
+		return jwtSecret, nil
+	})
+	if err != nil || !tk.Valid {
+		return nil, false
+	}
+
+	return tk.Claims.(jwt.MapClaims), true
+}
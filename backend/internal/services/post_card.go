@@ -0,0 +1,61 @@
+package services
+
+import (
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+)
+
+// UserCard is how users are shown in lists.
+type UserCard struct {
+	ID        string `json:"id"`
+	Handle    string `json:"handle"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+// PostCard is how posts are shown in the lists of what users bookmarked or
+// liked, and in the rankings.
+type PostCard struct {
+	ID              string    `json:"id"`
+	Title           string    `json:"title"`
+	Slug            string    `json:"slug"`
+	Summary         string    `json:"summary"`
+	Tags            []string  `json:"tags"`
+	PublishedAt     time.Time `json:"published_at"`
+	SummaryAudioURL *string   `json:"summary_audio_url"`
+	Author          UserCard  `json:"author"`
+	LikesCount      int       `json:"likes_count"`
+	CommentsCount   int       `json:"comments_count"`
+}
+
+func newUserCard(user *domain.User) UserCard {
+	return UserCard{
+		ID:        user.ID,
+		Handle:    user.Handle,
+		Name:      user.Name(),
+		AvatarURL: user.AvatarURL,
+	}
+}
+
+func newPostCard(card *customdao.PostCard) PostCard {
+	post := domain.Post{Tags: card.Tags}
+	return PostCard{
+		ID:              card.ID,
+		Title:           card.Title,
+		Slug:            card.Slug,
+		Summary:         card.Summary,
+		Tags:            post.ItsTags(),
+		PublishedAt:     card.PublishedAt,
+		SummaryAudioURL: card.SummaryAudioURL,
+		Author: UserCard{
+			ID:        card.AuthorID,
+			Handle:    card.AuthorHandle,
+			Name:      card.AuthorName,
+			AvatarURL: card.AuthorAvatarURL,
+		},
+		LikesCount:    card.LikesCount,
+		CommentsCount: card.CommentsCount,
+	}
+}
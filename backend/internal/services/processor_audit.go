@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+// ProcessorAudit records what processor clients do, refused attempts
+// included, and checks they may do it.
+type ProcessorAudit struct {
+	postDAO  dao.PostDAO
+	auditDAO dao.ProcessorAuditLogDAO
+	nextID   domain.NextID
+}
+
+func NewProcessorAudit(postDAO dao.PostDAO, auditDAO dao.ProcessorAuditLogDAO, nextID domain.NextID) *ProcessorAudit {
+	return &ProcessorAudit{
+		postDAO:  postDAO,
+		auditDAO: auditDAO,
+		nextID:   nextID,
+	}
+}
+
+// Record saves an audit entry. The operation already happened, so failing to
+// record it is only logged.
+func (a *ProcessorAudit) Record(ctx context.Context, client *domain.ProcessorClient, op string, authorID string, postID string, opErr error) {
+	entry, err := domain.NewProcessorAuditLog(a.nextID(), client.ID, op, authorID, postID, opErr)
+	if err == nil {
+		err = a.auditDAO.Create(ctx, entry)
+	}
+	if err != nil {
+		log.Printf("failed to record %s by processor client %s: %v", op, client.ID, err)
+	}
+}
+
+// authorizePost loads the post an operation targets and checks the client
+// may perform the operation on the posts of its author.
+func (a *ProcessorAudit) authorizePost(ctx context.Context, client *domain.ProcessorClient, op string, slug string) (*domain.Post, error) {
+	if !client.CanPerform(op) {
+		return nil, fmt.Errorf("unauthorized: client may not perform %s", op)
+	}
+
+	post, err := a.postDAO.FindOne(ctx, "slug = $1", "", slug)
+	if err != nil {
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+
+	if !client.CanActAs(post.AuthorID) {
+		return nil, fmt.Errorf("unauthorized: client may not act as author %s", post.AuthorID)
+	}
+
+	return post, nil
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+)
+
+type ProcessorCreatePost struct {
+	createPost *CreatePost
+	audit      *ProcessorAudit
+}
+
+type ProcessorCreatePostReq struct {
+	Client      *domain.ProcessorClient `json:"-"`
+	AuthorID    string                  `json:"author_id"`
+	Title       string                  `json:"title"`
+	Slug        string                  `json:"slug"`
+	RawMarkdown string                  `json:"raw_markdown"`
+	Publish     bool                    `json:"publish"`
+}
+
+func NewProcessorCreatePost(createPost *CreatePost, audit *ProcessorAudit) *ProcessorCreatePost {
+	return &ProcessorCreatePost{
+		createPost: createPost,
+		audit:      audit,
+	}
+}
+
+// Exec creates a post as one of the authors of the client. Publishing right
+// away also takes the publish operation.
+func (s *ProcessorCreatePost) Exec(ctx context.Context, req *ProcessorCreatePostReq) (*CreatePostResp, error) {
+	authorID, err := s.authorize(req)
+	if err != nil {
+		s.audit.Record(ctx, req.Client, domain.ProcessorOpCreatePost, req.AuthorID, "", err)
+		return nil, err
+	}
+
+	resp, err := s.createPost.Exec(ctx, &CreatePostReq{
+		Title:       req.Title,
+		Slug:        req.Slug,
+		RawMarkdown: req.RawMarkdown,
+		UserID:      authorID,
+		Publish:     req.Publish,
+	})
+
+	postID := ""
+	if resp != nil {
+		postID = resp.ID
+	}
+	s.audit.Record(ctx, req.Client, domain.ProcessorOpCreatePost, authorID, postID, err)
+
+	return resp, err
+}
+
+func (s *ProcessorCreatePost) authorize(req *ProcessorCreatePostReq) (string, error) {
+	if !req.Client.CanPerform(domain.ProcessorOpCreatePost) {
+		return "", fmt.Errorf("unauthorized: client may not perform %s", domain.ProcessorOpCreatePost)
+	}
+
+	if req.Publish && !req.Client.CanPerform(domain.ProcessorOpPublishPost) {
+		return "", fmt.Errorf("unauthorized: client may not perform %s", domain.ProcessorOpPublishPost)
+	}
+
+	authorID, err := req.Client.ActingAuthor(req.AuthorID)
+	if err != nil {
+		return "", fmt.Errorf("unauthorized: %w", err)
+	}
+
+	return authorID, nil
+}
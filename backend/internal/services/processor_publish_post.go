@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+
+	"blog0/internal/domain"
+)
+
+type ProcessorPublishPost struct {
+	updatePost *UpdatePost
+	audit      *ProcessorAudit
+}
+
+type ProcessorPublishPostReq struct {
+	Client  *domain.ProcessorClient
+	Slug    string
+	Publish bool
+}
+
+func NewProcessorPublishPost(updatePost *UpdatePost, audit *ProcessorAudit) *ProcessorPublishPost {
+	return &ProcessorPublishPost{
+		updatePost: updatePost,
+		audit:      audit,
+	}
+}
+
+// Exec publishes, or unpublishes, a post of one of the authors of the
+// client.
+func (s *ProcessorPublishPost) Exec(ctx context.Context, req *ProcessorPublishPostReq) (*UpdatePostResp, error) {
+	post, err := s.audit.authorizePost(ctx, req.Client, domain.ProcessorOpPublishPost, req.Slug)
+	if err != nil {
+		s.audit.Record(ctx, req.Client, domain.ProcessorOpPublishPost, "", "", err)
+		return nil, err
+	}
+
+	publish := req.Publish
+	resp, err := s.updatePost.Exec(ctx, &UpdatePostReq{
+		Slug:    req.Slug,
+		UserID:  post.AuthorID,
+		Publish: &publish,
+	})
+	s.audit.Record(ctx, req.Client, domain.ProcessorOpPublishPost, post.AuthorID, post.ID, err)
+
+	return resp, err
+}
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+)
+
+type ProcessorPurgeAccounts struct {
+	purge *PurgeDeletedAccounts
+	audit *ProcessorAudit
+}
+
+type ProcessorPurgeAccountsReq struct {
+	Client *domain.ProcessorClient
+}
+
+func NewProcessorPurgeAccounts(purge *PurgeDeletedAccounts, audit *ProcessorAudit) *ProcessorPurgeAccounts {
+	return &ProcessorPurgeAccounts{
+		purge: purge,
+		audit: audit,
+	}
+}
+
+// Exec is the scheduled job purging the accounts whose deletion grace
+// period is over.
+func (s *ProcessorPurgeAccounts) Exec(ctx context.Context, req *ProcessorPurgeAccountsReq) (*PurgeDeletedAccountsResp, error) {
+	if !req.Client.CanPerform(domain.ProcessorOpRunJobs) {
+		err := fmt.Errorf("unauthorized: client may not perform %s", domain.ProcessorOpRunJobs)
+		s.audit.Record(ctx, req.Client, domain.ProcessorOpRunJobs, "", "", err)
+		return nil, err
+	}
+
+	resp, err := s.purge.Exec(ctx)
+	s.audit.Record(ctx, req.Client, domain.ProcessorOpRunJobs, "", "", err)
+
+	return resp, err
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+)
+
+type ProcessorRefreshRankings struct {
+	refresh *RefreshRankings
+	audit   *ProcessorAudit
+}
+
+type ProcessorRefreshRankingsReq struct {
+	Client *domain.ProcessorClient
+}
+
+func NewProcessorRefreshRankings(refresh *RefreshRankings, audit *ProcessorAudit) *ProcessorRefreshRankings {
+	return &ProcessorRefreshRankings{
+		refresh: refresh,
+		audit:   audit,
+	}
+}
+
+// Exec is the scheduled job refreshing the trending posts rankings.
+func (s *ProcessorRefreshRankings) Exec(ctx context.Context, req *ProcessorRefreshRankingsReq) (*RefreshRankingsResp, error) {
+	if !req.Client.CanPerform(domain.ProcessorOpRunJobs) {
+		err := fmt.Errorf("unauthorized: client may not perform %s", domain.ProcessorOpRunJobs)
+		s.audit.Record(ctx, req.Client, domain.ProcessorOpRunJobs, "", "", err)
+		return nil, err
+	}
+
+	resp, err := s.refresh.Exec(ctx)
+	s.audit.Record(ctx, req.Client, domain.ProcessorOpRunJobs, "", "", err)
+
+	return resp, err
+}
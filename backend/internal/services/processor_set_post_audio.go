@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type ProcessorSetPostAudio struct {
+	postDAO dao.PostDAO
+	audit   *ProcessorAudit
+}
+
+type ProcessorSetPostAudioReq struct {
+	Client              *domain.ProcessorClient `json:"-"`
+	Slug                string                  `json:"-"`
+	RawMarkdownAudioURL *string                 `json:"raw_markdown_audio_url"`
+	SummaryAudioURL     *string                 `json:"summary_audio_url"`
+}
+
+type ProcessorSetPostAudioResp struct {
+	ID                  string  `json:"id"`
+	Slug                string  `json:"slug"`
+	RawMarkdownAudioURL *string `json:"raw_markdown_audio_url"`
+	SummaryAudioURL     *string `json:"summary_audio_url"`
+}
+
+func NewProcessorSetPostAudio(postDAO dao.PostDAO, audit *ProcessorAudit) *ProcessorSetPostAudio {
+	return &ProcessorSetPostAudio{
+		postDAO: postDAO,
+		audit:   audit,
+	}
+}
+
+// Exec is the callback of the audio generation: it stores the URLs of the
+// narrated post and summary. URLs left out are kept.
+func (s *ProcessorSetPostAudio) Exec(ctx context.Context, req *ProcessorSetPostAudioReq) (*ProcessorSetPostAudioResp, error) {
+	post, err := s.audit.authorizePost(ctx, req.Client, domain.ProcessorOpPostAudio, req.Slug)
+	if err != nil {
+		s.audit.Record(ctx, req.Client, domain.ProcessorOpPostAudio, "", "", err)
+		return nil, err
+	}
+
+	err = s.setAudio(ctx, post, req)
+	s.audit.Record(ctx, req.Client, domain.ProcessorOpPostAudio, post.AuthorID, post.ID, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProcessorSetPostAudioResp{
+		ID:                  post.ID,
+		Slug:                post.Slug,
+		RawMarkdownAudioURL: post.RawMarkdownAudioURL,
+		SummaryAudioURL:     post.SummaryAudioURL,
+	}, nil
+}
+
+func (s *ProcessorSetPostAudio) setAudio(ctx context.Context, post *domain.Post, req *ProcessorSetPostAudioReq) error {
+	fields := make(map[string]interface{})
+	for column, audioURL := range map[string]*string{
+		"raw_markdown_audio_url": req.RawMarkdownAudioURL,
+		"summary_audio_url":      req.SummaryAudioURL,
+	} {
+		if audioURL == nil {
+			continue
+		}
+		if parsed, err := url.Parse(*audioURL); err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+			return fmt.Errorf("failed to set audio: %s is not an http(s) URL", column)
+		}
+		fields[column] = *audioURL
+	}
+
+	if len(fields) == 0 {
+		return fmt.Errorf("failed to set audio: no audio URL given")
+	}
+
+	if err := s.postDAO.PartialUpdate(ctx, post.ID, fields); err != nil {
+		return fmt.Errorf("failed to save post: %w", err)
+	}
+
+	if req.RawMarkdownAudioURL != nil {
+		post.RawMarkdownAudioURL = req.RawMarkdownAudioURL
+	}
+	if req.SummaryAudioURL != nil {
+		post.SummaryAudioURL = req.SummaryAudioURL
+	}
+
+	return nil
+}
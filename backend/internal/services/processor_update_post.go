@@ -0,0 +1,48 @@
+package services
+
+import (
+	"context"
+
+	"blog0/internal/domain"
+)
+
+type ProcessorUpdatePost struct {
+	updatePost *UpdatePost
+	audit      *ProcessorAudit
+}
+
+type ProcessorUpdatePostReq struct {
+	Client      *domain.ProcessorClient `json:"-"`
+	Slug        string                  `json:"-"`
+	Title       string                  `json:"title"`
+	NewSlug     string                  `json:"slug"`
+	RawMarkdown string                  `json:"raw_markdown"`
+}
+
+func NewProcessorUpdatePost(updatePost *UpdatePost, audit *ProcessorAudit) *ProcessorUpdatePost {
+	return &ProcessorUpdatePost{
+		updatePost: updatePost,
+		audit:      audit,
+	}
+}
+
+// Exec rewrites a post of one of the authors of the client. Publishing is a
+// separate operation, see ProcessorPublishPost.
+func (s *ProcessorUpdatePost) Exec(ctx context.Context, req *ProcessorUpdatePostReq) (*UpdatePostResp, error) {
+	post, err := s.audit.authorizePost(ctx, req.Client, domain.ProcessorOpUpdatePost, req.Slug)
+	if err != nil {
+		s.audit.Record(ctx, req.Client, domain.ProcessorOpUpdatePost, "", "", err)
+		return nil, err
+	}
+
+	resp, err := s.updatePost.Exec(ctx, &UpdatePostReq{
+		Slug:        req.Slug,
+		Title:       req.Title,
+		NewSlug:     req.NewSlug,
+		RawMarkdown: req.RawMarkdown,
+		UserID:      post.AuthorID,
+	})
+	s.audit.Record(ctx, req.Client, domain.ProcessorOpUpdatePost, post.AuthorID, post.ID, err)
+
+	return resp, err
+}
@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+// MaxPurgedAccounts bounds how many accounts one run purges.
+const MaxPurgedAccounts = 50
+
+type PurgeDeletedAccounts struct {
+	data   AccountData
+	policy domain.DeletionPolicy
+}
+
+type purgeStep struct {
+	what string
+	run  func() error
+}
+
+type PurgeDeletedAccountsResp struct {
+	Purged int `json:"purged"`
+	Failed int `json:"failed"`
+}
+
+func NewPurgeDeletedAccounts(data AccountData, policy domain.DeletionPolicy) *PurgeDeletedAccounts {
+	return &PurgeDeletedAccounts{
+		data:   data,
+		policy: policy,
+	}
+}
+
+// Exec purges the accounts whose grace period is over. Each account is
+// purged in its own transaction, a failure leaves it for the next run.
+func (s *PurgeDeletedAccounts) Exec(ctx context.Context) (*PurgeDeletedAccountsResp, error) {
+	users, err := s.data.Users.FindPaginated(ctx, MaxPurgedAccounts, 0, "deletion_scheduled_at <= NOW() AND deleted_at IS NULL", "deletion_scheduled_at ASC")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load accounts to purge: %w", err)
+	}
+
+	resp := &PurgeDeletedAccountsResp{}
+	for _, user := range users {
+		err := s.data.Users.WithTransaction(ctx, func(ctx context.Context) error {
+			return s.purge(ctx, user)
+		})
+		if err != nil {
+			log.Printf("failed to purge account %s: %v", user.ID, err)
+			resp.Failed++
+			continue
+		}
+		resp.Purged++
+	}
+
+	return resp, nil
+}
+
+func (s *PurgeDeletedAccounts) purge(ctx context.Context, user *domain.User) error {
+	d := s.data
+
+	// Whatever signs the user in, or is only about them, goes in any case
+	steps := []purgeStep{
+		{"sessions", func() error {
+			return deleteWhere(ctx, d.Sessions.FindAll, d.Sessions.DeleteManyByPks, func(m *dao.Session) string { return m.ID }, "user_id = $1", user.ID)
+		}},
+		{"oauth codes", func() error {
+			return deleteWhere(ctx, d.OAuthCodes.FindAll, d.OAuthCodes.DeleteManyByPks, func(m *dao.OAuthCode) string { return m.ID }, "user_id = $1", user.ID)
+		}},
+		{"identities", func() error {
+			return deleteWhere(ctx, d.Identities.FindAll, d.Identities.DeleteManyByPks, func(m *dao.Identity) string { return m.ID }, "user_id = $1", user.ID)
+		}},
+		{"tokens", func() error {
+			return deleteWhere(ctx, d.PersonalAccessTokens.FindAll, d.PersonalAccessTokens.DeleteManyByPks, func(m *dao.PersonalAccessToken) string { return m.ID }, "user_id = $1", user.ID)
+		}},
+		{"avatar", func() error {
+			return deleteWhere(ctx, d.Avatars.FindAll, d.Avatars.DeleteManyByPks, func(m *dao.Avatar) string { return m.UserID }, "user_id = $1", user.ID)
+		}},
+		{"handle aliases", func() error {
+			return deleteWhere(ctx, d.HandleAliases.FindAll, d.HandleAliases.DeleteManyByPks, func(m *dao.HandleAlias) string { return m.Handle }, "user_id = $1", user.ID)
+		}},
+		{"notifications", func() error {
+			return deleteWhere(ctx, d.Notifications.FindAll, d.Notifications.DeleteManyByPks, func(m *dao.Notification) string { return m.ID }, "recipient_id = $1", user.ID)
+		}},
+		{"mentions", func() error {
+			return deleteWhere(ctx, d.Mentions.FindAll, d.Mentions.DeleteManyByPks, func(m *dao.Mention) string { return m.ID }, "mentioned_user_id = $1", user.ID)
+		}},
+		{"blocks", func() error {
+			return deleteWhere(ctx, d.Blocks.FindAll, d.Blocks.DeleteManyByPks, func(m *dao.Block) string { return m.ID }, "blocker_id = $1 OR blocked_id = $1", user.ID)
+		}},
+		{"mutes", func() error {
+			return deleteWhere(ctx, d.Mutes.FindAll, d.Mutes.DeleteManyByPks, func(m *dao.Mute) string { return m.ID }, "muter_id = $1 OR muted_id = $1", user.ID)
+		}},
+		{"bookmark collections", func() error {
+			return deleteWhere(ctx, d.BookmarkCollections.FindAll, d.BookmarkCollections.DeleteManyByPks, func(m *dao.BookmarkCollection) string { return m.ID }, "user_id = $1", user.ID)
+		}},
+		// Nobody keeps following an account that is gone
+		{"followers", func() error {
+			return deleteWhere(ctx, d.Follows.FindAll, d.Follows.DeleteManyByPks, func(m *dao.Follow) string { return m.ID }, "followee_id = $1", user.ID)
+		}},
+	}
+
+	if s.policy.Follows == domain.DeletionRemove {
+		steps = append(steps, purgeStep{"follows", func() error {
+			return deleteWhere(ctx, d.Follows.FindAll, d.Follows.DeleteManyByPks, func(m *dao.Follow) string { return m.ID }, "follower_id = $1", user.ID)
+		}})
+	}
+
+	if s.policy.Bookmarks == domain.DeletionRemove {
+		steps = append(steps, purgeStep{"bookmarks", func() error {
+			return deleteWhere(ctx, d.Bookmarks.FindAll, d.Bookmarks.DeleteManyByPks, func(m *dao.Bookmark) string { return m.ID }, "user_id = $1", user.ID)
+		}})
+	} else {
+		// Kept bookmarks lose their private notes
+		steps = append(steps, purgeStep{"bookmark notes", func() error {
+			bookmarks, err := d.Bookmarks.FindAll(ctx, "user_id = $1 AND note <> ''", "", user.ID)
+			if err != nil {
+				return err
+			}
+			for _, bookmark := range bookmarks {
+				if err := d.Bookmarks.PartialUpdate(ctx, bookmark.ID, map[string]interface{}{"note": ""}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}})
+	}
+
+	if s.policy.Likes == domain.DeletionRemove {
+		steps = append(steps, purgeStep{"reactions", func() error {
+			return deleteWhere(ctx, d.Reactions.FindAll, d.Reactions.DeleteManyByPks, func(m *dao.Reaction) string { return m.ID }, "user_id = $1", user.ID)
+		}})
+	}
+
+	if s.policy.Comments == domain.DeletionRemove {
+		steps = append(steps, purgeStep{"comments", func() error {
+			return s.removeComments(ctx, user.ID)
+		}})
+	}
+
+	if s.policy.Posts == domain.DeletionRemove {
+		steps = append(steps, purgeStep{"posts", func() error {
+			return deleteWhere(ctx, d.Posts.FindAll, d.Posts.DeleteManyByPks, func(m *dao.Post) string { return m.ID }, "author_id = $1", user.ID)
+		}})
+	}
+
+	for _, step := range steps {
+		if err := step.run(); err != nil {
+			return fmt.Errorf("failed to delete %s: %w", step.what, err)
+		}
+	}
+
+	user.Anonymise(time.Now())
+	if err := d.Users.Update(ctx, user); err != nil {
+		return fmt.Errorf("failed to anonymise user: %w", err)
+	}
+
+	return nil
+}
+
+// removeComments deletes the comments of the user. Comments with replies
+// are tombstoned instead, so the threads of others stay intact.
+func (s *PurgeDeletedAccounts) removeComments(ctx context.Context, userID string) error {
+	d := s.data
+
+	comments, err := d.Comments.FindAll(ctx, "author_id = $1", "created_at DESC", userID)
+	if err != nil {
+		return err
+	}
+
+	for _, comment := range comments {
+		if err := deleteWhere(ctx, d.CommentRevisions.FindAll, d.CommentRevisions.DeleteManyByPks, func(m *dao.CommentRevision) string { return m.ID }, "comment_id = $1", comment.ID); err != nil {
+			return err
+		}
+
+		replies, err := d.Comments.Count(ctx, "parent_id = $1", comment.ID)
+		if err != nil {
+			return err
+		}
+
+		if replies > 0 {
+			comment.Tombstone()
+			if err := d.Comments.Update(ctx, comment); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := d.Comments.DeleteByPk(ctx, comment.ID); err != nil {
+			return err
+		}
+
+		if comment.ParentID != nil {
+			if _, err := refreshReplyCount(ctx, d.Comments, *comment.ParentID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
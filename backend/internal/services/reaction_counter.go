@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+type ReactionCount struct {
+	Emoji       string `json:"emoji"`
+	Count       int    `json:"count"`
+	ReactedByMe bool   `json:"reacted_by_me"`
+}
+
+// ReactionCounter sums the reactions of posts and comments, in the order of
+// the configured reaction set.
+type ReactionCounter struct {
+	reactionDAO dao.ReactionDAO
+	tallyDAO    customdao.ReactionTallyDAO
+	set         domain.ReactionSet
+}
+
+func NewReactionCounter(reactionDAO dao.ReactionDAO, tallyDAO customdao.ReactionTallyDAO, set domain.ReactionSet) *ReactionCounter {
+	return &ReactionCounter{
+		reactionDAO: reactionDAO,
+		tallyDAO:    tallyDAO,
+		set:         set,
+	}
+}
+
+func (r *ReactionCounter) Set() domain.ReactionSet {
+	return r.set
+}
+
+// ForPosts returns the reactions of every post, keyed by post ID. ViewerID
+// may be empty for anonymous readers.
+func (r *ReactionCounter) ForPosts(ctx context.Context, postIDs []string, viewerID string) (map[string][]ReactionCount, error) {
+	return r.count(ctx, "post_id", r.tallyDAO.CountForPosts, postIDs, viewerID)
+}
+
+// ForComments returns the reactions of every comment, keyed by comment ID.
+func (r *ReactionCounter) ForComments(ctx context.Context, commentIDs []string, viewerID string) (map[string][]ReactionCount, error) {
+	return r.count(ctx, "comment_id", r.tallyDAO.CountForComments, commentIDs, viewerID)
+}
+
+// count tallies the reactions in the database, and only loads the ones of
+// the viewer for the reacted by me flags.
+func (r *ReactionCounter) count(ctx context.Context, column string, tally func(context.Context, []string) ([]*customdao.ReactionTally, error), targetIDs []string, viewerID string) (map[string][]ReactionCount, error) {
+	result := make(map[string][]ReactionCount, len(targetIDs))
+	if len(targetIDs) == 0 {
+		return result, nil
+	}
+
+	tallies, err := tally(ctx, targetIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count reactions: %w", err)
+	}
+
+	counts := make(map[string]map[string]int)
+	for _, t := range tallies {
+		if counts[t.TargetID] == nil {
+			counts[t.TargetID] = make(map[string]int)
+		}
+		counts[t.TargetID][t.Emoji] = t.Count
+	}
+
+	mine := make(map[string]map[string]bool)
+	if viewerID != "" {
+		args := []any{viewerID}
+		placeholders := make([]string, 0, len(targetIDs))
+		for _, id := range targetIDs {
+			args = append(args, id)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		}
+
+		reactions, err := r.reactionDAO.FindAll(ctx, "user_id = $1 AND "+column+" IN ("+strings.Join(placeholders, ",")+")", "", args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load reactions: %w", err)
+		}
+
+		for _, reaction := range reactions {
+			targetID := reaction.PostID
+			if column == "comment_id" {
+				targetID = reaction.CommentID
+			}
+			if targetID == nil {
+				continue
+			}
+
+			if mine[*targetID] == nil {
+				mine[*targetID] = make(map[string]bool)
+			}
+			mine[*targetID][reaction.Emoji] = true
+		}
+	}
+
+	for _, id := range targetIDs {
+		items := make([]ReactionCount, 0)
+		// Reactions dropped from the set are kept in the table but not shown
+		for _, emoji := range r.set {
+			if count := counts[id][emoji]; count > 0 {
+				items = append(items, ReactionCount{
+					Emoji:       emoji,
+					Count:       count,
+					ReactedByMe: mine[id][emoji],
+				})
+			}
+		}
+		result[id] = items
+	}
+
+	return result, nil
+}
+
+// reactionCountOf returns how many times the emoji was picked.
+func reactionCountOf(reactions []ReactionCount, emoji string) int {
+	for _, reaction := range reactions {
+		if reaction.Emoji == emoji {
+			return reaction.Count
+		}
+	}
+	return 0
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+)
+
+type RefreshRankings struct {
+	rankingDAO customdao.RankingDAO
+	weights    domain.RankingWeights
+}
+
+type RefreshRankingsResp struct {
+	// Ranked is the number of ranked posts per window.
+	Ranked      map[string]int `json:"ranked"`
+	PrunedViews int            `json:"pruned_views"`
+}
+
+func NewRefreshRankings(rankingDAO customdao.RankingDAO, weights domain.RankingWeights) *RefreshRankings {
+	return &RefreshRankings{
+		rankingDAO: rankingDAO,
+		weights:    weights,
+	}
+}
+
+// Exec rescores the posts of every ranking window, and prunes the views no
+// window looks at anymore.
+func (s *RefreshRankings) Exec(ctx context.Context) (*RefreshRankingsResp, error) {
+	now := time.Now()
+
+	pruned, err := s.rankingDAO.DeleteViewsBefore(ctx, now.Add(-domain.ViewRetention()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prune views: %w", err)
+	}
+
+	resp := &RefreshRankingsResp{
+		Ranked:      make(map[string]int),
+		PrunedViews: int(pruned),
+	}
+	// Readers see a window either before or after its refresh, never half
+	// rescored
+	for _, window := range domain.RankingWindows {
+		var ranked int64
+		err := s.rankingDAO.WithTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			ranked, err = s.rankingDAO.Refresh(ctx, window, s.weights, now)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to refresh %s rankings: %w", window.Period, err)
+		}
+		resp.Ranked[window.Period] = int(ranked)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type RefreshSession struct {
+	userDAO    dao.UserDAO
+	sessionDAO dao.SessionDAO
+	tokens     *TokenIssuer
+}
+
+type RefreshSessionReq struct {
+	RefreshToken string     `json:"refresh_token"`
+	Client       ClientInfo `json:"-"`
+}
+
+func NewRefreshSession(userDAO dao.UserDAO, sessionDAO dao.SessionDAO, tokens *TokenIssuer) *RefreshSession {
+	return &RefreshSession{
+		userDAO:    userDAO,
+		sessionDAO: sessionDAO,
+		tokens:     tokens,
+	}
+}
+
+func (s *RefreshSession) Exec(ctx context.Context, req *RefreshSessionReq) (*TokenPair, error) {
+	session, refreshToken, err := s.tokens.RotateSession(ctx, req.RefreshToken, req.Client)
+	if errors.Is(err, sql.ErrNoRows) {
+		// A rotated out token coming back means it leaked: end the session.
+		// So does a concurrent refresh losing the race for the same token
+		tokenHash := domain.HashToken(req.RefreshToken)
+		if reused, err := s.sessionDAO.FindOne(ctx, "previous_token_hash = $1", "", tokenHash); err == nil {
+			reused.Revoke()
+			if err := s.sessionDAO.Update(ctx, reused); err != nil {
+				return nil, fmt.Errorf("failed to revoke session: %w", err)
+			}
+		}
+		return nil, fmt.Errorf("invalid refresh token")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to rotate session: %w", err)
+	}
+
+	user, err := s.userDAO.FindByPk(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid refresh token")
+	}
+
+	if user.IsSuspended() {
+		session.Revoke()
+		if err := s.sessionDAO.Update(ctx, session); err != nil {
+			return nil, fmt.Errorf("failed to revoke session: %w", err)
+		}
+		return nil, fmt.Errorf("account suspended")
+	}
+
+	return s.tokens.SessionTokens(user, session, refreshToken)
+}
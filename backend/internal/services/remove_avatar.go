@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain/dao"
+)
+
+type RemoveAvatar struct {
+	userDAO   dao.UserDAO
+	avatarDAO dao.AvatarDAO
+}
+
+type RemoveAvatarReq struct {
+	UserID string
+}
+
+func NewRemoveAvatar(userDAO dao.UserDAO, avatarDAO dao.AvatarDAO) *RemoveAvatar {
+	return &RemoveAvatar{
+		userDAO:   userDAO,
+		avatarDAO: avatarDAO,
+	}
+}
+
+func (s *RemoveAvatar) Exec(ctx context.Context, req *RemoveAvatarReq) (*UserProfile, error) {
+	user, err := s.userDAO.FindByPk(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	user.AvatarURL = ""
+
+	err = s.avatarDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.avatarDAO.FindByPk(ctx, user.ID); err == nil {
+			if err := s.avatarDAO.DeleteByPk(ctx, user.ID); err != nil {
+				return fmt.Errorf("failed to delete avatar: %w", err)
+			}
+		}
+
+		if err := s.userDAO.PartialUpdate(ctx, user.ID, map[string]interface{}{
+			"avatar_url": "",
+		}); err != nil {
+			return fmt.Errorf("failed to save profile: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	profile := newUserProfile(user)
+	return &profile, nil
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"blog0/internal/domain/dao"
+)
+
+type RequestAccountDeletion struct {
+	userDAO dao.UserDAO
+	grace   time.Duration
+}
+
+// RequestAccountDeletionReq is confirmed by typing the handle of the
+// account.
+type RequestAccountDeletionReq struct {
+	UserID  string `json:"-"`
+	Confirm string `json:"confirm"`
+}
+
+type AccountDeletionResp struct {
+	Scheduled    bool       `json:"scheduled"`
+	ScheduledFor *time.Time `json:"scheduled_for"`
+}
+
+func NewRequestAccountDeletion(userDAO dao.UserDAO, grace time.Duration) *RequestAccountDeletion {
+	return &RequestAccountDeletion{
+		userDAO: userDAO,
+		grace:   grace,
+	}
+}
+
+// Exec schedules the deletion of the account after the grace period, until
+// then it can be cancelled and the account works as usual.
+func (s *RequestAccountDeletion) Exec(ctx context.Context, req *RequestAccountDeletionReq) (*AccountDeletionResp, error) {
+	user, err := s.userDAO.FindByPk(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	if !strings.EqualFold(strings.TrimPrefix(strings.TrimSpace(req.Confirm), "@"), user.Handle) {
+		return nil, fmt.Errorf("failed to delete account: confirm with your handle, @%s", user.Handle)
+	}
+
+	if !user.IsDeletionScheduled() {
+		user.ScheduleDeletion(time.Now().Add(s.grace))
+		if err := s.userDAO.PartialUpdate(ctx, user.ID, map[string]interface{}{
+			"deletion_scheduled_at": user.DeletionScheduledAt,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to schedule deletion: %w", err)
+		}
+	}
+
+	return &AccountDeletionResp{
+		Scheduled:    true,
+		ScheduledFor: user.DeletionScheduledAt,
+	}, nil
+}
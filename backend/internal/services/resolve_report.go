@@ -0,0 +1,259 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+// ResolveReport applies an admin's triage action to a report. The other open
+// reports on the same target are resolved along with it, every action lands
+// in the audit trail and each reporter is told the outcome.
+type ResolveReport struct {
+	reportDAO      dao.ReportDAO
+	adminActionDAO dao.AdminActionDAO
+	postDAO        dao.PostDAO
+	commentDAO     dao.CommentDAO
+	userDAO        dao.UserDAO
+	sessionDAO     dao.SessionDAO
+	mentions       *MentionTracker
+	notifier       *Notifier
+	realtime       domain.RealtimeHub
+	nextID         domain.NextID
+}
+
+type ResolveReportReq struct {
+	ReportID string `json:"-"`
+	AdminID  string `json:"-"`
+	Action   string `json:"-"`
+	Note     string `json:"note"`
+}
+
+type ResolveReportResp struct {
+	ID              string  `json:"id"`
+	Status          string  `json:"status"`
+	Resolution      *string `json:"resolution"`
+	ResolvedReports int     `json:"resolved_reports"`
+}
+
+func NewResolveReport(
+	reportDAO dao.ReportDAO,
+	adminActionDAO dao.AdminActionDAO,
+	postDAO dao.PostDAO,
+	commentDAO dao.CommentDAO,
+	userDAO dao.UserDAO,
+	sessionDAO dao.SessionDAO,
+	mentions *MentionTracker,
+	notifier *Notifier,
+	realtime domain.RealtimeHub,
+	nextID domain.NextID,
+) *ResolveReport {
+	return &ResolveReport{
+		reportDAO:      reportDAO,
+		adminActionDAO: adminActionDAO,
+		postDAO:        postDAO,
+		commentDAO:     commentDAO,
+		userDAO:        userDAO,
+		sessionDAO:     sessionDAO,
+		mentions:       mentions,
+		notifier:       notifier,
+		realtime:       realtime,
+		nextID:         nextID,
+	}
+}
+
+func (s *ResolveReport) Exec(ctx context.Context, req *ResolveReportReq) (*ResolveReportResp, error) {
+	report, err := s.reportDAO.FindByPk(ctx, req.ReportID)
+	if err != nil {
+		return nil, fmt.Errorf("report not found: %w", err)
+	}
+
+	if !report.IsOpen() {
+		return nil, fmt.Errorf("failed to resolve report: report already resolved")
+	}
+
+	if req.Action == domain.ReportActionHideContent && report.TargetType == domain.ReportTargetUser {
+		return nil, fmt.Errorf("failed to resolve report: users cannot be hidden, suspend them instead")
+	}
+
+	where, args := sameTargetReportsFilter(report)
+	reports, err := s.reportDAO.FindAll(ctx, where, "created_at ASC", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load reports: %w", err)
+	}
+
+	for _, r := range reports {
+		if err := r.Resolve(req.AdminID, req.Action); err != nil {
+			return nil, fmt.Errorf("failed to resolve report: %w", err)
+		}
+	}
+
+	targetType := report.TargetType
+	targetID := report.TargetID()
+	if req.Action == domain.ReportActionSuspendUser {
+		targetType = domain.ReportTargetUser
+		targetID = report.ReportedUserID
+	}
+
+	adminAction, err := domain.NewAdminAction(s.nextID(), req.AdminID, req.Action, &report.ID, targetType, targetID, req.Note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin action: %w", err)
+	}
+
+	var hiddenComment *domain.Comment
+	err = s.reportDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		switch req.Action {
+		case domain.ReportActionHideContent:
+			if report.TargetType == domain.ReportTargetComment {
+				hiddenComment, err = s.hideComment(ctx, *report.CommentID, report.Reason)
+			} else {
+				err = s.hidePost(ctx, *report.PostID)
+			}
+		case domain.ReportActionSuspendUser:
+			err = s.suspendUser(ctx, report.ReportedUserID)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := s.reportDAO.UpdateMany(ctx, reports); err != nil {
+			return fmt.Errorf("failed to save reports: %w", err)
+		}
+
+		if err := s.adminActionDAO.Create(ctx, adminAction); err != nil {
+			return fmt.Errorf("failed to save admin action: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if hiddenComment != nil {
+		s.publishHiddenComment(ctx, hiddenComment)
+	}
+
+	for _, r := range reports {
+		err := s.notifier.Notify(ctx, &NotifyReq{
+			RecipientID: r.ReporterID,
+			ActorID:     req.AdminID,
+			Type:        domain.NotificationTypeReport,
+			ReportID:    &r.ID,
+		})
+		if err != nil {
+			log.Printf("failed to notify reporter of report %s: %v", r.ID, err)
+		}
+	}
+
+	resolved := report
+	for _, r := range reports {
+		if r.ID == report.ID {
+			resolved = r
+		}
+	}
+
+	return &ResolveReportResp{
+		ID:              resolved.ID,
+		Status:          resolved.Status,
+		Resolution:      resolved.Resolution,
+		ResolvedReports: len(reports),
+	}, nil
+}
+
+func (s *ResolveReport) hidePost(ctx context.Context, postID string) error {
+	post, err := s.postDAO.FindByPk(ctx, postID)
+	if err != nil {
+		return fmt.Errorf("post not found: %w", err)
+	}
+
+	if post.IsHidden() {
+		return nil
+	}
+
+	post.Hide(time.Now())
+	if err := s.postDAO.Update(ctx, post); err != nil {
+		return fmt.Errorf("failed to save post: %w", err)
+	}
+
+	return nil
+}
+
+// hideComment takes the comment out of its thread. It returns nil when the
+// comment was not visible in the first place.
+func (s *ResolveReport) hideComment(ctx context.Context, commentID string, reason string) (*domain.Comment, error) {
+	comment, err := s.commentDAO.FindByPk(ctx, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("comment not found: %w", err)
+	}
+
+	if comment.IsHidden() {
+		return nil, nil
+	}
+
+	wasApproved := comment.IsApproved()
+	comment.Hide("hidden after a report: " + reason)
+	if err := s.commentDAO.Update(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to save comment: %w", err)
+	}
+
+	if !wasApproved {
+		return nil, nil
+	}
+
+	if err := s.mentions.SyncComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	if comment.ParentID != nil {
+		if _, err := refreshReplyCount(ctx, s.commentDAO, *comment.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	return comment, nil
+}
+
+func (s *ResolveReport) suspendUser(ctx context.Context, userID string) error {
+	user, err := s.userDAO.FindByPk(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	if user.IsSuspended() {
+		return nil
+	}
+
+	user.Suspend(time.Now())
+	if err := s.userDAO.Update(ctx, user); err != nil {
+		return fmt.Errorf("failed to save user: %w", err)
+	}
+
+	return revokeUserSessions(ctx, s.sessionDAO, user.ID)
+}
+
+func (s *ResolveReport) publishHiddenComment(ctx context.Context, comment *domain.Comment) {
+	post, err := s.postDAO.FindByPk(ctx, comment.PostID)
+	if err != nil {
+		log.Printf("failed to load post of comment %s: %v", comment.ID, err)
+		return
+	}
+
+	err = s.realtime.Publish(domain.RealtimeEvent{
+		Topic: domain.PostTopic(post.ID),
+		Type:  domain.RealtimeCommentDeleted,
+		Data: map[string]any{
+			"id":         comment.ID,
+			"post_slug":  post.Slug,
+			"tombstoned": false,
+		},
+	})
+	if err != nil {
+		log.Printf("failed to publish comment %s: %v", comment.ID, err)
+	}
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain/dao"
+)
+
+type RevokePersonalAccessToken struct {
+	tokenDAO dao.PersonalAccessTokenDAO
+}
+
+type RevokePersonalAccessTokenReq struct {
+	UserID  string
+	TokenID string
+}
+
+type RevokePersonalAccessTokenResp struct {
+	Success bool `json:"success"`
+}
+
+func NewRevokePersonalAccessToken(tokenDAO dao.PersonalAccessTokenDAO) *RevokePersonalAccessToken {
+	return &RevokePersonalAccessToken{
+		tokenDAO: tokenDAO,
+	}
+}
+
+func (s *RevokePersonalAccessToken) Exec(ctx context.Context, req *RevokePersonalAccessTokenReq) (*RevokePersonalAccessTokenResp, error) {
+	token, err := s.tokenDAO.FindOne(ctx, "id = $1 AND user_id = $2 AND revoked_at IS NULL", "", req.TokenID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("token not found: %w", err)
+	}
+
+	token.Revoke()
+	if err := s.tokenDAO.Update(ctx, token); err != nil {
+		return nil, fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	return &RevokePersonalAccessTokenResp{Success: true}, nil
+}
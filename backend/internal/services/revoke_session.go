@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain/dao"
+)
+
+type RevokeSession struct {
+	sessionDAO dao.SessionDAO
+}
+
+type RevokeSessionReq struct {
+	UserID    string
+	SessionID string
+}
+
+type RevokeSessionResp struct {
+	Success bool `json:"success"`
+}
+
+func NewRevokeSession(sessionDAO dao.SessionDAO) *RevokeSession {
+	return &RevokeSession{
+		sessionDAO: sessionDAO,
+	}
+}
+
+func (s *RevokeSession) Exec(ctx context.Context, req *RevokeSessionReq) (*RevokeSessionResp, error) {
+	session, err := s.sessionDAO.FindOne(ctx, "id = $1 AND user_id = $2 AND revoked_at IS NULL", "", req.SessionID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("session not found: %w", err)
+	}
+
+	session.Revoke()
+	if err := s.sessionDAO.Update(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	return &RevokeSessionResp{Success: true}, nil
+}
+
+// revokeUserSessions signs a user out everywhere.
+func revokeUserSessions(ctx context.Context, sessionDAO dao.SessionDAO, userID string) error {
+	sessions, err := sessionDAO.FindAll(ctx, "user_id = $1 AND revoked_at IS NULL", "", userID)
+	if err != nil {
+		return fmt.Errorf("failed to load sessions: %w", err)
+	}
+
+	for _, session := range sessions {
+		session.Revoke()
+	}
+
+	if len(sessions) > 0 {
+		if err := sessionDAO.UpdateMany(ctx, sessions); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+
+	"blog0/internal/domain"
+)
+
+// SetLike likes or unlikes a post whatever its current state, unlike
+// ToggleLike, so that a retried request has the same outcome.
+type SetLike struct {
+	toggleReaction *ToggleReaction
+}
+
+type SetLikeReq struct {
+	Slug   string `json:"-"`
+	UserID string `json:"-"`
+	Liked  bool   `json:"-"`
+}
+
+func NewSetLike(toggleReaction *ToggleReaction) *SetLike {
+	return &SetLike{
+		toggleReaction: toggleReaction,
+	}
+}
+
+func (s *SetLike) Exec(ctx context.Context, req *SetLikeReq) (*ToggleLikeResp, error) {
+	resp, err := s.toggleReaction.Set(ctx, &ToggleReactionReq{
+		Slug:   req.Slug,
+		Emoji:  domain.ReactionLike,
+		UserID: req.UserID,
+	}, req.Liked)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ToggleLikeResp{
+		Liked:      resp.Reacted,
+		LikesCount: reactionCountOf(resp.Reactions, domain.ReactionLike),
+	}, nil
+}
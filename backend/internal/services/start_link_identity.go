@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"blog0/internal/domain/dao"
+)
+
+type StartLinkIdentity struct {
+	identityDAO dao.IdentityDAO
+	providers   *OAuthProviders
+	jwtSecret   []byte
+	apiBaseURI  string
+}
+
+type StartLinkIdentityReq struct {
+	UserID   string
+	Provider string
+}
+
+// StartLinkIdentityResp carries the URL the web app navigates to, which goes
+// through the provider sign in and back to the settings of the user.
+type StartLinkIdentityResp struct {
+	URL       string `json:"url"`
+	ExpiresIn int    `json:"expires_in"`
+}
+
+func NewStartLinkIdentity(identityDAO dao.IdentityDAO, providers *OAuthProviders, jwtSecret string, apiBaseURI string) *StartLinkIdentity {
+	return &StartLinkIdentity{
+		identityDAO: identityDAO,
+		providers:   providers,
+		jwtSecret:   []byte(jwtSecret),
+		apiBaseURI:  apiBaseURI,
+	}
+}
+
+func (s *StartLinkIdentity) Exec(ctx context.Context, req *StartLinkIdentityReq) (*StartLinkIdentityResp, error) {
+	provider, err := s.providers.Get(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.identityDAO.FindOne(ctx, "user_id = $1 AND provider = $2", "", req.UserID, provider.Name)
+	if err == nil {
+		return nil, fmt.Errorf("unauthorized: %s is linked already", provider.Name)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to load identity: %w", err)
+	}
+
+	linkToken, err := signOAuthLink(s.jwtSecret, req.UserID, provider.Name, time.Now().Add(oauthLinkTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	return &StartLinkIdentityResp{
+		URL:       fmt.Sprintf("%s/api/v1/auth/%s?link_token=%s", s.apiBaseURI, provider.Name, url.QueryEscape(linkToken)),
+		ExpiresIn: int(oauthLinkTTL.Seconds()),
+	}, nil
+}
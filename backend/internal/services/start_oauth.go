@@ -2,23 +2,73 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"golang.org/x/oauth2"
+
+	"blog0/internal/domain"
 )
 
 type StartOAuth struct {
-	cfg *oauth2.Config
+	providers *OAuthProviders
+	jwtSecret []byte
+}
+
+// StartOAuthReq links the provider to a signed in user rather than signing
+// in when LinkToken, from StartLinkIdentity, is set.
+type StartOAuthReq struct {
+	Provider  string
+	LinkToken string
 }
 
+// StartOAuthResp carries the provider URL to redirect to and the signed
+// state the callback needs back, to keep in a cookie until then.
 type StartOAuthResp struct {
-	URL string
+	URL         string
+	SignedState string
+	ExpiresIn   int
 }
 
-func NewStartOAuth(cfg *oauth2.Config) *StartOAuth {
-	return &StartOAuth{cfg: cfg}
+func NewStartOAuth(providers *OAuthProviders, jwtSecret string) *StartOAuth {
+	return &StartOAuth{
+		providers: providers,
+		jwtSecret: []byte(jwtSecret),
+	}
 }
 
-func (s *StartOAuth) Exec(ctx context.Context) (*StartOAuthResp, error) {
-	url := s.cfg.AuthCodeURL("")
-	return &StartOAuthResp{URL: url}, nil
+func (s *StartOAuth) Exec(ctx context.Context, req StartOAuthReq) (*StartOAuthResp, error) {
+	provider, err := s.providers.Get(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := domain.NewOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	stored := &OAuthState{
+		Provider: provider.Name,
+		State:    state,
+		Verifier: oauth2.GenerateVerifier(),
+	}
+
+	if req.LinkToken != "" {
+		stored.LinkUserID, err = verifyOAuthLink(s.jwtSecret, req.LinkToken, provider.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	signed, err := signOAuthState(s.jwtSecret, stored, time.Now().Add(oauthStateTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	url := provider.Config.AuthCodeURL(stored.State, oauth2.S256ChallengeOption(stored.Verifier))
+	return &StartOAuthResp{
+		URL:         url,
+		SignedState: signed,
+		ExpiresIn:   int(oauthStateTTL.Seconds()),
+	}, nil
 }
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+)
+
+type SubscribeNotifications struct {
+	realtime domain.RealtimeHub
+}
+
+type SubscribeNotificationsReq struct {
+	UserID string
+}
+
+type SubscribeNotificationsResp struct {
+	Events      <-chan domain.RealtimeEvent
+	Unsubscribe func()
+}
+
+func NewSubscribeNotifications(realtime domain.RealtimeHub) *SubscribeNotifications {
+	return &SubscribeNotifications{
+		realtime: realtime,
+	}
+}
+
+func (s *SubscribeNotifications) Exec(ctx context.Context, req *SubscribeNotificationsReq) (*SubscribeNotificationsResp, error) {
+	events, unsubscribe, err := s.realtime.Subscribe(domain.UserTopic(req.UserID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe: %w", err)
+	}
+
+	return &SubscribeNotificationsResp{
+		Events:      events,
+		Unsubscribe: unsubscribe,
+	}, nil
+}
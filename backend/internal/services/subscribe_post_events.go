@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type SubscribePostEvents struct {
+	postDAO  dao.PostDAO
+	realtime domain.RealtimeHub
+}
+
+type SubscribePostEventsReq struct {
+	Slug string
+}
+
+type SubscribePostEventsResp struct {
+	Events      <-chan domain.RealtimeEvent
+	Unsubscribe func()
+}
+
+func NewSubscribePostEvents(postDAO dao.PostDAO, realtime domain.RealtimeHub) *SubscribePostEvents {
+	return &SubscribePostEvents{
+		postDAO:  postDAO,
+		realtime: realtime,
+	}
+}
+
+func (s *SubscribePostEvents) Exec(ctx context.Context, req *SubscribePostEventsReq) (*SubscribePostEventsResp, error) {
+	post, err := s.postDAO.FindOne(ctx, "slug = $1 AND published_at IS NOT NULL AND hidden_at IS NULL", "", req.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+
+	events, unsubscribe, err := s.realtime.Subscribe(domain.PostTopic(post.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe: %w", err)
+	}
+
+	return &SubscribePostEventsResp{
+		Events:      events,
+		Unsubscribe: unsubscribe,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type SuspendUser struct {
+	userDAO        dao.UserDAO
+	sessionDAO     dao.SessionDAO
+	adminActionDAO dao.AdminActionDAO
+	nextID         domain.NextID
+}
+
+type SuspendUserReq struct {
+	UserID  string `json:"-"`
+	AdminID string `json:"-"`
+	Note    string `json:"note"`
+}
+
+func NewSuspendUser(userDAO dao.UserDAO, sessionDAO dao.SessionDAO, adminActionDAO dao.AdminActionDAO, nextID domain.NextID) *SuspendUser {
+	return &SuspendUser{
+		userDAO:        userDAO,
+		sessionDAO:     sessionDAO,
+		adminActionDAO: adminActionDAO,
+		nextID:         nextID,
+	}
+}
+
+func (s *SuspendUser) Exec(ctx context.Context, req *SuspendUserReq) (*AdminUserItem, error) {
+	if req.UserID == req.AdminID {
+		return nil, fmt.Errorf("unauthorized: you cannot suspend yourself")
+	}
+
+	user, err := s.userDAO.FindByPk(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	if user.IsSuspended() {
+		item := newAdminUserItem(user)
+		return &item, nil
+	}
+
+	user.Suspend(time.Now())
+
+	adminAction, err := domain.NewAdminAction(s.nextID(), req.AdminID, domain.AdminActionSuspendUser, nil, domain.ReportTargetUser, user.ID, req.Note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin action: %w", err)
+	}
+
+	err = s.userDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userDAO.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to save user: %w", err)
+		}
+
+		if err := revokeUserSessions(ctx, s.sessionDAO, user.ID); err != nil {
+			return err
+		}
+
+		if err := s.adminActionDAO.Create(ctx, adminAction); err != nil {
+			return fmt.Errorf("failed to save admin action: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	item := newAdminUserItem(user)
+	return &item, nil
+}
@@ -0,0 +1,222 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+type ToggleReaction struct {
+	postDAO     dao.PostDAO
+	commentDAO  dao.CommentDAO
+	reactionDAO dao.ReactionDAO
+	relationDAO customdao.RelationDAO
+	blockDAO    dao.BlockDAO
+	reactions   *ReactionCounter
+	notifier    *Notifier
+	realtime    domain.RealtimeHub
+	nextID      domain.NextID
+}
+
+type ToggleReactionReq struct {
+	Slug      string  `json:"-"`
+	CommentID *string `json:"-"`
+	Emoji     string  `json:"-"`
+	UserID    string  `json:"-"`
+}
+
+type ToggleReactionResp struct {
+	Emoji     string          `json:"emoji"`
+	Reacted   bool            `json:"reacted"`
+	Reactions []ReactionCount `json:"reactions"`
+}
+
+func NewToggleReaction(postDAO dao.PostDAO, commentDAO dao.CommentDAO, reactionDAO dao.ReactionDAO, relationDAO customdao.RelationDAO, blockDAO dao.BlockDAO, reactions *ReactionCounter, notifier *Notifier, realtime domain.RealtimeHub, nextID domain.NextID) *ToggleReaction {
+	return &ToggleReaction{
+		postDAO:     postDAO,
+		commentDAO:  commentDAO,
+		reactionDAO: reactionDAO,
+		relationDAO: relationDAO,
+		blockDAO:    blockDAO,
+		reactions:   reactions,
+		notifier:    notifier,
+		realtime:    realtime,
+		nextID:      nextID,
+	}
+}
+
+// Exec adds the reaction, or removes it when the user already reacted.
+func (s *ToggleReaction) Exec(ctx context.Context, req *ToggleReactionReq) (*ToggleReactionResp, error) {
+	return s.exec(ctx, req, nil)
+}
+
+// Set adds the reaction when reacted is true and removes it otherwise, doing
+// nothing when it is already in that state, so that requests can be retried.
+func (s *ToggleReaction) Set(ctx context.Context, req *ToggleReactionReq, reacted bool) (*ToggleReactionResp, error) {
+	return s.exec(ctx, req, &reacted)
+}
+
+func (s *ToggleReaction) exec(ctx context.Context, req *ToggleReactionReq, want *bool) (*ToggleReactionResp, error) {
+	post, err := s.postDAO.FindOne(ctx, "slug = $1 AND hidden_at IS NULL", "", req.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+
+	if !s.reactions.Set().Has(req.Emoji) {
+		return nil, fmt.Errorf("unknown reaction %s", req.Emoji)
+	}
+
+	blocked, err := isBlocked(ctx, s.blockDAO, post.AuthorID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, fmt.Errorf("unauthorized: you cannot react to this post")
+	}
+
+	var comment *domain.Comment
+	if req.CommentID != nil {
+		comment, err = s.commentDAO.FindOne(ctx, "id = $1 AND post_id = $2 AND status = $3", "", *req.CommentID, post.ID, domain.CommentStatusApproved)
+		if err != nil {
+			return nil, fmt.Errorf("comment not found: %w", err)
+		}
+
+		if comment.IsDeleted() {
+			return nil, fmt.Errorf("comment not found: comment was deleted")
+		}
+
+		blocked, err := isBlocked(ctx, s.blockDAO, comment.AuthorID, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, fmt.Errorf("unauthorized: you cannot react to this comment")
+		}
+	}
+
+	var existing *domain.Reaction
+	if comment != nil {
+		existing, err = s.reactionDAO.FindOne(ctx, "user_id = $1 AND comment_id = $2 AND emoji = $3", "", req.UserID, comment.ID, req.Emoji)
+	} else {
+		existing, err = s.reactionDAO.FindOne(ctx, "user_id = $1 AND post_id = $2 AND emoji = $3", "", req.UserID, post.ID, req.Emoji)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		existing = nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load reaction: %w", err)
+	}
+
+	// Toggling flips the current state
+	reacted := existing == nil
+	if want != nil {
+		reacted = *want
+	}
+
+	changed := false
+	if !reacted && existing != nil {
+		err = s.reactionDAO.DeleteByPk(ctx, existing.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove reaction: %w", err)
+		}
+		changed = true
+	} else if reacted && existing == nil {
+		var reaction *domain.Reaction
+		if comment != nil {
+			reaction, err = domain.NewCommentReaction(s.nextID(), req.UserID, comment.ID, req.Emoji)
+		} else {
+			reaction, err = domain.NewPostReaction(s.nextID(), req.UserID, post.ID, req.Emoji)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create reaction: %w", err)
+		}
+
+		// A concurrent request may have added it since, then it's not ours to notify
+		changed, err = s.relationDAO.InsertReaction(ctx, reaction)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save reaction: %w", err)
+		}
+
+		if changed && comment == nil && req.Emoji == domain.ReactionLike {
+			err = s.notifier.Notify(ctx, &NotifyReq{
+				RecipientID: post.AuthorID,
+				ActorID:     req.UserID,
+				Type:        domain.NotificationTypeLike,
+				PostID:      &post.ID,
+			})
+			if err != nil {
+				// The like is saved, the author misses the notification only
+				log.Printf("failed to notify like of post %s: %v", post.ID, err)
+			}
+		}
+	}
+
+	var counts map[string][]ReactionCount
+	targetID := post.ID
+	if comment != nil {
+		targetID = comment.ID
+		counts, err = s.reactions.ForComments(ctx, []string{comment.ID}, req.UserID)
+	} else {
+		counts, err = s.reactions.ForPosts(ctx, []string{post.ID}, req.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if changed {
+		s.publish(post, comment, counts[targetID], req.Emoji)
+	}
+
+	return &ToggleReactionResp{
+		Emoji:     req.Emoji,
+		Reacted:   reacted,
+		Reactions: counts[targetID],
+	}, nil
+}
+
+func (s *ToggleReaction) publish(post *domain.Post, comment *domain.Comment, reactions []ReactionCount, emoji string) {
+	// Live readers get the totals, not the flags of the user who reacted
+	totals := make([]ReactionCount, 0, len(reactions))
+	for _, reaction := range reactions {
+		totals = append(totals, ReactionCount{Emoji: reaction.Emoji, Count: reaction.Count})
+	}
+
+	var commentID *string
+	if comment != nil {
+		commentID = &comment.ID
+	}
+
+	err := s.realtime.Publish(domain.RealtimeEvent{
+		Topic: domain.PostTopic(post.ID),
+		Type:  domain.RealtimeReactionsChanged,
+		Data: map[string]any{
+			"post_slug":  post.Slug,
+			"comment_id": commentID,
+			"reactions":  totals,
+		},
+	})
+	if err != nil {
+		log.Printf("failed to publish reactions of post %s: %v", post.ID, err)
+	}
+
+	// Older clients only listen to like counts
+	if comment == nil && emoji == domain.ReactionLike {
+		err = s.realtime.Publish(domain.RealtimeEvent{
+			Topic: domain.PostTopic(post.ID),
+			Type:  domain.RealtimeLikeCountChanged,
+			Data: map[string]any{
+				"post_slug":   post.Slug,
+				"likes_count": reactionCountOf(reactions, domain.ReactionLike),
+			},
+		})
+		if err != nil {
+			log.Printf("failed to publish like count of post %s: %v", post.ID, err)
+		}
+	}
+}
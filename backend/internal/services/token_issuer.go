@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+// TokenIssuer signs the short-lived access tokens and keeps the sessions
+// behind the refresh tokens.
+type TokenIssuer struct {
+	sessionDAO  dao.SessionDAO
+	rotationDAO customdao.SessionRotationDAO
+	jwtSecret   []byte
+	accessTTL   time.Duration
+	refreshTTL  time.Duration
+	nextID      domain.NextID
+}
+
+// ClientInfo describes the device a session was started or refreshed from.
+type ClientInfo struct {
+	UserAgent string
+	IP        string
+}
+
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+func NewTokenIssuer(sessionDAO dao.SessionDAO, rotationDAO customdao.SessionRotationDAO, jwtSecret string, accessTTL time.Duration, refreshTTL time.Duration, nextID domain.NextID) *TokenIssuer {
+	return &TokenIssuer{
+		sessionDAO:  sessionDAO,
+		rotationDAO: rotationDAO,
+		jwtSecret:   []byte(jwtSecret),
+		accessTTL:   accessTTL,
+		refreshTTL:  refreshTTL,
+		nextID:      nextID,
+	}
+}
+
+// StartSession opens a session for a user who just signed in.
+func (s *TokenIssuer) StartSession(ctx context.Context, user *domain.User, client ClientInfo) (*TokenPair, error) {
+	refreshToken, err := domain.NewOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := domain.NewSession(s.nextID(), user.ID, refreshToken, client.UserAgent, client.IP, time.Now().Add(s.refreshTTL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	if err := s.sessionDAO.Create(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to save session: %w", err)
+	}
+
+	return s.tokenPair(user, session.ID, refreshToken)
+}
+
+// RotateSession exchanges a refresh token for a new one, on the active
+// session holding it. It returns sql.ErrNoRows when there is none, including
+// when a concurrent refresh exchanged the token first.
+func (s *TokenIssuer) RotateSession(ctx context.Context, refreshToken string, client ClientInfo) (*domain.Session, string, error) {
+	nextToken, err := domain.NewOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	session, err := s.rotationDAO.Rotate(ctx, domain.HashToken(refreshToken), &customdao.SessionRotation{
+		RefreshTokenHash: domain.HashToken(nextToken),
+		UserAgent:        client.UserAgent,
+		IP:               client.IP,
+		Now:              now,
+		ExpiresAt:        now.Add(s.refreshTTL),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return session, nextToken, nil
+}
+
+// SessionTokens signs a new access token for a rotated session, with the
+// user's current role.
+func (s *TokenIssuer) SessionTokens(user *domain.User, session *domain.Session, refreshToken string) (*TokenPair, error) {
+	return s.tokenPair(user, session.ID, refreshToken)
+}
+
+func (s *TokenIssuer) tokenPair(user *domain.User, sessionID string, refreshToken string) (*TokenPair, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": user.ID,
+		"email":   user.Email,
+		"role":    user.Role,
+		"sid":     sessionID,
+		"exp":     time.Now().Add(s.accessTTL).Unix(),
+	})
+	accessToken, err := token.SignedString(s.jwtSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(s.accessTTL.Seconds()),
+	}, nil
+}
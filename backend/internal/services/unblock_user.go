@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain/dao"
+)
+
+type UnblockUser struct {
+	blockDAO dao.BlockDAO
+}
+
+type UnblockUserReq struct {
+	BlockedID string `json:"-"`
+	UserID    string `json:"-"`
+}
+
+type UnblockUserResp struct {
+	Blocked bool `json:"blocked"`
+}
+
+func NewUnblockUser(blockDAO dao.BlockDAO) *UnblockUser {
+	return &UnblockUser{
+		blockDAO: blockDAO,
+	}
+}
+
+func (s *UnblockUser) Exec(ctx context.Context, req *UnblockUserReq) (*UnblockUserResp, error) {
+	block, err := s.blockDAO.FindOne(ctx, "blocker_id = $1 AND blocked_id = $2", "", req.UserID, req.BlockedID)
+	if err != nil {
+		return nil, fmt.Errorf("block not found: %w", err)
+	}
+
+	err = s.blockDAO.DeleteByPk(ctx, block.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete block: %w", err)
+	}
+
+	return &UnblockUserResp{Blocked: false}, nil
+}
@@ -34,17 +34,20 @@ func (s *UnbookmarkPost) Exec(ctx context.Context, req *UnbookmarkPostReq) (*Unb
 		return nil, fmt.Errorf("post not found: %w", err)
 	}
 
-	bookmark, err := s.bookmarkDAO.FindOne(ctx, "user_id = $1 AND post_id = $2", "", req.UserID, post.ID)
+	// Removing a bookmark that is already gone succeeds
+	bookmarks, err := s.bookmarkDAO.FindAll(ctx, "user_id = $1 AND post_id = $2", "", req.UserID, post.ID)
 	if err != nil {
-		return nil, fmt.Errorf("bookmark not found: %w", err)
+		return nil, fmt.Errorf("failed to load bookmark: %w", err)
 	}
 
-	err = s.bookmarkDAO.DeleteByPk(ctx, bookmark.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to delete bookmark: %w", err)
+	for _, bookmark := range bookmarks {
+		err = s.bookmarkDAO.DeleteByPk(ctx, bookmark.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete bookmark: %w", err)
+		}
 	}
 
 	return &UnbookmarkPostResp{
 		Bookmarked: false,
 	}, nil
-}
\ No newline at end of file
+}
@@ -35,14 +35,17 @@ func (s *UnfollowUser) Exec(ctx context.Context, req *UnfollowUserReq) (*Unfollo
 		return nil, fmt.Errorf("author not found: %w", err)
 	}
 
-	follow, err := s.followDAO.FindOne(ctx, "follower_id = $1 AND followee_id = $2", "", req.UserID, req.AuthorID)
+	// Unfollowing someone not followed succeeds
+	follows, err := s.followDAO.FindAll(ctx, "follower_id = $1 AND followee_id = $2", "", req.UserID, req.AuthorID)
 	if err != nil {
-		return nil, fmt.Errorf("follow relationship not found: %w", err)
+		return nil, fmt.Errorf("failed to load follow: %w", err)
 	}
 
-	err = s.followDAO.DeleteByPk(ctx, follow.ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to delete follow: %w", err)
+	for _, follow := range follows {
+		err = s.followDAO.DeleteByPk(ctx, follow.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete follow: %w", err)
+		}
 	}
 
 	followersCount, err := s.followDAO.Count(ctx, "followee_id = $1", req.AuthorID)
@@ -54,4 +57,4 @@ func (s *UnfollowUser) Exec(ctx context.Context, req *UnfollowUserReq) (*Unfollo
 		Following:      false,
 		FollowersCount: int(followersCount),
 	}, nil
-}
\ No newline at end of file
+}
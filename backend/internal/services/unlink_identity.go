@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain/customdao"
+	"blog0/internal/domain/dao"
+)
+
+type UnlinkIdentity struct {
+	identityDAO dao.IdentityDAO
+	lockDAO     customdao.IdentityDAO
+}
+
+type UnlinkIdentityReq struct {
+	UserID   string
+	Provider string
+}
+
+type UnlinkIdentityResp struct {
+	Provider string `json:"provider"`
+	Unlinked bool   `json:"unlinked"`
+}
+
+func NewUnlinkIdentity(identityDAO dao.IdentityDAO, lockDAO customdao.IdentityDAO) *UnlinkIdentity {
+	return &UnlinkIdentity{
+		identityDAO: identityDAO,
+		lockDAO:     lockDAO,
+	}
+}
+
+func (s *UnlinkIdentity) Exec(ctx context.Context, req *UnlinkIdentityReq) (*UnlinkIdentityResp, error) {
+	err := s.identityDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		// Users must keep a way to sign in. The identities stay locked until
+		// the delete commits, so two unlinks can't both see the other one
+		linked, err := s.lockDAO.LockLinked(ctx, req.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to count identities: %w", err)
+		}
+
+		identity, err := s.identityDAO.FindOne(ctx, "user_id = $1 AND provider = $2", "", req.UserID, req.Provider)
+		if err != nil {
+			return fmt.Errorf("identity not found: %w", err)
+		}
+
+		if linked <= 1 {
+			return fmt.Errorf("unauthorized: %s is your last sign in method, link another provider first", req.Provider)
+		}
+
+		if err := s.identityDAO.DeleteByPk(ctx, identity.ID); err != nil {
+			return fmt.Errorf("failed to unlink identity: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UnlinkIdentityResp{
+		Provider: req.Provider,
+		Unlinked: true,
+	}, nil
+}
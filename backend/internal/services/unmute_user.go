@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain/dao"
+)
+
+type UnmuteUser struct {
+	muteDAO dao.MuteDAO
+}
+
+type UnmuteUserReq struct {
+	MutedID string `json:"-"`
+	UserID  string `json:"-"`
+}
+
+type UnmuteUserResp struct {
+	Muted bool `json:"muted"`
+}
+
+func NewUnmuteUser(muteDAO dao.MuteDAO) *UnmuteUser {
+	return &UnmuteUser{
+		muteDAO: muteDAO,
+	}
+}
+
+func (s *UnmuteUser) Exec(ctx context.Context, req *UnmuteUserReq) (*UnmuteUserResp, error) {
+	mute, err := s.muteDAO.FindOne(ctx, "muter_id = $1 AND muted_id = $2", "", req.UserID, req.MutedID)
+	if err != nil {
+		return nil, fmt.Errorf("mute not found: %w", err)
+	}
+
+	err = s.muteDAO.DeleteByPk(ctx, mute.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete mute: %w", err)
+	}
+
+	return &UnmuteUserResp{Muted: false}, nil
+}
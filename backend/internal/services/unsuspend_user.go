@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type UnsuspendUser struct {
+	userDAO        dao.UserDAO
+	adminActionDAO dao.AdminActionDAO
+	nextID         domain.NextID
+}
+
+type UnsuspendUserReq struct {
+	UserID  string `json:"-"`
+	AdminID string `json:"-"`
+	Note    string `json:"note"`
+}
+
+func NewUnsuspendUser(userDAO dao.UserDAO, adminActionDAO dao.AdminActionDAO, nextID domain.NextID) *UnsuspendUser {
+	return &UnsuspendUser{
+		userDAO:        userDAO,
+		adminActionDAO: adminActionDAO,
+		nextID:         nextID,
+	}
+}
+
+func (s *UnsuspendUser) Exec(ctx context.Context, req *UnsuspendUserReq) (*AdminUserItem, error) {
+	user, err := s.userDAO.FindByPk(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	if !user.IsSuspended() {
+		item := newAdminUserItem(user)
+		return &item, nil
+	}
+
+	user.Unsuspend()
+
+	adminAction, err := domain.NewAdminAction(s.nextID(), req.AdminID, domain.AdminActionUnsuspendUser, nil, domain.ReportTargetUser, user.ID, req.Note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin action: %w", err)
+	}
+
+	err = s.userDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userDAO.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to save user: %w", err)
+		}
+
+		if err := s.adminActionDAO.Create(ctx, adminAction); err != nil {
+			return fmt.Errorf("failed to save admin action: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	item := newAdminUserItem(user)
+	return &item, nil
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain/dao"
+)
+
+type UpdateBookmark struct {
+	bookmarkDAO   dao.BookmarkDAO
+	collectionDAO dao.BookmarkCollectionDAO
+}
+
+// UpdateBookmarkReq changes the fields that are set. An empty collection ID
+// takes the bookmark out of its collection.
+type UpdateBookmarkReq struct {
+	ID           string  `json:"-"`
+	UserID       string  `json:"-"`
+	CollectionID *string `json:"collection_id"`
+	Note         *string `json:"note"`
+	Read         *bool   `json:"read"`
+}
+
+type UpdateBookmarkResp struct {
+	ID           string     `json:"id"`
+	CollectionID *string    `json:"collection_id"`
+	Note         string     `json:"note"`
+	Read         bool       `json:"read"`
+	ReadAt       *time.Time `json:"read_at"`
+}
+
+func NewUpdateBookmark(bookmarkDAO dao.BookmarkDAO, collectionDAO dao.BookmarkCollectionDAO) *UpdateBookmark {
+	return &UpdateBookmark{
+		bookmarkDAO:   bookmarkDAO,
+		collectionDAO: collectionDAO,
+	}
+}
+
+func (s *UpdateBookmark) Exec(ctx context.Context, req *UpdateBookmarkReq) (*UpdateBookmarkResp, error) {
+	bookmark, err := s.bookmarkDAO.FindOne(ctx, "id::text = $1 AND user_id = $2", "", req.ID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("bookmark not found: %w", err)
+	}
+
+	if req.CollectionID != nil {
+		bookmark.CollectionID = nil
+		if *req.CollectionID != "" {
+			collection, err := s.collectionDAO.FindOne(ctx, "id::text = $1 AND user_id = $2", "", *req.CollectionID, req.UserID)
+			if err != nil {
+				return nil, fmt.Errorf("collection not found: %w", err)
+			}
+			bookmark.CollectionID = &collection.ID
+		}
+	}
+
+	if req.Note != nil {
+		err = bookmark.SetNote(*req.Note)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update bookmark: %w", err)
+		}
+	}
+
+	if req.Read != nil {
+		bookmark.SetRead(*req.Read, time.Now())
+	}
+
+	err = s.bookmarkDAO.Update(ctx, bookmark)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save bookmark: %w", err)
+	}
+
+	return &UpdateBookmarkResp{
+		ID:           bookmark.ID,
+		CollectionID: bookmark.CollectionID,
+		Note:         bookmark.Note,
+		Read:         bookmark.IsRead(),
+		ReadAt:       bookmark.ReadAt,
+	}, nil
+}
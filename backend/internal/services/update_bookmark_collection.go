@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog0/internal/domain/dao"
+)
+
+type UpdateBookmarkCollection struct {
+	collectionDAO dao.BookmarkCollectionDAO
+	bookmarkDAO   dao.BookmarkDAO
+}
+
+type UpdateBookmarkCollectionReq struct {
+	ID          string `json:"-"`
+	UserID      string `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsPublic    bool   `json:"is_public"`
+}
+
+func NewUpdateBookmarkCollection(collectionDAO dao.BookmarkCollectionDAO, bookmarkDAO dao.BookmarkDAO) *UpdateBookmarkCollection {
+	return &UpdateBookmarkCollection{
+		collectionDAO: collectionDAO,
+		bookmarkDAO:   bookmarkDAO,
+	}
+}
+
+func (s *UpdateBookmarkCollection) Exec(ctx context.Context, req *UpdateBookmarkCollectionReq) (*BookmarkCollectionInfo, error) {
+	collection, err := s.collectionDAO.FindOne(ctx, "id::text = $1 AND user_id = $2", "", req.ID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("collection not found: %w", err)
+	}
+
+	err = collection.Update(req.Name, req.Description, req.IsPublic, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to update collection: %w", err)
+	}
+
+	err = checkCollectionName(ctx, s.collectionDAO, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.collectionDAO.Update(ctx, collection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save collection: %w", err)
+	}
+
+	count, err := s.bookmarkDAO.Count(ctx, "collection_id = $1", collection.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count bookmarks: %w", err)
+	}
+
+	info := newBookmarkCollectionInfo(collection, int(count))
+	return &info, nil
+}
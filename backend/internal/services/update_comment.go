@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type UpdateComment struct {
+	postDAO            dao.PostDAO
+	commentDAO         dao.CommentDAO
+	commentRevisionDAO dao.CommentRevisionDAO
+	mentions           *MentionTracker
+	realtime           domain.RealtimeHub
+	nextID             domain.NextID
+}
+
+type UpdateCommentReq struct {
+	Slug      string `json:"-"`
+	CommentID string `json:"-"`
+	Body      string `json:"body"`
+	UserID    string `json:"-"`
+}
+
+type UpdateCommentResp struct {
+	ID        string     `json:"id"`
+	PostSlug  string     `json:"post_slug"`
+	Body      string     `json:"body"`
+	Edited    bool       `json:"edited"`
+	EditedAt  *time.Time `json:"edited_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func NewUpdateComment(postDAO dao.PostDAO, commentDAO dao.CommentDAO, commentRevisionDAO dao.CommentRevisionDAO, mentions *MentionTracker, realtime domain.RealtimeHub, nextID domain.NextID) *UpdateComment {
+	return &UpdateComment{
+		postDAO:            postDAO,
+		commentDAO:         commentDAO,
+		commentRevisionDAO: commentRevisionDAO,
+		mentions:           mentions,
+		realtime:           realtime,
+		nextID:             nextID,
+	}
+}
+
+func (s *UpdateComment) Exec(ctx context.Context, req *UpdateCommentReq) (*UpdateCommentResp, error) {
+	post, err := s.postDAO.FindOne(ctx, "slug = $1 AND hidden_at IS NULL", "", req.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+
+	comment, err := s.commentDAO.FindOne(ctx, "id = $1 AND post_id = $2", "", req.CommentID, post.ID)
+	if err != nil {
+		return nil, fmt.Errorf("comment not found: %w", err)
+	}
+
+	// Deleted comments only remain as tombstones for their replies
+	if comment.IsDeleted() {
+		return nil, fmt.Errorf("comment not found: comment was deleted")
+	}
+
+	if comment.AuthorID != req.UserID {
+		return nil, fmt.Errorf("unauthorized: you can only edit your own comments")
+	}
+
+	if comment.Body != req.Body {
+		revision, err := domain.NewCommentRevision(s.nextID(), comment.ID, req.UserID, comment.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create comment revision: %w", err)
+		}
+
+		if err := comment.UpdateBody(req.Body); err != nil {
+			return nil, fmt.Errorf("failed to update comment: %w", err)
+		}
+
+		err = s.commentDAO.WithTransaction(ctx, func(ctx context.Context) error {
+			if err := s.commentRevisionDAO.Create(ctx, revision); err != nil {
+				return fmt.Errorf("failed to save comment revision: %w", err)
+			}
+
+			if err := s.commentDAO.Update(ctx, comment); err != nil {
+				return fmt.Errorf("failed to save comment: %w", err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.mentions.SyncComment(ctx, comment); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &UpdateCommentResp{
+		ID:        comment.ID,
+		PostSlug:  req.Slug,
+		Body:      comment.Body,
+		Edited:    comment.IsEdited(),
+		EditedAt:  comment.EditedAt,
+		CreatedAt: comment.CreatedAt,
+	}
+
+	// Held comments are not in the public thread yet
+	if comment.IsApproved() {
+		err = s.realtime.Publish(domain.RealtimeEvent{
+			Topic: domain.PostTopic(post.ID),
+			Type:  domain.RealtimeCommentUpdated,
+			Data:  resp,
+		})
+		if err != nil {
+			log.Printf("failed to publish comment %s: %v", comment.ID, err)
+		}
+	}
+
+	return resp, nil
+}
@@ -13,6 +13,7 @@ type UpdatePost struct {
 	postDAO              dao.PostDAO
 	postContentGenerator domain.PostContentGenerator
 	eventBus             domain.EventBus
+	mentions             *MentionTracker
 }
 
 type UpdatePostReq struct {
@@ -37,11 +38,12 @@ type UpdatePostResp struct {
 	UpdatedAt   time.Time  `json:"updated_at"`
 }
 
-func NewUpdatePost(postDAO dao.PostDAO, postContentGenerator domain.PostContentGenerator, eventBus domain.EventBus) *UpdatePost {
+func NewUpdatePost(postDAO dao.PostDAO, postContentGenerator domain.PostContentGenerator, eventBus domain.EventBus, mentions *MentionTracker) *UpdatePost {
 	return &UpdatePost{
 		postDAO:              postDAO,
 		postContentGenerator: postContentGenerator,
 		eventBus:             eventBus,
+		mentions:             mentions,
 	}
 }
 
@@ -86,6 +88,10 @@ func (s *UpdatePost) Exec(ctx context.Context, req *UpdatePostReq) (*UpdatePostR
 		return nil, fmt.Errorf("failed to save post: %w", err)
 	}
 
+	if err := s.mentions.SyncPost(ctx, post); err != nil {
+		return nil, err
+	}
+
 	err = s.eventBus.ProcessEvents([]any{
 		&domain.PostUpdated{
 			PostID: post.ID,
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type UpdateProfile struct {
+	userDAO dao.UserDAO
+}
+
+// UpdateProfileReq replaces the whole profile. The handle is changed with
+// ChangeHandle.
+type UpdateProfileReq struct {
+	UserID      string   `json:"-"`
+	DisplayName string   `json:"display_name"`
+	Bio         string   `json:"bio"`
+	Website     string   `json:"website"`
+	Location    string   `json:"location"`
+	SocialLinks []string `json:"social_links"`
+}
+
+// UserProfile is the public profile of a user. Bio is Markdown.
+type UserProfile struct {
+	ID          string   `json:"id"`
+	Handle      string   `json:"handle"`
+	Username    string   `json:"username"`
+	DisplayName string   `json:"display_name"`
+	Bio         string   `json:"bio"`
+	AvatarURL   string   `json:"avatar_url"`
+	Website     string   `json:"website"`
+	Location    string   `json:"location"`
+	SocialLinks []string `json:"social_links"`
+}
+
+func NewUpdateProfile(userDAO dao.UserDAO) *UpdateProfile {
+	return &UpdateProfile{
+		userDAO: userDAO,
+	}
+}
+
+func (s *UpdateProfile) Exec(ctx context.Context, req *UpdateProfileReq) (*UserProfile, error) {
+	user, err := s.userDAO.FindByPk(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	err = user.UpdateProfile(domain.Profile{
+		DisplayName: req.DisplayName,
+		Bio:         req.Bio,
+		Website:     req.Website,
+		Location:    req.Location,
+		SocialLinks: req.SocialLinks,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update profile: %w", err)
+	}
+
+	if err := s.userDAO.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to save profile: %w", err)
+	}
+
+	profile := newUserProfile(user)
+	return &profile, nil
+}
+
+func newUserProfile(user *domain.User) UserProfile {
+	return UserProfile{
+		ID:          user.ID,
+		Handle:      user.Handle,
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		Bio:         user.Bio,
+		AvatarURL:   user.AvatarURL,
+		Website:     user.Website,
+		Location:    user.Location,
+		SocialLinks: user.ItsSocialLinks(),
+	}
+}
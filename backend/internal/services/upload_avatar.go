@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+type UploadAvatar struct {
+	userDAO    dao.UserDAO
+	avatarDAO  dao.AvatarDAO
+	apiBaseURI string
+}
+
+type UploadAvatarReq struct {
+	UserID string
+	Data   []byte
+}
+
+func NewUploadAvatar(userDAO dao.UserDAO, avatarDAO dao.AvatarDAO, apiBaseURI string) *UploadAvatar {
+	return &UploadAvatar{
+		userDAO:    userDAO,
+		avatarDAO:  avatarDAO,
+		apiBaseURI: apiBaseURI,
+	}
+}
+
+// Exec stores the avatar and points the profile at it. The URL changes with
+// every upload, so it can be cached for long.
+func (s *UploadAvatar) Exec(ctx context.Context, req *UploadAvatarReq) (*UserProfile, error) {
+	user, err := s.userDAO.FindByPk(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	avatar, err := domain.NewAvatar(user.ID, req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload avatar: %w", err)
+	}
+
+	user.AvatarURL = fmt.Sprintf("%s/api/v1/users/%s/avatar?v=%d", s.apiBaseURI, user.ID, avatar.UpdatedAt.Unix())
+
+	err = s.avatarDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.avatarDAO.FindByPk(ctx, user.ID); err == nil {
+			err = s.avatarDAO.Update(ctx, avatar)
+		} else {
+			err = s.avatarDAO.Create(ctx, avatar)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to save avatar: %w", err)
+		}
+
+		if err := s.userDAO.PartialUpdate(ctx, user.ID, map[string]interface{}{
+			"avatar_url": user.AvatarURL,
+		}); err != nil {
+			return fmt.Errorf("failed to save profile: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	profile := newUserProfile(user)
+	return &profile, nil
+}
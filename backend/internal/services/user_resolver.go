@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"blog0/internal/domain"
+	"blog0/internal/domain/dao"
+)
+
+// UserResolver finds the user an URL refers to, either by ID or by handle
+// (@jane). Previous handles still lead to the user until their alias
+// expires, callers tell by comparing the handle they asked for.
+type UserResolver struct {
+	userDAO  dao.UserDAO
+	aliasDAO dao.HandleAliasDAO
+}
+
+func NewUserResolver(userDAO dao.UserDAO, aliasDAO dao.HandleAliasDAO) *UserResolver {
+	return &UserResolver{
+		userDAO:  userDAO,
+		aliasDAO: aliasDAO,
+	}
+}
+
+func (r *UserResolver) Resolve(ctx context.Context, ref string) (*domain.User, error) {
+	if !domain.IsHandleRef(ref) {
+		return r.userDAO.FindOne(ctx, "id::text = $1", "", ref)
+	}
+
+	handle, err := domain.ParseHandle(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.userDAO.FindOne(ctx, "handle = $1", "", handle)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	alias, err := r.aliasDAO.FindOne(ctx, "handle = $1 AND expires_at > NOW()", "", handle)
+	if err != nil {
+		return nil, fmt.Errorf("no user with handle %s: %w", handle, err)
+	}
+
+	return r.userDAO.FindByPk(ctx, alias.UserID)
+}
@@ -1,102 +1,478 @@
 package server
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
 	"golang.org/x/oauth2/google"
 
 	"blog0/config"
+	"blog0/internal/domain"
 	"blog0/internal/infra/handlers"
 	"blog0/internal/infra/middlewares"
+	"blog0/internal/infra/oauth"
+	"blog0/internal/infra/persistence/pgcustom"
 	"blog0/internal/infra/persistence/postgres"
 	infraServices "blog0/internal/infra/services"
 	"blog0/internal/services"
 )
 
+// tokenScopes lists the writes personal access tokens can make, with the
+// scope each one needs. Every other write is reserved to the web app.
+var tokenScopes = map[string]string{
+	"POST /api/v1/me/posts":                   domain.ScopeWritePosts,
+	"PUT /api/v1/me/posts/:slug":              domain.ScopeWritePosts,
+	"DELETE /api/v1/me/posts/:slug":           domain.ScopeWritePosts,
+	"POST /api/v1/posts/:slug/comments":       domain.ScopeWriteComments,
+	"PUT /api/v1/posts/:slug/comments/:id":    domain.ScopeWriteComments,
+	"DELETE /api/v1/posts/:slug/comments/:id": domain.ScopeWriteComments,
+}
+
 func New(cfg config.Config, db *sql.DB) *gin.Engine {
 	router := gin.Default()
 	router.Use(middlewares.UseCORS())
 
-	googleOAuthConfig := &oauth2.Config{
-		RedirectURL:  fmt.Sprintf("%s/api/v1/auth/google/callback", cfg.APIBaseURI),
-		ClientID:     cfg.GoogleClientID,
-		ClientSecret: cfg.GoogleClientSecret,
-		Scopes:       []string{"https://www.googleapis.com/auth/userinfo.email", "https://www.googleapis.com/auth/userinfo.profile"},
-		Endpoint:     google.Endpoint,
-	}
-
 	postDAO := postgres.NewPostDAO(db)
 	userDAO := postgres.NewUserDAO(db)
 	commentDAO := postgres.NewCommentDAO(db)
-	postLikeDAO := postgres.NewPostLikeDAO(db)
 	bookmarkDAO := postgres.NewBookmarkDAO(db)
 	followDAO := postgres.NewFollowDAO(db)
+	notificationDAO := postgres.NewNotificationDAO(db)
+	commentRevisionDAO := postgres.NewCommentRevisionDAO(db)
+	reactionDAO := postgres.NewReactionDAO(db)
+	mentionDAO := postgres.NewMentionDAO(db)
+	blockDAO := postgres.NewBlockDAO(db)
+	muteDAO := postgres.NewMuteDAO(db)
+	reportDAO := postgres.NewReportDAO(db)
+	adminActionDAO := postgres.NewAdminActionDAO(db)
+	sessionDAO := postgres.NewSessionDAO(db)
+	oauthCodeDAO := postgres.NewOAuthCodeDAO(db)
+	identityDAO := postgres.NewIdentityDAO(db)
+	personalAccessTokenDAO := postgres.NewPersonalAccessTokenDAO(db)
+	processorClientDAO := postgres.NewProcessorClientDAO(db)
+	processorAuditLogDAO := postgres.NewProcessorAuditLogDAO(db)
+	avatarDAO := postgres.NewAvatarDAO(db)
+	handleAliasDAO := postgres.NewHandleAliasDAO(db)
+	relationDAO := pgcustom.NewRelationDAO(db)
+	notificationGroupDAO := pgcustom.NewNotificationGroupDAO(db)
+	reactionTallyDAO := pgcustom.NewReactionTallyDAO(db)
+	sessionRotationDAO := pgcustom.NewSessionRotationDAO(db)
+	reportFilingDAO := pgcustom.NewReportDAO(db)
+	oauthCodeConsumer := pgcustom.NewOAuthCodeDAO(db)
+	identityLockDAO := pgcustom.NewIdentityDAO(db)
+	bookmarkCollectionDAO := postgres.NewBookmarkCollectionDAO(db)
+	activityDAO := pgcustom.NewActivityDAO(db)
+	rankingDAO := pgcustom.NewRankingDAO(db)
 
 	postContentGenerator := infraServices.NewOpenAIGenerator(cfg.OpenAIApiKey, "gpt-4o")
 	nextIDFunc := uuid.NewString
 	triggerDev := infraServices.NewTriggerDev(cfg.TriggerSecretKey)
 	eventBus := infraServices.NewTriggerDevEventBus(triggerDev)
+	realtimeHub := infraServices.NewPostgresRealtimeHub(db, cfg.PostgresURI)
+	notifier := services.NewNotifier(notificationDAO, notificationGroupDAO, realtimeHub, nextIDFunc)
+	commentModerator := newCommentModerator(cfg, commentDAO)
+	mentionTracker := services.NewMentionTracker(userDAO, mentionDAO, relationDAO, blockDAO, notifier, nextIDFunc)
+	tokenIssuer := services.NewTokenIssuer(sessionDAO, sessionRotationDAO, cfg.JWTSecret, durationOr(cfg.AccessTokenTTL, 15*time.Minute), durationOr(cfg.RefreshTokenTTL, 30*24*time.Hour), nextIDFunc)
+	reactionCounter := services.NewReactionCounter(reactionDAO, reactionTallyDAO, domain.ParseReactionSet(cfg.Reactions))
+
+	oauthProviders := newOAuthProviders(cfg)
 
-	startOAuthServ := services.NewStartOAuth(googleOAuthConfig)
-	finishOAuthServ := services.NewFinishOAuth(userDAO, googleOAuthConfig, infraServices.GoogleInfoExtractor, nextIDFunc, cfg)
-	listPostsServ := services.NewListPosts(postDAO, userDAO, postLikeDAO, commentDAO)
-	getPostBySlugServ := services.NewGetPostBySlug(postDAO, userDAO, commentDAO, postLikeDAO)
-	createCommentServ := services.NewCreateComment(postDAO, userDAO, commentDAO, nextIDFunc)
-	toggleLikeServ := services.NewToggleLike(postDAO, postLikeDAO, nextIDFunc)
-	bookmarkPostServ := services.NewBookmarkPost(postDAO, bookmarkDAO, nextIDFunc)
+	startOAuthServ := services.NewStartOAuth(oauthProviders, cfg.JWTSecret)
+	handles := services.NewHandles(userDAO, handleAliasDAO)
+	finishOAuthServ := services.NewFinishOAuth(userDAO, identityDAO, oauthCodeDAO, handles, oauthProviders, nextIDFunc, cfg)
+	exchangeOAuthCodeServ := services.NewExchangeOAuthCode(userDAO, oauthCodeConsumer, tokenIssuer)
+	listOAuthProvidersServ := services.NewListOAuthProviders(oauthProviders)
+	listPostsServ := services.NewListPosts(postDAO, userDAO, commentDAO, reactionCounter)
+	listTrendingPostsServ := services.NewListTrendingPosts(rankingDAO)
+	getPostBySlugServ := services.NewGetPostBySlug(postDAO, userDAO, commentDAO, reactionCounter, mentionTracker, rankingDAO)
+	listCommentsServ := services.NewListComments(postDAO, userDAO, commentDAO, reactionCounter, mentionTracker)
+	createCommentServ := services.NewCreateComment(postDAO, userDAO, commentDAO, blockDAO, commentModerator, mentionTracker, notifier, realtimeHub, nextIDFunc)
+	updateCommentServ := services.NewUpdateComment(postDAO, commentDAO, commentRevisionDAO, mentionTracker, realtimeHub, nextIDFunc)
+	deleteCommentServ := services.NewDeleteComment(postDAO, commentDAO, commentRevisionDAO, realtimeHub)
+	getCommentHistoryServ := services.NewGetCommentHistory(postDAO, commentDAO, commentRevisionDAO)
+	toggleReactionServ := services.NewToggleReaction(postDAO, commentDAO, reactionDAO, relationDAO, blockDAO, reactionCounter, notifier, realtimeHub, nextIDFunc)
+	toggleLikeServ := services.NewToggleLike(toggleReactionServ)
+	setLikeServ := services.NewSetLike(toggleReactionServ)
+	listBookmarksServ := services.NewListBookmarks(bookmarkDAO, bookmarkCollectionDAO, activityDAO)
+	updateBookmarkServ := services.NewUpdateBookmark(bookmarkDAO, bookmarkCollectionDAO)
+	listBookmarkCollectionsServ := services.NewListBookmarkCollections(bookmarkCollectionDAO, bookmarkDAO)
+	createBookmarkCollectionServ := services.NewCreateBookmarkCollection(bookmarkCollectionDAO, nextIDFunc)
+	updateBookmarkCollectionServ := services.NewUpdateBookmarkCollection(bookmarkCollectionDAO, bookmarkDAO)
+	deleteBookmarkCollectionServ := services.NewDeleteBookmarkCollection(bookmarkCollectionDAO)
+	getBookmarkCollectionServ := services.NewGetBookmarkCollection(bookmarkCollectionDAO, bookmarkDAO, userDAO, activityDAO)
+	listReactionsServ := services.NewListReactions(reactionCounter)
+	bookmarkPostServ := services.NewBookmarkPost(postDAO, bookmarkDAO, relationDAO, nextIDFunc)
 	unbookmarkPostServ := services.NewUnbookmarkPost(postDAO, bookmarkDAO)
-	createPostServ := services.NewCreatePost(postDAO, nextIDFunc, postContentGenerator, eventBus)
-	updatePostServ := services.NewUpdatePost(postDAO, postContentGenerator, eventBus)
+	createPostServ := services.NewCreatePost(postDAO, nextIDFunc, postContentGenerator, eventBus, mentionTracker)
+	updatePostServ := services.NewUpdatePost(postDAO, postContentGenerator, eventBus, mentionTracker)
 	deletePostServ := services.NewDeletePost(postDAO)
 	listMyPostsServ := services.NewListMyPosts(postDAO, userDAO)
-	getAuthorInfoServ := services.NewGetAuthorInfo(userDAO, postDAO, postLikeDAO)
-	followUserServ := services.NewFollowUser(userDAO, followDAO, nextIDFunc)
+	userResolver := services.NewUserResolver(userDAO, handleAliasDAO)
+	getAuthorInfoServ := services.NewGetAuthorInfo(userResolver, postDAO, followDAO, rankingDAO)
+	listFollowsServ := services.NewListFollows(userResolver, userDAO, followDAO)
+	getAvatarServ := services.NewGetAvatar(avatarDAO)
+	followUserServ := services.NewFollowUser(userDAO, followDAO, relationDAO, blockDAO, notifier, nextIDFunc)
 	unfollowUserServ := services.NewUnfollowUser(userDAO, followDAO)
-	getProfileServ := services.NewGetProfile(userDAO, followDAO, bookmarkDAO, postLikeDAO, postDAO)
+	getProfileServ := services.NewGetProfile(userDAO, followDAO, bookmarkDAO, reactionDAO)
+	listLikedPostsServ := services.NewListLikedPosts(reactionDAO, activityDAO)
+	listFollowedUsersServ := services.NewListFollowedUsers(followDAO, activityDAO)
+	updateProfileServ := services.NewUpdateProfile(userDAO)
+	uploadAvatarServ := services.NewUploadAvatar(userDAO, avatarDAO, cfg.APIBaseURI)
+	removeAvatarServ := services.NewRemoveAvatar(userDAO, avatarDAO)
+	accountData := services.AccountData{
+		Users:                userDAO,
+		Posts:                postDAO,
+		Comments:             commentDAO,
+		CommentRevisions:     commentRevisionDAO,
+		Reactions:            reactionDAO,
+		Bookmarks:            bookmarkDAO,
+		BookmarkCollections:  bookmarkCollectionDAO,
+		Follows:              followDAO,
+		Notifications:        notificationDAO,
+		Mentions:             mentionDAO,
+		Blocks:               blockDAO,
+		Mutes:                muteDAO,
+		Reports:              reportDAO,
+		AdminActions:         adminActionDAO,
+		Sessions:             sessionDAO,
+		OAuthCodes:           oauthCodeDAO,
+		Identities:           identityDAO,
+		PersonalAccessTokens: personalAccessTokenDAO,
+		Avatars:              avatarDAO,
+		HandleAliases:        handleAliasDAO,
+	}
+	requestAccountDeletionServ := services.NewRequestAccountDeletion(userDAO, durationOr(cfg.AccountDeletionGrace, 14*24*time.Hour))
+	cancelAccountDeletionServ := services.NewCancelAccountDeletion(userDAO)
+	exportAccountDataServ := services.NewExportAccountData(accountData)
+	purgeDeletedAccountsServ := services.NewPurgeDeletedAccounts(accountData, deletionPolicy(cfg))
+	refreshRankingsServ := services.NewRefreshRankings(rankingDAO, domain.DefaultRankingWeights)
+	changeHandleServ := services.NewChangeHandle(userDAO, handleAliasDAO, handles, durationOr(cfg.HandleChangeCooldown, 30*24*time.Hour), durationOr(cfg.HandleAliasGrace, 90*24*time.Hour))
+	listFeedServ := services.NewListFeed(postDAO, userDAO, commentDAO, reactionCounter)
+	listNotificationsServ := services.NewListNotifications(notificationDAO, userDAO, postDAO, reportDAO)
+	markNotificationReadServ := services.NewMarkNotificationRead(notificationDAO)
+	markAllNotificationsReadServ := services.NewMarkAllNotificationsRead(notificationDAO)
+	subscribePostEventsServ := services.NewSubscribePostEvents(postDAO, realtimeHub)
+	subscribeNotificationsServ := services.NewSubscribeNotifications(realtimeHub)
+	issueStreamTicketServ := services.NewIssueStreamTicket(cfg.JWTSecret)
+	listModerationQueueServ := services.NewListModerationQueue(postDAO, userDAO, commentDAO)
+	moderateCommentServ := services.NewModerateComment(postDAO, userDAO, commentDAO, mentionTracker, notifier, realtimeHub)
+	listMentionsServ := services.NewListMentions(mentionDAO, userDAO, postDAO, commentDAO)
+	blockUserServ := services.NewBlockUser(userDAO, blockDAO, followDAO, relationDAO, nextIDFunc)
+	unblockUserServ := services.NewUnblockUser(blockDAO)
+	listBlocksServ := services.NewListBlocks(userDAO, blockDAO)
+	muteUserServ := services.NewMuteUser(userDAO, relationDAO, nextIDFunc)
+	unmuteUserServ := services.NewUnmuteUser(muteDAO)
+	listMutesServ := services.NewListMutes(userDAO, muteDAO)
+	createReportServ := services.NewCreateReport(postDAO, commentDAO, userDAO, reportDAO, reportFilingDAO, nextIDFunc)
+	listReportsServ := services.NewListReports(reportDAO, postDAO, commentDAO, userDAO)
+	resolveReportServ := services.NewResolveReport(reportDAO, adminActionDAO, postDAO, commentDAO, userDAO, sessionDAO, mentionTracker, notifier, realtimeHub, nextIDFunc)
+	listAdminActionsServ := services.NewListAdminActions(adminActionDAO, userDAO)
+	listUsersServ := services.NewListUsers(userDAO)
+	changeUserRoleServ := services.NewChangeUserRole(userDAO, adminActionDAO, nextIDFunc)
+	suspendUserServ := services.NewSuspendUser(userDAO, sessionDAO, adminActionDAO, nextIDFunc)
+	unsuspendUserServ := services.NewUnsuspendUser(userDAO, adminActionDAO, nextIDFunc)
+	deleteUserServ := services.NewDeleteUser(userDAO, adminActionDAO, nextIDFunc)
+	refreshSessionServ := services.NewRefreshSession(userDAO, sessionDAO, tokenIssuer)
+	logoutServ := services.NewLogout(sessionDAO)
+	listSessionsServ := services.NewListSessions(sessionDAO)
+	revokeSessionServ := services.NewRevokeSession(sessionDAO)
+	listIdentitiesServ := services.NewListIdentities(identityDAO, oauthProviders)
+	startLinkIdentityServ := services.NewStartLinkIdentity(identityDAO, oauthProviders, cfg.JWTSecret, cfg.APIBaseURI)
+	unlinkIdentityServ := services.NewUnlinkIdentity(identityDAO, identityLockDAO)
+	createPersonalAccessTokenServ := services.NewCreatePersonalAccessToken(personalAccessTokenDAO, nextIDFunc)
+	listPersonalAccessTokensServ := services.NewListPersonalAccessTokens(personalAccessTokenDAO)
+	revokePersonalAccessTokenServ := services.NewRevokePersonalAccessToken(personalAccessTokenDAO)
+	processorAudit := services.NewProcessorAudit(postDAO, processorAuditLogDAO, nextIDFunc)
+	processorCreatePostServ := services.NewProcessorCreatePost(createPostServ, processorAudit)
+	processorUpdatePostServ := services.NewProcessorUpdatePost(updatePostServ, processorAudit)
+	processorPublishPostServ := services.NewProcessorPublishPost(updatePostServ, processorAudit)
+	processorSetPostAudioServ := services.NewProcessorSetPostAudio(postDAO, processorAudit)
+	processorPurgeAccountsServ := services.NewProcessorPurgeAccounts(purgeDeletedAccountsServ, processorAudit)
+	processorRefreshRankingsServ := services.NewProcessorRefreshRankings(refreshRankingsServ, processorAudit)
+	createProcessorClientServ := services.NewCreateProcessorClient(processorClientDAO, userDAO, adminActionDAO, nextIDFunc)
+	listProcessorClientsServ := services.NewListProcessorClients(processorClientDAO)
+	disableProcessorClientServ := services.NewDisableProcessorClient(processorClientDAO, adminActionDAO, nextIDFunc)
+	listProcessorAuditLogsServ := services.NewListProcessorAuditLogs(processorAuditLogDAO)
+
+	authenticator := middlewares.NewAuthenticator(cfg.JWTSecret, sessionDAO, personalAccessTokenDAO, userDAO)
 
 	api := router.Group("/api/v1")
+	api.Use(middlewares.MayHaveAuthorization(authenticator))
 	{
-		api.GET("/auth/google", handlers.StartOAuth(startOAuthServ))
-		api.GET("/auth/google/callback", handlers.OAuthCallback(finishOAuthServ))
+		api.GET("/auth/providers", handlers.ListOAuthProviders(listOAuthProvidersServ))
+		api.GET("/auth/:provider", handlers.StartOAuth(startOAuthServ))
+		api.GET("/auth/:provider/callback", handlers.OAuthCallback(finishOAuthServ))
+		api.POST("/auth/exchange", handlers.ExchangeOAuthCode(exchangeOAuthCodeServ))
+		api.POST("/auth/refresh", handlers.RefreshSession(refreshSessionServ))
 
+		api.GET("/reactions", handlers.ListReactions(listReactionsServ))
 		api.GET("/posts", handlers.ListPosts(listPostsServ))
+		api.GET("/posts/trending", handlers.ListTrendingPosts(listTrendingPostsServ))
 		api.GET("/posts/:slug", handlers.GetPostBySlug(getPostBySlugServ))
+		api.GET("/posts/:slug/comments", handlers.ListComments(listCommentsServ))
+		api.GET("/posts/:slug/comments/:id/history", handlers.GetCommentHistory(getCommentHistoryServ))
+		api.GET("/posts/:slug/stream", handlers.StreamPostEvents(subscribePostEventsServ))
 		api.GET("/users/:author_id", handlers.GetAuthorInfo(getAuthorInfoServ))
+		api.GET("/users/:author_id/avatar", handlers.GetAvatar(getAvatarServ))
+		api.GET("/users/:author_id/followers", handlers.ListFollowers(listFollowsServ))
+		api.GET("/users/:author_id/following", handlers.ListFollowing(listFollowsServ))
+		api.GET("/collections/:id", handlers.GetBookmarkCollection(getBookmarkCollectionServ))
+		api.GET("/me/stream", middlewares.HasStreamAuthorization(authenticator), handlers.StreamNotifications(subscribeNotificationsServ))
 
-		api.Use(middlewares.HasAuthorization(cfg.JWTSecret))
+		api.Use(middlewares.HasAuthorization(authenticator))
+		api.Use(middlewares.EnforceTokenScopes(tokenScopes))
+		api.POST("/auth/logout", handlers.Logout(logoutServ))
+		api.Use(middlewares.RejectSuspendedWrites(userDAO))
 		{
 			// User-specific endpoints (my content)
 			api.GET("/me/profile", handlers.GetProfile(getProfileServ))
-			api.POST("/me/posts", handlers.CreatePost(createPostServ))
-			api.PUT("/me/posts/:slug", handlers.UpdatePost(updatePostServ))
+			api.PUT("/me/profile", handlers.UpdateProfile(updateProfileServ))
+			api.PUT("/me/profile/avatar", handlers.UploadAvatar(uploadAvatarServ))
+			api.DELETE("/me/profile/avatar", handlers.RemoveAvatar(removeAvatarServ))
+			api.PUT("/me/handle", handlers.ChangeHandle(changeHandleServ))
+			api.DELETE("/me", handlers.RequestAccountDeletion(requestAccountDeletionServ))
+			api.POST("/me/deletion/cancel", handlers.CancelAccountDeletion(cancelAccountDeletionServ))
+			api.GET("/me/data-export", handlers.ExportAccountData(exportAccountDataServ))
+			api.GET("/me/sessions", handlers.ListSessions(listSessionsServ))
+			api.DELETE("/me/sessions/:id", handlers.RevokeSession(revokeSessionServ))
+			api.GET("/me/tokens", handlers.ListPersonalAccessTokens(listPersonalAccessTokensServ))
+			api.POST("/me/tokens", handlers.CreatePersonalAccessToken(createPersonalAccessTokenServ))
+			api.DELETE("/me/tokens/:id", handlers.RevokePersonalAccessToken(revokePersonalAccessTokenServ))
+			api.GET("/me/identities", handlers.ListIdentities(listIdentitiesServ))
+			api.POST("/me/identities/:provider", handlers.LinkIdentity(startLinkIdentityServ))
+			api.DELETE("/me/identities/:provider", handlers.UnlinkIdentity(unlinkIdentityServ))
+			api.GET("/me/following", handlers.ListFollowedUsers(listFollowedUsersServ))
+			api.GET("/me/likes", handlers.ListLikedPosts(listLikedPostsServ))
+			api.GET("/me/bookmarks", handlers.ListBookmarks(listBookmarksServ))
+			api.PUT("/me/bookmarks/:id", handlers.UpdateBookmark(updateBookmarkServ))
+			api.GET("/me/collections", handlers.ListBookmarkCollections(listBookmarkCollectionsServ))
+			api.POST("/me/collections", handlers.CreateBookmarkCollection(createBookmarkCollectionServ))
+			api.PUT("/me/collections/:id", handlers.UpdateBookmarkCollection(updateBookmarkCollectionServ))
+			api.DELETE("/me/collections/:id", handlers.DeleteBookmarkCollection(deleteBookmarkCollectionServ))
+			api.GET("/me/feed", handlers.ListFeed(listFeedServ))
+			api.GET("/me/notifications", handlers.ListNotifications(listNotificationsServ))
+			api.POST("/me/notifications/read-all", handlers.MarkAllNotificationsRead(markAllNotificationsReadServ))
+			api.POST("/me/notifications/:id/read", handlers.MarkNotificationRead(markNotificationReadServ))
+			api.POST("/me/stream/ticket", handlers.IssueStreamTicket(issueStreamTicketServ))
+			api.GET("/me/mentions", handlers.ListMentions(listMentionsServ))
+			api.GET("/me/moderation", handlers.ListModerationQueue(listModerationQueueServ))
+			api.POST("/me/moderation/:id/approve", handlers.ApproveComment(moderateCommentServ))
+			api.POST("/me/moderation/:id/reject", handlers.RejectComment(moderateCommentServ))
+			api.GET("/me/blocks", handlers.ListBlocks(listBlocksServ))
+			api.POST("/me/blocks/:user_id", handlers.BlockUser(blockUserServ))
+			api.DELETE("/me/blocks/:user_id", handlers.UnblockUser(unblockUserServ))
+			api.GET("/me/mutes", handlers.ListMutes(listMutesServ))
+			api.POST("/me/mutes/:user_id", handlers.MuteUser(muteUserServ))
+			api.DELETE("/me/mutes/:user_id", handlers.UnmuteUser(unmuteUserServ))
+			api.POST("/me/posts", middlewares.RequireRole(domain.RoleAuthor), handlers.CreatePost(createPostServ))
+			api.PUT("/me/posts/:slug", middlewares.RequireRole(domain.RoleAuthor), handlers.UpdatePost(updatePostServ))
 			api.DELETE("/me/posts/:slug", handlers.DeletePost(deletePostServ))
 			api.GET("/me/posts", handlers.ListMyPosts(listMyPostsServ))
 
 			// Post interactions
 			api.POST("/posts/:slug/comments", handlers.CreateComment(createCommentServ))
+			api.PUT("/posts/:slug/comments/:id", handlers.UpdateComment(updateCommentServ))
+			api.DELETE("/posts/:slug/comments/:id", handlers.DeleteComment(deleteCommentServ))
+			api.POST("/posts/:slug/comments/:id/reactions/:emoji", handlers.ToggleCommentReaction(toggleReactionServ))
 			api.POST("/posts/:slug/likes", handlers.ToggleLike(toggleLikeServ))
+			api.PUT("/posts/:slug/likes", handlers.LikePost(setLikeServ))
+			api.DELETE("/posts/:slug/likes", handlers.UnlikePost(setLikeServ))
+			api.POST("/posts/:slug/reactions/:emoji", handlers.TogglePostReaction(toggleReactionServ))
+			api.PUT("/posts/:slug/bookmarks", handlers.BookmarkPost(bookmarkPostServ))
 			api.POST("/posts/:slug/bookmarks", handlers.BookmarkPost(bookmarkPostServ))
 			api.DELETE("/posts/:slug/bookmarks", handlers.UnbookmarkPost(unbookmarkPostServ))
 
 			// User interactions
+			api.PUT("/users/:author_id/follow", handlers.FollowUser(followUserServ))
 			api.POST("/users/:author_id/follow", handlers.FollowUser(followUserServ))
 			api.DELETE("/users/:author_id/follow", handlers.UnfollowUser(unfollowUserServ))
+
+			// Reports
+			api.POST("/reports", handlers.CreateReport(createReportServ))
+
+			// Editors triage reports, admins also suspend and manage users
+			admin := api.Group("/admin")
+			admin.Use(middlewares.RequireRole(domain.RoleEditor))
+			{
+				admin.GET("/reports", handlers.ListReports(listReportsServ))
+				admin.POST("/reports/:id/dismiss", handlers.DismissReport(resolveReportServ))
+				admin.POST("/reports/:id/hide", handlers.HideReportedContent(resolveReportServ))
+
+				adminOnly := admin.Group("")
+				adminOnly.Use(middlewares.RequireRole(domain.RoleAdmin))
+				{
+					adminOnly.POST("/reports/:id/suspend", handlers.SuspendReportedUser(resolveReportServ))
+					adminOnly.GET("/actions", handlers.ListAdminActions(listAdminActionsServ))
+					adminOnly.GET("/users", handlers.ListUsers(listUsersServ))
+					adminOnly.PUT("/users/:user_id/role", handlers.ChangeUserRole(changeUserRoleServ))
+					adminOnly.POST("/users/:user_id/suspension", handlers.SuspendUser(suspendUserServ))
+					adminOnly.DELETE("/users/:user_id/suspension", handlers.UnsuspendUser(unsuspendUserServ))
+					adminOnly.DELETE("/users/:user_id", handlers.DeleteUser(deleteUserServ))
+					adminOnly.GET("/processor-clients", handlers.ListProcessorClients(listProcessorClientsServ))
+					adminOnly.POST("/processor-clients", handlers.CreateProcessorClient(createProcessorClientServ))
+					adminOnly.DELETE("/processor-clients/:id", handlers.DisableProcessorClient(disableProcessorClientServ))
+					adminOnly.GET("/processor-clients/:id/audit", handlers.ListProcessorAuditLogs(listProcessorAuditLogsServ))
+				}
+			}
 		}
 	}
 
 	processor := router.Group("/api/p/v1")
-	processor.Use(middlewares.HasProcessorAuthorization(cfg.ProcessorSecret, cfg.ProcessorUserID))
+	processor.Use(middlewares.HasProcessorAuthorization(processorClientDAO, cfg.ProcessorSecret, cfg.ProcessorUserID))
 	{
-		processor.POST("/posts", handlers.CreatePost(createPostServ))
+		processor.POST("/posts", handlers.ProcessorCreatePost(processorCreatePostServ))
+		processor.PUT("/posts/:slug", handlers.ProcessorUpdatePost(processorUpdatePostServ))
+		processor.POST("/posts/:slug/publish", handlers.ProcessorPublishPost(processorPublishPostServ))
+		processor.DELETE("/posts/:slug/publish", handlers.ProcessorUnpublishPost(processorPublishPostServ))
+		processor.PUT("/posts/:slug/audio", handlers.ProcessorSetPostAudio(processorSetPostAudioServ))
+		processor.POST("/jobs/purge-accounts", handlers.ProcessorPurgeAccounts(processorPurgeAccountsServ))
+		processor.POST("/jobs/refresh-rankings", handlers.ProcessorRefreshRankings(processorRefreshRankingsServ))
 	}
 
 	router.GET("/api/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	return router
 }
+
+// newCommentModerator builds the comment moderation pipeline from the
+// MODERATION_* settings. Links are capped at 2 unless configured otherwise.
+func newCommentModerator(cfg config.Config, commentDAO *postgres.CommentDAO) domain.CommentModerator {
+	maxLinks := 2
+	if parsed, err := strconv.Atoi(cfg.ModerationMaxLinks); err == nil && parsed >= 0 {
+		maxLinks = parsed
+	}
+
+	moderators := []domain.CommentModerator{
+		services.NewLinkCountModerator(maxLinks),
+		services.NewBlockedWordsModerator(strings.Split(cfg.ModerationBlockedWords, ",")),
+	}
+
+	if holdFirst, _ := strconv.ParseBool(cfg.ModerationHoldFirstComments); holdFirst {
+		moderators = append(moderators, services.NewFirstTimeCommenterModerator(commentDAO))
+	}
+
+	if cfg.ModerationClassifier == "openai" {
+		classifier := infraServices.NewOpenAICommentClassifier(cfg.OpenAIApiKey, "gpt-4o-mini")
+		moderators = append(moderators, services.NewClassifierModerator(classifier))
+	}
+
+	return services.NewModerationPipeline(moderators...)
+}
+
+// newOAuthProviders registers the sign in providers that have credentials
+// configured. The OIDC one is discovered from its issuer on startup and left
+// out when the issuer can't be reached.
+func newOAuthProviders(cfg config.Config) *services.OAuthProviders {
+	redirectURL := func(name string) string {
+		return fmt.Sprintf("%s/api/v1/auth/%s/callback", cfg.APIBaseURI, name)
+	}
+
+	providers := make([]*services.OAuthProvider, 0)
+
+	if cfg.GoogleClientID != "" {
+		providers = append(providers, &services.OAuthProvider{
+			Name: "google",
+			Config: &oauth2.Config{
+				RedirectURL:  redirectURL("google"),
+				ClientID:     cfg.GoogleClientID,
+				ClientSecret: cfg.GoogleClientSecret,
+				Scopes:       []string{"https://www.googleapis.com/auth/userinfo.email", "https://www.googleapis.com/auth/userinfo.profile"},
+				Endpoint:     google.Endpoint,
+			},
+			InfoExtractor: oauth.GoogleInfoExtractor,
+		})
+	}
+
+	if cfg.GitHubClientID != "" {
+		providers = append(providers, &services.OAuthProvider{
+			Name: "github",
+			Config: &oauth2.Config{
+				RedirectURL:  redirectURL("github"),
+				ClientID:     cfg.GitHubClientID,
+				ClientSecret: cfg.GitHubClientSecret,
+				Scopes:       []string{"read:user", "user:email"},
+				Endpoint:     github.Endpoint,
+			},
+			InfoExtractor: oauth.NewGitHubInfoExtractor(stringOr(cfg.GitHubAPIBaseURL, "https://api.github.com")),
+		})
+	}
+
+	if cfg.GitLabClientID != "" {
+		baseURL := stringOr(cfg.GitLabBaseURL, "https://gitlab.com")
+		providers = append(providers, &services.OAuthProvider{
+			Name: "gitlab",
+			Config: &oauth2.Config{
+				RedirectURL:  redirectURL("gitlab"),
+				ClientID:     cfg.GitLabClientID,
+				ClientSecret: cfg.GitLabClientSecret,
+				Scopes:       []string{"read_user"},
+				Endpoint:     oauth.GitLabEndpoint(baseURL),
+			},
+			InfoExtractor: oauth.NewGitLabInfoExtractor(baseURL),
+		})
+	}
+
+	if cfg.OIDCIssuer != "" {
+		name := stringOr(cfg.OIDCName, "oidc")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		discovery, err := oauth.DiscoverOIDC(ctx, cfg.OIDCIssuer)
+		if err != nil {
+			log.Printf("skipping %s sign in: %v", name, err)
+		} else {
+			providers = append(providers, &services.OAuthProvider{
+				Name: name,
+				Config: &oauth2.Config{
+					RedirectURL:  redirectURL(name),
+					ClientID:     cfg.OIDCClientID,
+					ClientSecret: cfg.OIDCClientSecret,
+					Scopes:       []string{"openid", "email", "profile"},
+					Endpoint:     discovery.Endpoint(),
+				},
+				InfoExtractor: discovery.InfoExtractor(),
+			})
+		}
+	}
+
+	return services.NewOAuthProviders(providers...)
+}
+
+func stringOr(value string, fallback string) string {
+	if value != "" {
+		return value
+	}
+	return fallback
+}
+
+// deletionPolicy reads ACCOUNT_DELETION_POLICY. A policy that doesn't parse
+// falls back to the default one rather than removing more than meant.
+func deletionPolicy(cfg config.Config) domain.DeletionPolicy {
+	policy, err := domain.ParseDeletionPolicy(cfg.AccountDeletionPolicy)
+	if err != nil {
+		log.Printf("using the default account deletion policy: %v", err)
+		return domain.DefaultDeletionPolicy()
+	}
+	return policy
+}
+
+// durationOr parses a duration setting such as "15m", falling back when it is
+// empty or invalid.
+func durationOr(value string, fallback time.Duration) time.Duration {
+	if parsed, err := time.ParseDuration(value); err == nil && parsed > 0 {
+		return parsed
+	}
+	return fallback
+}
@@ -22,9 +22,41 @@ export interface CreatePostResp {
   updated_at: string;
 }
 
+export interface UpdatePostReq {
+  raw_markdown?: string;
+  slug?: string;
+  title?: string;
+}
+
+export type UpdatePostResp = CreatePostResp;
+
+export interface SetPostAudioReq {
+  raw_markdown_audio_url?: string;
+  summary_audio_url?: string;
+}
+
+export interface SetPostAudioResp {
+  id: string;
+  slug: string;
+  raw_markdown_audio_url?: string;
+  summary_audio_url?: string;
+}
+
+export interface PurgeAccountsResp {
+  purged: number;
+  failed: number;
+}
+
+export interface RefreshRankingsResp {
+  ranked: Record<string, number>;
+  pruned_views: number;
+}
+
 export interface ApiClientConfig {
   baseUrl?: string;
   apiToken?: string;
+  clientId?: string;
+  clientSecret?: string;
   defaultHeaders?: Record<string, string>;
   onTokenExpired?: () => void;
 }
@@ -45,11 +77,15 @@ export class ApiError extends Error {
 export class Blog0ApiClient {
   private baseUrl: string;
   private apiToken?: string;
+  private clientId?: string;
+  private clientSecret?: string;
   private defaultHeaders: Record<string, string>;
 
   constructor(config: ApiClientConfig = {}) {
     this.baseUrl = config.baseUrl || "https://blog0-backend.vercel.app";
     this.apiToken = config.apiToken;
+    this.clientId = config.clientId;
+    this.clientSecret = config.clientSecret;
     this.defaultHeaders = {
       "Content-Type": "application/json",
       ...config.defaultHeaders,
@@ -70,7 +106,12 @@ export class Blog0ApiClient {
       ...(options.headers as Record<string, string>),
     };
 
-    if (this.apiToken) {
+    if (this.clientId && this.clientSecret) {
+      const credentials = Buffer.from(
+        `${this.clientId}:${this.clientSecret}`
+      ).toString("base64");
+      headers.Authorization = `Basic ${credentials}`;
+    } else if (this.apiToken) {
       headers.Authorization = `${this.apiToken}`;
     }
 
@@ -98,6 +139,44 @@ export class Blog0ApiClient {
       body: JSON.stringify(post),
     });
   }
+
+  async updatePost(slug: string, post: UpdatePostReq): Promise<UpdatePostResp> {
+    return this.request<UpdatePostResp>(`/posts/${encodeURIComponent(slug)}`, {
+      method: "PUT",
+      body: JSON.stringify(post),
+    });
+  }
+
+  async publishPost(slug: string): Promise<UpdatePostResp> {
+    return this.request<UpdatePostResp>(
+      `/posts/${encodeURIComponent(slug)}/publish`,
+      { method: "POST" }
+    );
+  }
+
+  async unpublishPost(slug: string): Promise<UpdatePostResp> {
+    return this.request<UpdatePostResp>(
+      `/posts/${encodeURIComponent(slug)}/publish`,
+      { method: "DELETE" }
+    );
+  }
+
+  async runJob<T>(name: string): Promise<T> {
+    return this.request<T>(`/jobs/${name}`, { method: "POST" });
+  }
+
+  async setPostAudio(
+    slug: string,
+    audio: SetPostAudioReq
+  ): Promise<SetPostAudioResp> {
+    return this.request<SetPostAudioResp>(
+      `/posts/${encodeURIComponent(slug)}/audio`,
+      {
+        method: "PUT",
+        body: JSON.stringify(audio),
+      }
+    );
+  }
 }
 
 // Instancia global opcional
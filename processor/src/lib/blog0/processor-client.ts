@@ -0,0 +1,12 @@
+import Blog0ApiClient from "./api-client";
+
+// Registered clients use PROCESSOR_CLIENT_ID and PROCESSOR_CLIENT_SECRET,
+// PROCESSOR_PWD is the legacy shared secret.
+export function newApiClient(): Blog0ApiClient {
+  return new Blog0ApiClient({
+    baseUrl: process.env.BLOG0_API_URL,
+    clientId: process.env.PROCESSOR_CLIENT_ID,
+    clientSecret: process.env.PROCESSOR_CLIENT_SECRET,
+    apiToken: process.env.PROCESSOR_PWD,
+  });
+}
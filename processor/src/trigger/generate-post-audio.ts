@@ -2,6 +2,7 @@ import { logger, task } from "@trigger.dev/sdk/v3";
 import { db } from "../db/index";
 import { posts } from "../db/schema";
 import { eq } from "drizzle-orm";
+import { newApiClient } from "../lib/blog0/processor-client";
 import { getElevenLabsClient } from "../lib/elevenlabs/client";
 import { uploadStreamToUploadThing } from "../lib/uploadthing/utils";
 
@@ -30,6 +31,11 @@ export const generatePostAudioTask = task({
       throw new Error(`Post ${payload.postId} has no raw_markdown`);
     }
 
+    const slug = selectedPosts[0].slug;
+    if (!slug) {
+      throw new Error(`Post ${payload.postId} has no slug`);
+    }
+
     const rawMarkdownAudioUrl = await generateAudioUrl(
       selectedPosts[0].raw_markdown,
       selectedPosts[0].id,
@@ -41,13 +47,10 @@ export const generatePostAudioTask = task({
       `${selectedPosts[0].id}_summary}`
     );
 
-    await db
-      .update(posts)
-      .set({
-        raw_markdown: rawMarkdownAudioUrl,
-        summary_audio_url: summaryAudioUrl,
-      })
-      .where(eq(posts.id, payload.postId));
+    await newApiClient().setPostAudio(slug, {
+      raw_markdown_audio_url: rawMarkdownAudioUrl,
+      summary_audio_url: summaryAudioUrl,
+    });
 
     logger.log("generate post audio completed", {
       postId: payload.postId,
@@ -1,5 +1,5 @@
 import { logger, schedules } from "@trigger.dev/sdk/v3";
-import Blog0ApiClient from "../lib/blog0/api-client";
+import { newApiClient } from "../lib/blog0/processor-client";
 import { generateObject } from "ai";
 import z from "zod";
 import { openai } from "@ai-sdk/openai";
@@ -15,11 +15,7 @@ export const generatePostTask = schedules.task({
     try {
       const { title, slug, rawMarkdown } = await generateContent();
 
-      const authorizationValue = process.env.PROCESSOR_PWD;
-
-      const apiClient = new Blog0ApiClient({
-        apiToken: authorizationValue,
-      });
+      const apiClient = newApiClient();
 
       const post = await apiClient.createPost({
         raw_markdown: rawMarkdown,
@@ -0,0 +1,19 @@
+import { logger, schedules } from "@trigger.dev/sdk/v3";
+import type { PurgeAccountsResp } from "../lib/blog0/api-client";
+import { newApiClient } from "../lib/blog0/processor-client";
+
+export const purgeAccountsTask = schedules.task({
+  id: "purge-accounts",
+  maxDuration: 5 * 60, // 5 min
+  // cron every hour
+  cron: "0 * * * *",
+  run: async (payload) => {
+    logger.log("purge accounts started", { timestamp: payload.timestamp });
+
+    const result = await newApiClient().runJob<PurgeAccountsResp>(
+      "purge-accounts"
+    );
+
+    logger.log("purge accounts completed", { ...result });
+  },
+});
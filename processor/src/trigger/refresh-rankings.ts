@@ -0,0 +1,19 @@
+import { logger, schedules } from "@trigger.dev/sdk/v3";
+import type { RefreshRankingsResp } from "../lib/blog0/api-client";
+import { newApiClient } from "../lib/blog0/processor-client";
+
+export const refreshRankingsTask = schedules.task({
+  id: "refresh-rankings",
+  maxDuration: 5 * 60, // 5 min
+  // cron every 15 minutes
+  cron: "*/15 * * * *",
+  run: async (payload) => {
+    logger.log("refresh rankings started", { timestamp: payload.timestamp });
+
+    const result = await newApiClient().runJob<RefreshRankingsResp>(
+      "refresh-rankings"
+    );
+
+    logger.log("refresh rankings completed", { ...result });
+  },
+});
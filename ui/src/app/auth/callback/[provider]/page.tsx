@@ -1,7 +1,8 @@
 'use client';
 
 import { useParams, useRouter, useSearchParams } from 'next/navigation';
-import { useEffect, useState } from 'react';
+import { useEffect, useRef, useState } from 'react';
+import Blog0ApiClient from '@/lib/api-client';
 import { useAuthStore } from '@/store/authStore';
 import { useToast } from '@/components/ui/toast';
 
@@ -14,9 +15,16 @@ export default function AuthCallbackPage() {
 
   const [status, setStatus] = useState<'loading' | 'success' | 'error'>('loading');
   const [message, setMessage] = useState('Processing authentication...');
+  // The sign in code works once, don't exchange it again on re-renders
+  const exchanged = useRef(false);
 
   useEffect(() => {
-    const handleCallback = () => {
+    const handleCallback = async () => {
+      if (exchanged.current) {
+        return;
+      }
+      exchanged.current = true;
+
       try {
         const provider = params.provider as string;
 
@@ -26,40 +34,34 @@ export default function AuthCallbackPage() {
 
         setLoading(true);
 
-        // Check URL parameters for token or error (backend format: ?token=%s&id=%s&email=%s&username=%s)
-        const token = searchParams.get('token');
+        // The backend redirects with a one-time code (?code=%s) or an error
+        const code = searchParams.get('code');
         const error = searchParams.get('error');
-        const userId = searchParams.get('id');
-        const userName = searchParams.get('username');
-        const userEmail = searchParams.get('email');
 
         if (error) {
           throw new Error(error);
         }
 
-        if (token) {
-          // Set token in store
-          setToken(token);
-
-          // Set user if provided
-          if (userId && userName) {
-            setUser({
-              id: userId,
-              name: userName,
-              email: userEmail || undefined,
-            });
-          }
-
-          setStatus('success');
-          setMessage('Authentication successful! Redirecting...');
-
-          // Redirect to home page after a short delay
-          setTimeout(() => {
-            router.push('/');
-          }, 2000);
-        } else {
-          throw new Error('No authentication token received');
+        if (!code) {
+          throw new Error('No authentication code received');
         }
+
+        const resp = await new Blog0ApiClient().exchangeOAuthCode(code);
+
+        setToken(resp.access_token);
+        setUser({
+          id: resp.user.id,
+          name: resp.user.username,
+          email: resp.user.email || undefined,
+        });
+
+        setStatus('success');
+        setMessage('Authentication successful! Redirecting...');
+
+        // Redirect to home page after a short delay
+        setTimeout(() => {
+          router.push('/');
+        }, 2000);
       } catch (error) {
         console.error('Auth callback error:', error);
         const errorMessage = error instanceof Error ? error.message : 'Authentication failed';
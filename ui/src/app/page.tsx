@@ -319,7 +319,9 @@ export default function Home() {
 
     try {
       const apiClient = getApiClient();
-      const response = await apiClient.toggleLike(slug);
+      const response = isPostLiked(slug)
+        ? await apiClient.unlikePost(slug)
+        : await apiClient.likePost(slug);
       
       // Update profile state
       updatePostLike(slug, response.liked);
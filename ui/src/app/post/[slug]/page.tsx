@@ -25,6 +25,7 @@ import { useToast } from '@/components/ui/toast';
 import CommentForm from '@/components/CommentForm';
 import CommentItem from '@/components/CommentItem';
 import FollowButton from '@/components/FollowButton';
+import { linkMentions } from '@/lib/mentions';
 import ReactMarkdown from 'react-markdown';
 import remarkGfm from 'remark-gfm';
 import rehypeHighlight from 'rehype-highlight';
@@ -94,7 +95,9 @@ export default function PostPage() {
 
     try {
       const apiClient = getApiClient();
-      const response = await apiClient.toggleLike(post.slug);
+      const response = isLiked(post.slug)
+        ? await apiClient.unlikePost(post.slug)
+        : await apiClient.likePost(post.slug);
       
       // Update profile state
       updatePostLike(post.slug, response.liked);
@@ -329,14 +332,26 @@ export default function PostPage() {
                     p: ({ ...props }) => (
                       <p className="text-white mb-4 leading-relaxed" {...props} />
                     ),
-                    a: ({ ...props }) => (
-                      <a 
-                        className="text-[#25F4EE] hover:text-[#FE2C55] transition-colors underline" 
-                        target="_blank" 
-                        rel="noopener noreferrer" 
-                        {...props} 
-                      />
-                    ),
+                    a: ({ href, children, ...props }) =>
+                      href?.startsWith('/') ? (
+                        // Mentions link to profiles on this site
+                        <Link
+                          href={href}
+                          className="text-[#25F4EE] hover:text-[#FE2C55] transition-colors font-medium"
+                        >
+                          {children}
+                        </Link>
+                      ) : (
+                        <a 
+                          href={href}
+                          className="text-[#25F4EE] hover:text-[#FE2C55] transition-colors underline" 
+                          target="_blank" 
+                          rel="noopener noreferrer" 
+                          {...props} 
+                        >
+                          {children}
+                        </a>
+                      ),
                     code: ({ className, children, ...props }: { className?: string; children?: React.ReactNode; inline?: boolean }) => {
                       const isInline = !className?.includes('language-');
                       return isInline ? (
@@ -401,7 +416,7 @@ export default function PostPage() {
                     ),
                   }}
                 >
-                  {post.raw_markdown}
+                  {linkMentions(post.raw_markdown, post.mentions)}
                 </ReactMarkdown>
               </div>
             </div>
@@ -0,0 +1,136 @@
+'use client';
+
+import { ArrowLeft, Heart } from 'lucide-react';
+import { useParams, useRouter } from 'next/navigation';
+import Link from 'next/link';
+import { useCallback, useEffect, useState } from 'react';
+import { Avatar, AvatarFallback } from '@/components/ui/avatar';
+import { Button } from '@/components/ui/button';
+import FollowButton from '@/components/FollowButton';
+import { ApiError, type GetAuthorInfoResp } from '@/lib/api-client';
+import { useAuthStore } from '@/store/authStore';
+
+// Profiles are addressed by user ID or by @handle, mentions link to the latter.
+export default function UserPage() {
+  const params = useParams();
+  const router = useRouter();
+  const ref = decodeURIComponent(params.ref as string);
+
+  const [author, setAuthor] = useState<GetAuthorInfoResp | null>(null);
+  const [loading, setLoading] = useState(true);
+  const [error, setError] = useState<string | null>(null);
+
+  const { getApiClient } = useAuthStore();
+
+  const fetchAuthor = useCallback(async () => {
+    try {
+      setLoading(true);
+      setError(null);
+      const apiClient = getApiClient();
+      setAuthor(await apiClient.getAuthorInfo(ref));
+    } catch (err) {
+      setError(err instanceof ApiError ? err.message : 'Failed to load profile');
+    } finally {
+      setLoading(false);
+    }
+  }, [getApiClient, ref]);
+
+  useEffect(() => {
+    fetchAuthor();
+  }, [fetchAuthor]);
+
+  if (loading) {
+    return (
+      <div className="min-h-screen bg-black mesh-background flex items-center justify-center">
+        <div className="text-2xl accent-text font-bold">Loading profile...</div>
+      </div>
+    );
+  }
+
+  if (error || !author) {
+    return (
+      <div className="min-h-screen bg-black mesh-background flex items-center justify-center">
+        <div className="text-center space-y-4">
+          <div className="text-xl text-[#FE2C55]">Error: {error ?? 'Profile not found'}</div>
+          <Button
+            onClick={() => router.push('/')}
+            className="bg-[#FE2C55] text-white border-0 hover:bg-[#FE2C55]/80"
+          >
+            Back to Home
+          </Button>
+        </div>
+      </div>
+    );
+  }
+
+  return (
+    <div className="min-h-screen bg-black mesh-background">
+      {/* Header */}
+      <header className="sticky top-0 z-50 bg-black/80 backdrop-blur-sm border-b border-white/10">
+        <div className="max-w-3xl mx-auto px-4 h-16 flex items-center">
+          <Button
+            variant="ghost"
+            size="sm"
+            onClick={() => router.back()}
+            className="text-white hover:text-[#FE2C55] hover:bg-white/10"
+          >
+            <ArrowLeft className="h-4 w-4 mr-2" />
+            Back
+          </Button>
+        </div>
+      </header>
+
+      {/* Content */}
+      <div className="max-w-3xl mx-auto px-4 py-8 space-y-8">
+        <div className="flex items-start justify-between">
+          <div className="flex items-center space-x-4">
+            <Avatar className="w-16 h-16 border border-white/10">
+              <AvatarFallback className="bg-[#121212] text-[#AFAFAF] text-xl">
+                {author.name.charAt(0).toUpperCase()}
+              </AvatarFallback>
+            </Avatar>
+            <div>
+              <h1 className="text-white font-bold text-2xl">{author.name}</h1>
+              <div className="text-[#AFAFAF] text-sm">@{author.handle}</div>
+            </div>
+          </div>
+
+          <FollowButton
+            authorId={author.id}
+            authorName={author.name}
+            initialFollowing={author.is_following ?? false}
+            initialFollowersCount={author.followers_count}
+          />
+        </div>
+
+        {author.bio && <p className="text-white leading-relaxed">{author.bio}</p>}
+
+        <div className="text-[#AFAFAF] text-sm">
+          {author.posts_count} post{author.posts_count !== 1 ? 's' : ''} •{' '}
+          {author.followers_count} follower{author.followers_count !== 1 ? 's' : ''} •{' '}
+          {author.following_count} following
+        </div>
+
+        {/* Top posts */}
+        {author.top_posts.length > 0 && (
+          <div className="space-y-3">
+            <h2 className="text-white font-bold text-lg">Top posts</h2>
+            {author.top_posts.map((post) => (
+              <Link
+                key={post.id}
+                href={`/post/${post.slug}`}
+                className="flex items-center justify-between bg-[#121212] border border-white/10 hover:border-white/20 rounded-lg px-4 py-3 transition-colors"
+              >
+                <span className="text-white font-medium">{post.title}</span>
+                <span className="text-[#AFAFAF] text-sm flex items-center">
+                  <Heart className="h-3 w-3 mr-1" />
+                  {post.likes_count}
+                </span>
+              </Link>
+            ))}
+          </div>
+        )}
+      </div>
+    </div>
+  );
+}
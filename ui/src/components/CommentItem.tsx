@@ -1,11 +1,13 @@
 'use client';
 
 import { MessageCircle, MoreHorizontal } from 'lucide-react';
+import Link from 'next/link';
 import { useState } from 'react';
 import { Avatar, AvatarFallback } from '@/components/ui/avatar';
 import { Button } from '@/components/ui/button';
 import { type CommentInfo } from '@/lib/api-client';
 import CommentForm from '@/components/CommentForm';
+import { profileHref, splitMentions } from '@/lib/mentions';
 
 interface CommentItemProps {
   comment: CommentInfo;
@@ -47,7 +49,19 @@ export default function CommentItem({
           </div>
           
           <p className={`text-white leading-relaxed ${isReply ? 'text-sm' : 'body-medium'}`}>
-            {comment.body}
+            {splitMentions(comment.body, comment.mentions).map((segment, index) =>
+              segment.handle ? (
+                <Link
+                  key={index}
+                  href={profileHref(segment.handle)}
+                  className="text-[#25F4EE] hover:text-[#FE2C55] transition-colors font-medium"
+                >
+                  {segment.text}
+                </Link>
+              ) : (
+                segment.text
+              )
+            )}
           </p>
           
           <div className="flex items-center space-x-4">
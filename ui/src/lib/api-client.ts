@@ -21,6 +21,20 @@ export interface UpdatePostReq {
   title?: string;
 }
 
+export interface ExchangeOAuthCodeResp {
+  access_token: string;
+  refresh_token: string;
+  token_type: string;
+  expires_in: number;
+  provider: string;
+  user: {
+    id: string;
+    email: string;
+    username: string;
+    role: string;
+  };
+}
+
 export interface AuthorInfo {
   id: string;
   name: string;
@@ -33,11 +47,18 @@ export interface BookmarkPostResp {
   post_slug: string;
 }
 
+export interface MentionedUser {
+  id: string;
+  handle: string;
+  username: string;
+}
+
 export interface CommentInfo {
   author: AuthorInfo;
   body: string;
   created_at: string;
   id: string;
+  mentions?: MentionedUser[];
   parent_id?: string;
 }
 
@@ -80,19 +101,68 @@ export interface TopPostInfo {
   title: string;
 }
 
-export interface GetAuthorInfoResp {
-  followers_count: number;
+export interface UserProfile {
   id: string;
+  handle: string;
+  username: string;
+  display_name: string;
+  bio: string;
+  avatar_url: string;
+  website: string;
+  location: string;
+  social_links: string[];
+}
+
+export interface UpdateProfileReq {
+  display_name: string;
+  bio: string;
+  website: string;
+  location: string;
+  social_links: string[];
+}
+
+export interface ChangeHandleResp {
+  handle: string;
+  previous_handle: string;
+  alias_expires_at?: string;
+  next_change_after: string;
+}
+
+export interface GetAuthorInfoResp extends UserProfile {
+  followers_count: number;
+  following_count: number;
+  is_following: boolean | null;
   name: string;
   posts_count: number;
   top_posts: TopPostInfo[];
 }
 
+export interface UserCard {
+  id: string;
+  handle: string;
+  name: string;
+  avatar_url: string;
+}
+
+export interface FollowItem {
+  user: UserCard;
+  followed_at: string;
+  is_following: boolean | null;
+}
+
+export interface ListFollowsResp {
+  page: number;
+  per_page: number;
+  total: number;
+  items: FollowItem[];
+}
+
 export interface GetPostBySlugResp {
   author: AuthorInfo;
   comments: CommentInfo[];
   id: string;
   likes_count: number;
+  mentions: MentionedUser[];
   published_at?: string;
   raw_markdown: string;
   slug: string;
@@ -154,20 +224,70 @@ export interface UnfollowUserResp {
   following: boolean;
 }
 
-export interface ProfilePost {
-  id: string;
-  title: string;
-}
-
 export interface ProfileUser {
   id: string;
   username: string;
 }
 
 export interface GetProfileResp {
-  bookmarks: ProfilePost[];
-  following: ProfileUser[];
-  liked_posts: ProfilePost[];
+  profile: UserProfile;
+  deletion_scheduled_at: string | null;
+  following_count: number;
+  followers_count: number;
+  bookmarks_count: number;
+  likes_count: number;
+}
+
+export interface PostCard {
+  id: string;
+  title: string;
+  slug: string;
+  summary: string;
+  tags: string[];
+  published_at: string;
+  summary_audio_url: string | null;
+  author: UserCard;
+  likes_count: number;
+  comments_count: number;
+}
+
+export interface LikedPostItem {
+  post: PostCard;
+  liked_at: string;
+}
+
+export interface ListLikedPostsResp {
+  page: number;
+  per_page: number;
+  total: number;
+  items: LikedPostItem[];
+}
+
+export interface FollowedUserItem {
+  user: UserCard;
+  bio: string;
+  posts_count: number;
+  followers_count: number;
+  followed_at: string;
+}
+
+export interface ListFollowedUsersResp {
+  page: number;
+  per_page: number;
+  total: number;
+  items: FollowedUserItem[];
+}
+
+export interface AccountDeletionResp {
+  scheduled: boolean;
+  scheduled_for: string | null;
+}
+
+export interface AccountExport {
+  format_version: number;
+  exported_at: string;
+  account: Record<string, unknown>;
+  tables: Record<string, Record<string, unknown>[]>;
 }
 
 export interface UpdatePostResp {
@@ -190,6 +310,124 @@ export interface ListPostsParams {
   [key: string]: string | number | boolean | undefined;
 }
 
+export interface StreamTicketResp {
+  ticket: string;
+  expires_at: string;
+}
+
+export type TrendingWindow = 'day' | 'week' | 'month';
+
+export interface ListTrendingPostsParams {
+  window?: TrendingWindow;
+  page?: number;
+  per_page?: number;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface TrendingPostItem {
+  post: PostCard;
+  score: number;
+  activity: {
+    likes: number;
+    comments: number;
+    bookmarks: number;
+    views: number;
+  };
+}
+
+export interface ListTrendingPostsResp {
+  window: TrendingWindow;
+  refreshed_at: string | null;
+  page: number;
+  per_page: number;
+  total: number;
+  items: TrendingPostItem[];
+}
+
+export interface BookmarkCollectionInfo {
+  id: string;
+  name: string;
+  description: string;
+  is_public: boolean;
+  bookmarks_count: number;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface ListBookmarkCollectionsResp {
+  items: BookmarkCollectionInfo[];
+}
+
+export interface BookmarkCollectionReq {
+  name: string;
+  description?: string;
+  is_public?: boolean;
+}
+
+export interface BookmarkItem {
+  id: string;
+  post: PostCard;
+  collection_id: string | null;
+  note: string;
+  read: boolean;
+  read_at: string | null;
+  created_at: string;
+}
+
+export interface ListBookmarksResp {
+  page: number;
+  per_page: number;
+  total: number;
+  items: BookmarkItem[];
+}
+
+export interface ListBookmarksParams {
+  page?: number;
+  per_page?: number;
+  collection_id?: string;
+  status?: 'read' | 'unread';
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface UpdateBookmarkReq {
+  collection_id?: string;
+  note?: string;
+  read?: boolean;
+}
+
+export interface UpdateBookmarkResp {
+  id: string;
+  collection_id: string | null;
+  note: string;
+  read: boolean;
+  read_at: string | null;
+}
+
+export interface CollectionItem {
+  post: PostCard;
+  added_at: string;
+}
+
+export interface GetBookmarkCollectionParams {
+  page?: number;
+  per_page?: number;
+  [key: string]: string | number | boolean | undefined;
+}
+
+export interface GetBookmarkCollectionResp {
+  collection: BookmarkCollectionInfo;
+  owner: UserCard;
+  page: number;
+  per_page: number;
+  items: CollectionItem[];
+}
+
+export interface ListFollowsParams {
+  page?: number;
+  per_page?: number;
+  [key: string]: string | number | boolean | undefined;
+}
+
 export interface ListMyPostsParams {
   page?: number;
   per_page?: number;
@@ -250,6 +488,11 @@ export class Blog0ApiClient {
       headers.Authorization = `${this.apiToken}`;
     }
 
+    // The browser sets the multipart boundary itself
+    if (options.body instanceof FormData) {
+      delete headers['Content-Type'];
+    }
+
     const response = await fetch(url, {
       ...options,
       headers,
@@ -299,12 +542,25 @@ export class Blog0ApiClient {
     window.location.href = `${this.baseUrl}/api/v1/auth/${provider}/callback${window.location.search}`;
   }
 
+  async exchangeOAuthCode(code: string): Promise<ExchangeOAuthCodeResp> {
+    return this.request<ExchangeOAuthCodeResp>('/auth/exchange', {
+      method: 'POST',
+      body: JSON.stringify({ code }),
+    });
+  }
+
   async listPosts(params: ListPostsParams = {}): Promise<ListPostsResp> {
     const queryString = this.buildQueryString(params);
     const endpoint = `/posts${queryString ? `?${queryString}` : ''}`;
     return this.request<ListPostsResp>(endpoint);
   }
 
+  async listTrendingPosts(params: ListTrendingPostsParams = {}): Promise<ListTrendingPostsResp> {
+    const queryString = this.buildQueryString(params);
+    const endpoint = `/posts/trending${queryString ? `?${queryString}` : ''}`;
+    return this.request<ListTrendingPostsResp>(endpoint);
+  }
+
   async getPostBySlug(slug: string): Promise<GetPostBySlugResp> {
     return this.request<GetPostBySlugResp>(`/posts/${slug}`);
   }
@@ -315,9 +571,21 @@ export class Blog0ApiClient {
     });
   }
 
+  async likePost(slug: string): Promise<ToggleLikeResp> {
+    return this.request<ToggleLikeResp>(`/posts/${slug}/likes`, {
+      method: 'PUT',
+    });
+  }
+
+  async unlikePost(slug: string): Promise<ToggleLikeResp> {
+    return this.request<ToggleLikeResp>(`/posts/${slug}/likes`, {
+      method: 'DELETE',
+    });
+  }
+
   async bookmarkPost(slug: string): Promise<BookmarkPostResp> {
     return this.request<BookmarkPostResp>(`/posts/${slug}/bookmarks`, {
-      method: 'POST',
+      method: 'PUT',
     });
   }
 
@@ -327,6 +595,61 @@ export class Blog0ApiClient {
     });
   }
 
+  async listLikedPosts(params: ListFollowsParams = {}): Promise<ListLikedPostsResp> {
+    const queryString = this.buildQueryString(params);
+    const endpoint = `/me/likes${queryString ? `?${queryString}` : ''}`;
+    return this.request<ListLikedPostsResp>(endpoint);
+  }
+
+  async listFollowedUsers(params: ListFollowsParams = {}): Promise<ListFollowedUsersResp> {
+    const queryString = this.buildQueryString(params);
+    const endpoint = `/me/following${queryString ? `?${queryString}` : ''}`;
+    return this.request<ListFollowedUsersResp>(endpoint);
+  }
+
+  async listBookmarks(params: ListBookmarksParams = {}): Promise<ListBookmarksResp> {
+    const queryString = this.buildQueryString(params);
+    const endpoint = `/me/bookmarks${queryString ? `?${queryString}` : ''}`;
+    return this.request<ListBookmarksResp>(endpoint);
+  }
+
+  async updateBookmark(id: string, changes: UpdateBookmarkReq): Promise<UpdateBookmarkResp> {
+    return this.request<UpdateBookmarkResp>(`/me/bookmarks/${id}`, {
+      method: 'PUT',
+      body: JSON.stringify(changes),
+    });
+  }
+
+  async listBookmarkCollections(): Promise<ListBookmarkCollectionsResp> {
+    return this.request<ListBookmarkCollectionsResp>('/me/collections');
+  }
+
+  async createBookmarkCollection(collection: BookmarkCollectionReq): Promise<BookmarkCollectionInfo> {
+    return this.request<BookmarkCollectionInfo>('/me/collections', {
+      method: 'POST',
+      body: JSON.stringify(collection),
+    });
+  }
+
+  async updateBookmarkCollection(id: string, collection: BookmarkCollectionReq): Promise<BookmarkCollectionInfo> {
+    return this.request<BookmarkCollectionInfo>(`/me/collections/${id}`, {
+      method: 'PUT',
+      body: JSON.stringify(collection),
+    });
+  }
+
+  async deleteBookmarkCollection(id: string): Promise<{ deleted: boolean }> {
+    return this.request<{ deleted: boolean }>(`/me/collections/${id}`, {
+      method: 'DELETE',
+    });
+  }
+
+  async getBookmarkCollection(id: string, params: GetBookmarkCollectionParams = {}): Promise<GetBookmarkCollectionResp> {
+    const queryString = this.buildQueryString(params);
+    const endpoint = `/collections/${id}${queryString ? `?${queryString}` : ''}`;
+    return this.request<GetBookmarkCollectionResp>(endpoint);
+  }
+
   async createComment(slug: string, comment: CreateCommentReq): Promise<CreateCommentResp> {
     return this.request<CreateCommentResp>(`/posts/${slug}/comments`, {
       method: 'POST',
@@ -360,13 +683,26 @@ export class Blog0ApiClient {
     });
   }
 
+  // authorId can also be a handle, like @jane
   async getAuthorInfo(authorId: string): Promise<GetAuthorInfoResp> {
-    return this.request<GetAuthorInfoResp>(`/users/${authorId}`);
+    return this.request<GetAuthorInfoResp>(`/users/${encodeURIComponent(authorId)}`);
+  }
+
+  async listFollowers(authorId: string, params: ListFollowsParams = {}): Promise<ListFollowsResp> {
+    const queryString = this.buildQueryString(params);
+    const endpoint = `/users/${encodeURIComponent(authorId)}/followers${queryString ? `?${queryString}` : ''}`;
+    return this.request<ListFollowsResp>(endpoint);
+  }
+
+  async listFollowing(authorId: string, params: ListFollowsParams = {}): Promise<ListFollowsResp> {
+    const queryString = this.buildQueryString(params);
+    const endpoint = `/users/${encodeURIComponent(authorId)}/following${queryString ? `?${queryString}` : ''}`;
+    return this.request<ListFollowsResp>(endpoint);
   }
 
   async followUser(authorId: string): Promise<FollowUserResp> {
     return this.request<FollowUserResp>(`/users/${authorId}/follow`, {
-      method: 'POST',
+      method: 'PUT',
     });
   }
 
@@ -379,6 +715,61 @@ export class Blog0ApiClient {
   async getProfile(): Promise<GetProfileResp> {
     return this.request<GetProfileResp>('/me/profile');
   }
+
+  async updateProfile(profile: UpdateProfileReq): Promise<UserProfile> {
+    return this.request<UserProfile>('/me/profile', {
+      method: 'PUT',
+      body: JSON.stringify(profile),
+    });
+  }
+
+  async changeHandle(handle: string): Promise<ChangeHandleResp> {
+    return this.request<ChangeHandleResp>('/me/handle', {
+      method: 'PUT',
+      body: JSON.stringify({ handle }),
+    });
+  }
+
+  async uploadAvatar(file: File): Promise<UserProfile> {
+    const body = new FormData();
+    body.append('avatar', file);
+    return this.request<UserProfile>('/me/profile/avatar', {
+      method: 'PUT',
+      body,
+    });
+  }
+
+  async removeAvatar(): Promise<UserProfile> {
+    return this.request<UserProfile>('/me/profile/avatar', {
+      method: 'DELETE',
+    });
+  }
+
+  async deleteAccount(confirm: string): Promise<AccountDeletionResp> {
+    return this.request<AccountDeletionResp>('/me', {
+      method: 'DELETE',
+      body: JSON.stringify({ confirm }),
+    });
+  }
+
+  // EventSource can't send the Authorization header, the stream is opened
+  // with a short-lived ticket instead
+  async openNotificationStream(): Promise<EventSource> {
+    const { ticket } = await this.request<StreamTicketResp>('/me/stream/ticket', {
+      method: 'POST',
+    });
+    return new EventSource(`${this.baseUrl}/api/v1/me/stream?ticket=${encodeURIComponent(ticket)}`);
+  }
+
+  async cancelAccountDeletion(): Promise<AccountDeletionResp> {
+    return this.request<AccountDeletionResp>('/me/deletion/cancel', {
+      method: 'POST',
+    });
+  }
+
+  async exportAccountData(): Promise<AccountExport> {
+    return this.request<AccountExport>('/me/data-export');
+  }
 }
 
 // Create a shared global instance
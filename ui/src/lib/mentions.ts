@@ -0,0 +1,66 @@
+import { type MentionedUser } from '@/lib/api-client';
+
+// Same rules as the backend parser: mentions in code, emails and paths are
+// not mentions.
+const mentionPattern = /(^|[^\w@./-])@([A-Za-z0-9_](?:[A-Za-z0-9_.-]*[A-Za-z0-9_])?)/g;
+const codePattern = /```[\s\S]*?```|`[^`\n]*`/g;
+
+export interface MentionSegment {
+  text: string;
+  handle?: string;
+}
+
+export function profileHref(handle: string): string {
+  return `/users/@${handle}`;
+}
+
+// splitMentions cuts a text around the @handle mentions the API resolved,
+// unresolved ones stay plain text.
+export function splitMentions(text: string, mentions: MentionedUser[] = []): MentionSegment[] {
+  const handles = new Set(mentions.map((mention) => mention.handle.toLowerCase()));
+  const segments: MentionSegment[] = [];
+  let plain = '';
+
+  const scan = (chunk: string) => {
+    let last = 0;
+    for (const match of chunk.matchAll(mentionPattern)) {
+      const handle = match[2].toLowerCase();
+      if (!handles.has(handle)) {
+        continue;
+      }
+
+      const start = match.index! + match[1].length;
+      plain += chunk.slice(last, start);
+      if (plain) {
+        segments.push({ text: plain });
+        plain = '';
+      }
+      segments.push({ text: `@${match[2]}`, handle });
+      last = start + match[2].length + 1;
+    }
+    plain += chunk.slice(last);
+  };
+
+  let last = 0;
+  if (handles.size > 0) {
+    for (const match of text.matchAll(codePattern)) {
+      scan(text.slice(last, match.index));
+      plain += match[0];
+      last = match.index! + match[0].length;
+    }
+  }
+  scan(text.slice(last));
+
+  if (plain) {
+    segments.push({ text: plain });
+  }
+  return segments;
+}
+
+// linkMentions turns the resolved @handle mentions of a markdown text into
+// links to their profiles.
+export function linkMentions(markdown: string, mentions: MentionedUser[] = []): string {
+  return splitMentions(markdown, mentions)
+    .map((segment) => (segment.handle ? `[${segment.text}](${profileHref(segment.handle)})` : segment.text))
+    .join('');
+}
@@ -15,8 +15,8 @@ interface AuthState {
   isLoading: boolean;
   error: string | null;
   // Efficient hash maps for profile data
-  likedPosts: Record<string, boolean>; // postId -> true
-  bookmarkedPosts: Record<string, boolean>; // postId -> true
+  likedPosts: Record<string, boolean>; // post slug -> true
+  bookmarkedPosts: Record<string, boolean>; // post slug -> true
   followingUsers: Record<string, boolean>; // userId -> true
 }
 
@@ -220,23 +220,30 @@ export const useAuthStore = create<AuthStore>()(
 
         try {
           const api = get().getApiClient();
-          const profile = await api.getProfile();
+          // The most recent likes, bookmarks and follows are enough to flag
+          // what is on screen
+          const [likes, bookmarks, following] = await Promise.all([
+            api.listLikedPosts({ per_page: 100 }),
+            api.listBookmarks({ per_page: 100 }),
+            api.listFollowedUsers({ per_page: 100 }),
+          ]);
           
-          // Convert arrays to hash maps for efficient lookups
+          // Convert arrays to hash maps for efficient lookups, posts are
+          // looked up by slug
           const likedPosts: Record<string, boolean> = {};
           const bookmarkedPosts: Record<string, boolean> = {};
           const followingUsers: Record<string, boolean> = {};
           
-          profile.liked_posts.forEach(post => {
-            likedPosts[post.id] = true;
+          likes.items.forEach(item => {
+            likedPosts[item.post.slug] = true;
           });
           
-          profile.bookmarks.forEach(post => {
-            bookmarkedPosts[post.id] = true;
+          bookmarks.items.forEach(item => {
+            bookmarkedPosts[item.post.slug] = true;
           });
           
-          profile.following.forEach(user => {
-            followingUsers[user.id] = true;
+          following.items.forEach(item => {
+            followingUsers[item.user.id] = true;
           });
           
           set({ 